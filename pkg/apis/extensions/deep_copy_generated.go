@@ -1022,6 +1022,20 @@ func deepCopy_extensions_Deployment(in Deployment, out *Deployment, c *conversio
 	return nil
 }
 
+func deepCopy_extensions_DeploymentCondition(in DeploymentCondition, out *DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if err := deepCopy_unversioned_Time(in.LastUpdateTime, &out.LastUpdateTime, c); err != nil {
+		return err
+	}
+	if err := deepCopy_unversioned_Time(in.LastTransitionTime, &out.LastTransitionTime, c); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_extensions_DeploymentList(in DeploymentList, out *DeploymentList, c *conversion.Cloner) error {
 	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
 		return err
@@ -1045,9 +1059,9 @@ func deepCopy_extensions_DeploymentList(in DeploymentList, out *DeploymentList,
 func deepCopy_extensions_DeploymentSpec(in DeploymentSpec, out *DeploymentSpec, c *conversion.Cloner) error {
 	out.Replicas = in.Replicas
 	if in.Selector != nil {
-		out.Selector = make(map[string]string)
-		for key, val := range in.Selector {
-			out.Selector[key] = val
+		out.Selector = new(PodSelector)
+		if err := deepCopy_extensions_PodSelector(*in.Selector, out.Selector, c); err != nil {
+			return err
 		}
 	} else {
 		out.Selector = nil
@@ -1059,12 +1073,29 @@ func deepCopy_extensions_DeploymentSpec(in DeploymentSpec, out *DeploymentSpec,
 		return err
 	}
 	out.UniqueLabelKey = in.UniqueLabelKey
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
 	return nil
 }
 
 func deepCopy_extensions_DeploymentStatus(in DeploymentStatus, out *DeploymentStatus, c *conversion.Cloner) error {
 	out.Replicas = in.Replicas
 	out.UpdatedReplicas = in.UpdatedReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_extensions_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1636,6 +1667,7 @@ func init() {
 		deepCopy_extensions_DaemonSetSpec,
 		deepCopy_extensions_DaemonSetStatus,
 		deepCopy_extensions_Deployment,
+		deepCopy_extensions_DeploymentCondition,
 		deepCopy_extensions_DeploymentList,
 		deepCopy_extensions_DeploymentSpec,
 		deepCopy_extensions_DeploymentStatus,
@@ -65,3 +65,27 @@ func PodSelectorAsSelector(ps *PodSelector) (labels.Selector, error) {
 	sort.Sort(labels.ByKey(selector))
 	return selector, nil
 }
+
+// PodSelectorAsMap converts the PodSelector api type into a map of strings, for
+// consumers such as ReplicationControllers that only support equality-based
+// selectors. Only MatchLabels and MatchExpressions using the In operator with a
+// single value can be expressed this way; any other selector is an error.
+func PodSelectorAsMap(ps *PodSelector) (map[string]string, error) {
+	if ps == nil {
+		return nil, nil
+	}
+	selector := make(map[string]string, len(ps.MatchLabels)+len(ps.MatchExpressions))
+	for k, v := range ps.MatchLabels {
+		selector[k] = v
+	}
+	for _, expr := range ps.MatchExpressions {
+		if expr.Operator != PodSelectorOpIn || len(expr.Values) != 1 {
+			return nil, fmt.Errorf("pod selector requirement %q %q %v cannot be expressed as a map", expr.Key, expr.Operator, expr.Values)
+		}
+		if v, ok := selector[expr.Key]; ok && v != expr.Values[0] {
+			return nil, fmt.Errorf("pod selector requires conflicting values %q and %q for key %q", v, expr.Values[0], expr.Key)
+		}
+		selector[expr.Key] = expr.Values[0]
+	}
+	return selector, nil
+}
@@ -81,3 +81,73 @@ func TestPodSelectorAsSelector(t *testing.T) {
 		}
 	}
 }
+
+func TestPodSelectorAsMap(t *testing.T) {
+	tc := []struct {
+		in        *PodSelector
+		out       map[string]string
+		expectErr bool
+	}{
+		{in: nil, out: nil},
+		{in: &PodSelector{}, out: map[string]string{}},
+		{
+			in:  &PodSelector{MatchLabels: map[string]string{"foo": "bar"}},
+			out: map[string]string{"foo": "bar"},
+		},
+		{
+			in: &PodSelector{
+				MatchLabels: map[string]string{"foo": "bar"},
+				MatchExpressions: []PodSelectorRequirement{{
+					Key:      "baz",
+					Operator: PodSelectorOpIn,
+					Values:   []string{"qux"},
+				}},
+			},
+			out: map[string]string{"foo": "bar", "baz": "qux"},
+		},
+		{
+			in: &PodSelector{
+				MatchExpressions: []PodSelectorRequirement{{
+					Key:      "baz",
+					Operator: PodSelectorOpIn,
+					Values:   []string{"qux", "norf"},
+				}},
+			},
+			expectErr: true,
+		},
+		{
+			in: &PodSelector{
+				MatchExpressions: []PodSelectorRequirement{{
+					Key:      "baz",
+					Operator: PodSelectorOpNotIn,
+					Values:   []string{"qux"},
+				}},
+			},
+			expectErr: true,
+		},
+		{
+			in: &PodSelector{
+				MatchLabels: map[string]string{"baz": "norf"},
+				MatchExpressions: []PodSelectorRequirement{{
+					Key:      "baz",
+					Operator: PodSelectorOpIn,
+					Values:   []string{"qux"},
+				}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for i, tc := range tc {
+		out, err := PodSelectorAsMap(tc.in)
+		if err == nil && tc.expectErr {
+			t.Errorf("[%v]expected error but got none.", i)
+		}
+		if err != nil && !tc.expectErr {
+			t.Errorf("[%v]did not expect error but got: %v", i, err)
+		}
+		if !reflect.DeepEqual(out, tc.out) {
+			t.Errorf("[%v]expected:\n\t%+v\nbut got:\n\t%+v", i, tc.out, out)
+		}
+	}
+}
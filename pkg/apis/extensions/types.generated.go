@@ -4294,15 +4294,17 @@ func (x *DeploymentSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 		} else {
 			yysep387 := !z.EncBinary()
 			yy2arr387 := z.EncBasicHandle().StructToArray
-			var yyq387 [5]bool
+			var yyq387 [7]bool
 			_, _, _ = yysep387, yyq387, yy2arr387
 			const yyr387 bool = false
 			yyq387[0] = x.Replicas != 0
-			yyq387[1] = len(x.Selector) != 0
+			yyq387[1] = x.Selector != nil
 			yyq387[3] = true
 			yyq387[4] = x.UniqueLabelKey != ""
+			yyq387[5] = x.Paused != false
+			yyq387[6] = x.ProgressDeadlineSeconds != nil
 			if yyr387 || yy2arr387 {
-				r.EncodeArrayStart(5)
+				r.EncodeArrayStart(7)
 			} else {
 				var yynn387 int = 1
 				for _, b := range yyq387 {
@@ -4339,12 +4341,7 @@ func (x *DeploymentSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
-						yym392 := z.EncBinary()
-						_ = yym392
-						if false {
-						} else {
-							z.F.EncMapStringStringV(x.Selector, false, e)
-						}
+						x.Selector.CodecEncodeSelf(e)
 					}
 				} else {
 					r.EncodeNil()
@@ -4355,41 +4352,36 @@ func (x *DeploymentSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
-						yym393 := z.EncBinary()
-						_ = yym393
-						if false {
-						} else {
-							z.F.EncMapStringStringV(x.Selector, false, e)
-						}
+						x.Selector.CodecEncodeSelf(e)
 					}
 				}
 			}
 			if yyr387 || yy2arr387 {
-				yy395 := &x.Template
-				yy395.CodecEncodeSelf(e)
+				yy393 := &x.Template
+				yy393.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("template"))
-				yy396 := &x.Template
-				yy396.CodecEncodeSelf(e)
+				yy394 := &x.Template
+				yy394.CodecEncodeSelf(e)
 			}
 			if yyr387 || yy2arr387 {
 				if yyq387[3] {
-					yy398 := &x.Strategy
-					yy398.CodecEncodeSelf(e)
+					yy396 := &x.Strategy
+					yy396.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
 				if yyq387[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("strategy"))
-					yy399 := &x.Strategy
-					yy399.CodecEncodeSelf(e)
+					yy397 := &x.Strategy
+					yy397.CodecEncodeSelf(e)
 				}
 			}
 			if yyr387 || yy2arr387 {
 				if yyq387[4] {
-					yym401 := z.EncBinary()
-					_ = yym401
+					yym399 := z.EncBinary()
+					_ = yym399
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.UniqueLabelKey))
@@ -4400,11 +4392,65 @@ func (x *DeploymentSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 			} else {
 				if yyq387[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("uniqueLabelKey"))
+					yym400 := z.EncBinary()
+					_ = yym400
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.UniqueLabelKey))
+					}
+				}
+			}
+			if yyr387 || yy2arr387 {
+				if yyq387[5] {
 					yym402 := z.EncBinary()
 					_ = yym402
 					if false {
 					} else {
-						r.EncodeString(codecSelferC_UTF81234, string(x.UniqueLabelKey))
+						r.EncodeBool(bool(x.Paused))
+					}
+				} else {
+					r.EncodeBool(false)
+				}
+			} else {
+				if yyq387[5] {
+					r.EncodeString(codecSelferC_UTF81234, string("paused"))
+					yym403 := z.EncBinary()
+					_ = yym403
+					if false {
+					} else {
+						r.EncodeBool(bool(x.Paused))
+					}
+				}
+			}
+			if yyr387 || yy2arr387 {
+				if yyq387[6] {
+					if x.ProgressDeadlineSeconds == nil {
+						r.EncodeNil()
+					} else {
+						yy405 := *x.ProgressDeadlineSeconds
+						yym406 := z.EncBinary()
+						_ = yym406
+						if false {
+						} else {
+							r.EncodeInt(int64(yy405))
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq387[6] {
+					r.EncodeString(codecSelferC_UTF81234, string("progressDeadlineSeconds"))
+					if x.ProgressDeadlineSeconds == nil {
+						r.EncodeNil()
+					} else {
+						yy407 := *x.ProgressDeadlineSeconds
+						yym408 := z.EncBinary()
+						_ = yym408
+						if false {
+						} else {
+							r.EncodeInt(int64(yy407))
+						}
 					}
 				}
 			}
@@ -4419,24 +4465,24 @@ func (x *DeploymentSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym403 := z.DecBinary()
-	_ = yym403
+	yym409 := z.DecBinary()
+	_ = yym409
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl404 := r.ReadMapStart()
-			if yyl404 == 0 {
+			yyl410 := r.ReadMapStart()
+			if yyl410 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl404, d)
+				x.codecDecodeSelfFromMap(yyl410, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl404 := r.ReadArrayStart()
-			if yyl404 == 0 {
+			yyl410 := r.ReadArrayStart()
+			if yyl410 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl404, d)
+				x.codecDecodeSelfFromArray(yyl410, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -4448,12 +4494,12 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys405Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys405Slc
-	var yyhl405 bool = l >= 0
-	for yyj405 := 0; ; yyj405++ {
-		if yyhl405 {
-			if yyj405 >= l {
+	var yys411Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys411Slc
+	var yyhl411 bool = l >= 0
+	for yyj411 := 0; ; yyj411++ {
+		if yyhl411 {
+			if yyj411 >= l {
 				break
 			}
 		} else {
@@ -4461,9 +4507,9 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys405Slc = r.DecodeBytes(yys405Slc, true, true)
-		yys405 := string(yys405Slc)
-		switch yys405 {
+		yys411Slc = r.DecodeBytes(yys411Slc, true, true)
+		yys411 := string(yys411Slc)
+		switch yys411 {
 		case "replicas":
 			if r.TryDecodeAsNil() {
 				x.Replicas = 0
@@ -4472,29 +4518,28 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			}
 		case "selector":
 			if r.TryDecodeAsNil() {
-				x.Selector = nil
+				if x.Selector != nil {
+					x.Selector = nil
+				}
 			} else {
-				yyv407 := &x.Selector
-				yym408 := z.DecBinary()
-				_ = yym408
-				if false {
-				} else {
-					z.F.DecMapStringStringX(yyv407, false, d)
+				if x.Selector == nil {
+					x.Selector = new(PodSelector)
 				}
+				x.Selector.CodecDecodeSelf(d)
 			}
 		case "template":
 			if r.TryDecodeAsNil() {
 				x.Template = pkg2_api.PodTemplateSpec{}
 			} else {
-				yyv409 := &x.Template
-				yyv409.CodecDecodeSelf(d)
+				yyv414 := &x.Template
+				yyv414.CodecDecodeSelf(d)
 			}
 		case "strategy":
 			if r.TryDecodeAsNil() {
 				x.Strategy = DeploymentStrategy{}
 			} else {
-				yyv410 := &x.Strategy
-				yyv410.CodecDecodeSelf(d)
+				yyv415 := &x.Strategy
+				yyv415.CodecDecodeSelf(d)
 			}
 		case "uniqueLabelKey":
 			if r.TryDecodeAsNil() {
@@ -4502,11 +4547,33 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			} else {
 				x.UniqueLabelKey = string(r.DecodeString())
 			}
+		case "paused":
+			if r.TryDecodeAsNil() {
+				x.Paused = false
+			} else {
+				x.Paused = bool(r.DecodeBool())
+			}
+		case "progressDeadlineSeconds":
+			if r.TryDecodeAsNil() {
+				if x.ProgressDeadlineSeconds != nil {
+					x.ProgressDeadlineSeconds = nil
+				}
+			} else {
+				if x.ProgressDeadlineSeconds == nil {
+					x.ProgressDeadlineSeconds = new(int)
+				}
+				yym419 := z.DecBinary()
+				_ = yym419
+				if false {
+				} else {
+					*((*int)(x.ProgressDeadlineSeconds)) = int(r.DecodeInt(codecSelferBitsize1234))
+				}
+			}
 		default:
-			z.DecStructFieldNotFound(-1, yys405)
-		} // end switch yys405
-	} // end for yyj405
-	if !yyhl405 {
+			z.DecStructFieldNotFound(-1, yys411)
+		} // end switch yys411
+	} // end for yyj411
+	if !yyhl411 {
 		r.ReadEnd()
 	}
 }
@@ -4515,16 +4582,16 @@ func (x *DeploymentSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj412 int
-	var yyb412 bool
-	var yyhl412 bool = l >= 0
-	yyj412++
-	if yyhl412 {
-		yyb412 = yyj412 > l
+	var yyj420 int
+	var yyb420 bool
+	var yyhl420 bool = l >= 0
+	yyj420++
+	if yyhl420 {
+		yyb420 = yyj420 > l
 	} else {
-		yyb412 = r.CheckBreak()
+		yyb420 = r.CheckBreak()
 	}
-	if yyb412 {
+	if yyb420 {
 		r.ReadEnd()
 		return
 	}
@@ -4533,66 +4600,65 @@ func (x *DeploymentSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj412++
-	if yyhl412 {
-		yyb412 = yyj412 > l
+	yyj420++
+	if yyhl420 {
+		yyb420 = yyj420 > l
 	} else {
-		yyb412 = r.CheckBreak()
+		yyb420 = r.CheckBreak()
 	}
-	if yyb412 {
+	if yyb420 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
-		x.Selector = nil
+		if x.Selector != nil {
+			x.Selector = nil
+		}
 	} else {
-		yyv414 := &x.Selector
-		yym415 := z.DecBinary()
-		_ = yym415
-		if false {
-		} else {
-			z.F.DecMapStringStringX(yyv414, false, d)
+		if x.Selector == nil {
+			x.Selector = new(PodSelector)
 		}
+		x.Selector.CodecDecodeSelf(d)
 	}
-	yyj412++
-	if yyhl412 {
-		yyb412 = yyj412 > l
+	yyj420++
+	if yyhl420 {
+		yyb420 = yyj420 > l
 	} else {
-		yyb412 = r.CheckBreak()
+		yyb420 = r.CheckBreak()
 	}
-	if yyb412 {
+	if yyb420 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Template = pkg2_api.PodTemplateSpec{}
 	} else {
-		yyv416 := &x.Template
-		yyv416.CodecDecodeSelf(d)
+		yyv423 := &x.Template
+		yyv423.CodecDecodeSelf(d)
 	}
-	yyj412++
-	if yyhl412 {
-		yyb412 = yyj412 > l
+	yyj420++
+	if yyhl420 {
+		yyb420 = yyj420 > l
 	} else {
-		yyb412 = r.CheckBreak()
+		yyb420 = r.CheckBreak()
 	}
-	if yyb412 {
+	if yyb420 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Strategy = DeploymentStrategy{}
 	} else {
-		yyv417 := &x.Strategy
-		yyv417.CodecDecodeSelf(d)
+		yyv424 := &x.Strategy
+		yyv424.CodecDecodeSelf(d)
 	}
-	yyj412++
-	if yyhl412 {
-		yyb412 = yyj412 > l
+	yyj420++
+	if yyhl420 {
+		yyb420 = yyj420 > l
 	} else {
-		yyb412 = r.CheckBreak()
+		yyb420 = r.CheckBreak()
 	}
-	if yyb412 {
+	if yyb420 {
 		r.ReadEnd()
 		return
 	}
@@ -4601,17 +4667,57 @@ func (x *DeploymentSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.UniqueLabelKey = string(r.DecodeString())
 	}
+	yyj420++
+	if yyhl420 {
+		yyb420 = yyj420 > l
+	} else {
+		yyb420 = r.CheckBreak()
+	}
+	if yyb420 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Paused = false
+	} else {
+		x.Paused = bool(r.DecodeBool())
+	}
+	yyj420++
+	if yyhl420 {
+		yyb420 = yyj420 > l
+	} else {
+		yyb420 = r.CheckBreak()
+	}
+	if yyb420 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		if x.ProgressDeadlineSeconds != nil {
+			x.ProgressDeadlineSeconds = nil
+		}
+	} else {
+		if x.ProgressDeadlineSeconds == nil {
+			x.ProgressDeadlineSeconds = new(int)
+		}
+		yym428 := z.DecBinary()
+		_ = yym428
+		if false {
+		} else {
+			*((*int)(x.ProgressDeadlineSeconds)) = int(r.DecodeInt(codecSelferBitsize1234))
+		}
+	}
 	for {
-		yyj412++
-		if yyhl412 {
-			yyb412 = yyj412 > l
+		yyj420++
+		if yyhl420 {
+			yyb420 = yyj420 > l
 		} else {
-			yyb412 = r.CheckBreak()
+			yyb420 = r.CheckBreak()
 		}
-		if yyb412 {
+		if yyb420 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj412-1, "")
+		z.DecStructFieldNotFound(yyj420-1, "")
 	}
 	r.ReadEnd()
 }
@@ -4623,43 +4729,43 @@ func (x *DeploymentStrategy) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym419 := z.EncBinary()
-		_ = yym419
+		yym429 := z.EncBinary()
+		_ = yym429
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep420 := !z.EncBinary()
-			yy2arr420 := z.EncBasicHandle().StructToArray
-			var yyq420 [2]bool
-			_, _, _ = yysep420, yyq420, yy2arr420
-			const yyr420 bool = false
-			yyq420[0] = x.Type != ""
-			yyq420[1] = x.RollingUpdate != nil
-			if yyr420 || yy2arr420 {
+			yysep430 := !z.EncBinary()
+			yy2arr430 := z.EncBasicHandle().StructToArray
+			var yyq430 [2]bool
+			_, _, _ = yysep430, yyq430, yy2arr430
+			const yyr430 bool = false
+			yyq430[0] = x.Type != ""
+			yyq430[1] = x.RollingUpdate != nil
+			if yyr430 || yy2arr430 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn420 int = 0
-				for _, b := range yyq420 {
+				var yynn430 int = 0
+				for _, b := range yyq430 {
 					if b {
-						yynn420++
+						yynn430++
 					}
 				}
-				r.EncodeMapStart(yynn420)
+				r.EncodeMapStart(yynn430)
 			}
-			if yyr420 || yy2arr420 {
-				if yyq420[0] {
+			if yyr430 || yy2arr430 {
+				if yyq430[0] {
 					x.Type.CodecEncodeSelf(e)
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq420[0] {
+				if yyq430[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("type"))
 					x.Type.CodecEncodeSelf(e)
 				}
 			}
-			if yyr420 || yy2arr420 {
-				if yyq420[1] {
+			if yyr430 || yy2arr430 {
+				if yyq430[1] {
 					if x.RollingUpdate == nil {
 						r.EncodeNil()
 					} else {
@@ -4669,7 +4775,7 @@ func (x *DeploymentStrategy) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq420[1] {
+				if yyq430[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("rollingUpdate"))
 					if x.RollingUpdate == nil {
 						r.EncodeNil()
@@ -4678,7 +4784,7 @@ func (x *DeploymentStrategy) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep420 {
+			if yysep430 {
 				r.EncodeEnd()
 			}
 		}
@@ -4689,24 +4795,24 @@ func (x *DeploymentStrategy) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym423 := z.DecBinary()
-	_ = yym423
+	yym433 := z.DecBinary()
+	_ = yym433
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl424 := r.ReadMapStart()
-			if yyl424 == 0 {
+			yyl434 := r.ReadMapStart()
+			if yyl434 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl424, d)
+				x.codecDecodeSelfFromMap(yyl434, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl424 := r.ReadArrayStart()
-			if yyl424 == 0 {
+			yyl434 := r.ReadArrayStart()
+			if yyl434 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl424, d)
+				x.codecDecodeSelfFromArray(yyl434, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -4718,12 +4824,12 @@ func (x *DeploymentStrategy) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys425Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys425Slc
-	var yyhl425 bool = l >= 0
-	for yyj425 := 0; ; yyj425++ {
-		if yyhl425 {
-			if yyj425 >= l {
+	var yys435Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys435Slc
+	var yyhl435 bool = l >= 0
+	for yyj435 := 0; ; yyj435++ {
+		if yyhl435 {
+			if yyj435 >= l {
 				break
 			}
 		} else {
@@ -4731,9 +4837,9 @@ func (x *DeploymentStrategy) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 				break
 			}
 		}
-		yys425Slc = r.DecodeBytes(yys425Slc, true, true)
-		yys425 := string(yys425Slc)
-		switch yys425 {
+		yys435Slc = r.DecodeBytes(yys435Slc, true, true)
+		yys435 := string(yys435Slc)
+		switch yys435 {
 		case "type":
 			if r.TryDecodeAsNil() {
 				x.Type = ""
@@ -4752,10 +4858,10 @@ func (x *DeploymentStrategy) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 				x.RollingUpdate.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys425)
-		} // end switch yys425
-	} // end for yyj425
-	if !yyhl425 {
+			z.DecStructFieldNotFound(-1, yys435)
+		} // end switch yys435
+	} // end for yyj435
+	if !yyhl435 {
 		r.ReadEnd()
 	}
 }
@@ -4764,16 +4870,16 @@ func (x *DeploymentStrategy) codecDecodeSelfFromArray(l int, d *codec1978.Decode
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj428 int
-	var yyb428 bool
-	var yyhl428 bool = l >= 0
-	yyj428++
-	if yyhl428 {
-		yyb428 = yyj428 > l
+	var yyj438 int
+	var yyb438 bool
+	var yyhl438 bool = l >= 0
+	yyj438++
+	if yyhl438 {
+		yyb438 = yyj438 > l
 	} else {
-		yyb428 = r.CheckBreak()
+		yyb438 = r.CheckBreak()
 	}
-	if yyb428 {
+	if yyb438 {
 		r.ReadEnd()
 		return
 	}
@@ -4782,13 +4888,13 @@ func (x *DeploymentStrategy) codecDecodeSelfFromArray(l int, d *codec1978.Decode
 	} else {
 		x.Type = DeploymentStrategyType(r.DecodeString())
 	}
-	yyj428++
-	if yyhl428 {
-		yyb428 = yyj428 > l
+	yyj438++
+	if yyhl438 {
+		yyb438 = yyj438 > l
 	} else {
-		yyb428 = r.CheckBreak()
+		yyb438 = r.CheckBreak()
 	}
-	if yyb428 {
+	if yyb438 {
 		r.ReadEnd()
 		return
 	}
@@ -4803,16 +4909,16 @@ func (x *DeploymentStrategy) codecDecodeSelfFromArray(l int, d *codec1978.Decode
 		x.RollingUpdate.CodecDecodeSelf(d)
 	}
 	for {
-		yyj428++
-		if yyhl428 {
-			yyb428 = yyj428 > l
+		yyj438++
+		if yyhl438 {
+			yyb438 = yyj438 > l
 		} else {
-			yyb428 = r.CheckBreak()
+			yyb438 = r.CheckBreak()
 		}
-		if yyb428 {
+		if yyb438 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj428-1, "")
+		z.DecStructFieldNotFound(yyj438-1, "")
 	}
 	r.ReadEnd()
 }
@@ -4821,8 +4927,8 @@ func (x DeploymentStrategyType) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	yym431 := z.EncBinary()
-	_ = yym431
+	yym441 := z.EncBinary()
+	_ = yym441
 	if false {
 	} else if z.HasExtensions() && z.EncExt(x) {
 	} else {
@@ -4834,8 +4940,8 @@ func (x *DeploymentStrategyType) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym432 := z.DecBinary()
-	_ = yym432
+	yym442 := z.DecBinary()
+	_ = yym442
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
@@ -4850,94 +4956,94 @@ func (x *RollingUpdateDeployment) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym433 := z.EncBinary()
-		_ = yym433
+		yym443 := z.EncBinary()
+		_ = yym443
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep434 := !z.EncBinary()
-			yy2arr434 := z.EncBasicHandle().StructToArray
-			var yyq434 [3]bool
-			_, _, _ = yysep434, yyq434, yy2arr434
-			const yyr434 bool = false
-			yyq434[0] = true
-			yyq434[1] = true
-			yyq434[2] = x.MinReadySeconds != 0
-			if yyr434 || yy2arr434 {
+			yysep444 := !z.EncBinary()
+			yy2arr444 := z.EncBasicHandle().StructToArray
+			var yyq444 [3]bool
+			_, _, _ = yysep444, yyq444, yy2arr444
+			const yyr444 bool = false
+			yyq444[0] = true
+			yyq444[1] = true
+			yyq444[2] = x.MinReadySeconds != 0
+			if yyr444 || yy2arr444 {
 				r.EncodeArrayStart(3)
 			} else {
-				var yynn434 int = 0
-				for _, b := range yyq434 {
+				var yynn444 int = 0
+				for _, b := range yyq444 {
 					if b {
-						yynn434++
+						yynn444++
 					}
 				}
-				r.EncodeMapStart(yynn434)
+				r.EncodeMapStart(yynn444)
 			}
-			if yyr434 || yy2arr434 {
-				if yyq434[0] {
-					yy436 := &x.MaxUnavailable
-					yym437 := z.EncBinary()
-					_ = yym437
+			if yyr444 || yy2arr444 {
+				if yyq444[0] {
+					yy446 := &x.MaxUnavailable
+					yym447 := z.EncBinary()
+					_ = yym447
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy436) {
-					} else if !yym437 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy436)
+					} else if z.HasExtensions() && z.EncExt(yy446) {
+					} else if !yym447 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy446)
 					} else {
-						z.EncFallback(yy436)
+						z.EncFallback(yy446)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq434[0] {
+				if yyq444[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("maxUnavailable"))
-					yy438 := &x.MaxUnavailable
-					yym439 := z.EncBinary()
-					_ = yym439
+					yy448 := &x.MaxUnavailable
+					yym449 := z.EncBinary()
+					_ = yym449
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy438) {
-					} else if !yym439 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy438)
+					} else if z.HasExtensions() && z.EncExt(yy448) {
+					} else if !yym449 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy448)
 					} else {
-						z.EncFallback(yy438)
+						z.EncFallback(yy448)
 					}
 				}
 			}
-			if yyr434 || yy2arr434 {
-				if yyq434[1] {
-					yy441 := &x.MaxSurge
-					yym442 := z.EncBinary()
-					_ = yym442
+			if yyr444 || yy2arr444 {
+				if yyq444[1] {
+					yy451 := &x.MaxSurge
+					yym452 := z.EncBinary()
+					_ = yym452
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy441) {
-					} else if !yym442 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy441)
+					} else if z.HasExtensions() && z.EncExt(yy451) {
+					} else if !yym452 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy451)
 					} else {
-						z.EncFallback(yy441)
+						z.EncFallback(yy451)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq434[1] {
+				if yyq444[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("maxSurge"))
-					yy443 := &x.MaxSurge
-					yym444 := z.EncBinary()
-					_ = yym444
+					yy453 := &x.MaxSurge
+					yym454 := z.EncBinary()
+					_ = yym454
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy443) {
-					} else if !yym444 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy443)
+					} else if z.HasExtensions() && z.EncExt(yy453) {
+					} else if !yym454 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy453)
 					} else {
-						z.EncFallback(yy443)
+						z.EncFallback(yy453)
 					}
 				}
 			}
-			if yyr434 || yy2arr434 {
-				if yyq434[2] {
-					yym446 := z.EncBinary()
-					_ = yym446
+			if yyr444 || yy2arr444 {
+				if yyq444[2] {
+					yym456 := z.EncBinary()
+					_ = yym456
 					if false {
 					} else {
 						r.EncodeInt(int64(x.MinReadySeconds))
@@ -4946,17 +5052,17 @@ func (x *RollingUpdateDeployment) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq434[2] {
+				if yyq444[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("minReadySeconds"))
-					yym447 := z.EncBinary()
-					_ = yym447
+					yym457 := z.EncBinary()
+					_ = yym457
 					if false {
 					} else {
 						r.EncodeInt(int64(x.MinReadySeconds))
 					}
 				}
 			}
-			if yysep434 {
+			if yysep444 {
 				r.EncodeEnd()
 			}
 		}
@@ -4967,24 +5073,24 @@ func (x *RollingUpdateDeployment) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym448 := z.DecBinary()
-	_ = yym448
+	yym458 := z.DecBinary()
+	_ = yym458
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl449 := r.ReadMapStart()
-			if yyl449 == 0 {
+			yyl459 := r.ReadMapStart()
+			if yyl459 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl449, d)
+				x.codecDecodeSelfFromMap(yyl459, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl449 := r.ReadArrayStart()
-			if yyl449 == 0 {
+			yyl459 := r.ReadArrayStart()
+			if yyl459 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl449, d)
+				x.codecDecodeSelfFromArray(yyl459, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -4996,12 +5102,12 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromMap(l int, d *codec1978.Dec
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys450Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys450Slc
-	var yyhl450 bool = l >= 0
-	for yyj450 := 0; ; yyj450++ {
-		if yyhl450 {
-			if yyj450 >= l {
+	var yys460Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys460Slc
+	var yyhl460 bool = l >= 0
+	for yyj460 := 0; ; yyj460++ {
+		if yyhl460 {
+			if yyj460 >= l {
 				break
 			}
 		} else {
@@ -5009,37 +5115,37 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromMap(l int, d *codec1978.Dec
 				break
 			}
 		}
-		yys450Slc = r.DecodeBytes(yys450Slc, true, true)
-		yys450 := string(yys450Slc)
-		switch yys450 {
+		yys460Slc = r.DecodeBytes(yys460Slc, true, true)
+		yys460 := string(yys460Slc)
+		switch yys460 {
 		case "maxUnavailable":
 			if r.TryDecodeAsNil() {
 				x.MaxUnavailable = pkg6_util.IntOrString{}
 			} else {
-				yyv451 := &x.MaxUnavailable
-				yym452 := z.DecBinary()
-				_ = yym452
+				yyv461 := &x.MaxUnavailable
+				yym462 := z.DecBinary()
+				_ = yym462
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv451) {
-				} else if !yym452 && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(yyv451)
+				} else if z.HasExtensions() && z.DecExt(yyv461) {
+				} else if !yym462 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv461)
 				} else {
-					z.DecFallback(yyv451, false)
+					z.DecFallback(yyv461, false)
 				}
 			}
 		case "maxSurge":
 			if r.TryDecodeAsNil() {
 				x.MaxSurge = pkg6_util.IntOrString{}
 			} else {
-				yyv453 := &x.MaxSurge
-				yym454 := z.DecBinary()
-				_ = yym454
+				yyv463 := &x.MaxSurge
+				yym464 := z.DecBinary()
+				_ = yym464
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv453) {
-				} else if !yym454 && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(yyv453)
+				} else if z.HasExtensions() && z.DecExt(yyv463) {
+				} else if !yym464 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv463)
 				} else {
-					z.DecFallback(yyv453, false)
+					z.DecFallback(yyv463, false)
 				}
 			}
 		case "minReadySeconds":
@@ -5049,10 +5155,10 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromMap(l int, d *codec1978.Dec
 				x.MinReadySeconds = int(r.DecodeInt(codecSelferBitsize1234))
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys450)
-		} // end switch yys450
-	} // end for yyj450
-	if !yyhl450 {
+			z.DecStructFieldNotFound(-1, yys460)
+		} // end switch yys460
+	} // end for yyj460
+	if !yyhl460 {
 		r.ReadEnd()
 	}
 }
@@ -5061,64 +5167,64 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromArray(l int, d *codec1978.D
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj456 int
-	var yyb456 bool
-	var yyhl456 bool = l >= 0
-	yyj456++
-	if yyhl456 {
-		yyb456 = yyj456 > l
+	var yyj466 int
+	var yyb466 bool
+	var yyhl466 bool = l >= 0
+	yyj466++
+	if yyhl466 {
+		yyb466 = yyj466 > l
 	} else {
-		yyb456 = r.CheckBreak()
+		yyb466 = r.CheckBreak()
 	}
-	if yyb456 {
+	if yyb466 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.MaxUnavailable = pkg6_util.IntOrString{}
 	} else {
-		yyv457 := &x.MaxUnavailable
-		yym458 := z.DecBinary()
-		_ = yym458
+		yyv467 := &x.MaxUnavailable
+		yym468 := z.DecBinary()
+		_ = yym468
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv457) {
-		} else if !yym458 && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(yyv457)
+		} else if z.HasExtensions() && z.DecExt(yyv467) {
+		} else if !yym468 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv467)
 		} else {
-			z.DecFallback(yyv457, false)
+			z.DecFallback(yyv467, false)
 		}
 	}
-	yyj456++
-	if yyhl456 {
-		yyb456 = yyj456 > l
+	yyj466++
+	if yyhl466 {
+		yyb466 = yyj466 > l
 	} else {
-		yyb456 = r.CheckBreak()
+		yyb466 = r.CheckBreak()
 	}
-	if yyb456 {
+	if yyb466 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.MaxSurge = pkg6_util.IntOrString{}
 	} else {
-		yyv459 := &x.MaxSurge
-		yym460 := z.DecBinary()
-		_ = yym460
+		yyv469 := &x.MaxSurge
+		yym470 := z.DecBinary()
+		_ = yym470
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv459) {
-		} else if !yym460 && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(yyv459)
+		} else if z.HasExtensions() && z.DecExt(yyv469) {
+		} else if !yym470 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv469)
 		} else {
-			z.DecFallback(yyv459, false)
+			z.DecFallback(yyv469, false)
 		}
 	}
-	yyj456++
-	if yyhl456 {
-		yyb456 = yyj456 > l
+	yyj466++
+	if yyhl466 {
+		yyb466 = yyj466 > l
 	} else {
-		yyb456 = r.CheckBreak()
+		yyb466 = r.CheckBreak()
 	}
-	if yyb456 {
+	if yyb466 {
 		r.ReadEnd()
 		return
 	}
@@ -5128,16 +5234,16 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromArray(l int, d *codec1978.D
 		x.MinReadySeconds = int(r.DecodeInt(codecSelferBitsize1234))
 	}
 	for {
-		yyj456++
-		if yyhl456 {
-			yyb456 = yyj456 > l
+		yyj466++
+		if yyhl466 {
+			yyb466 = yyj466 > l
 		} else {
-			yyb456 = r.CheckBreak()
+			yyb466 = r.CheckBreak()
 		}
-		if yyb456 {
+		if yyb466 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj456-1, "")
+		z.DecStructFieldNotFound(yyj466-1, "")
 	}
 	r.ReadEnd()
 }
@@ -5149,33 +5255,34 @@ func (x *DeploymentStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym462 := z.EncBinary()
-		_ = yym462
+		yym472 := z.EncBinary()
+		_ = yym472
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep463 := !z.EncBinary()
-			yy2arr463 := z.EncBasicHandle().StructToArray
-			var yyq463 [2]bool
-			_, _, _ = yysep463, yyq463, yy2arr463
-			const yyr463 bool = false
-			yyq463[0] = x.Replicas != 0
-			yyq463[1] = x.UpdatedReplicas != 0
-			if yyr463 || yy2arr463 {
-				r.EncodeArrayStart(2)
+			yysep473 := !z.EncBinary()
+			yy2arr473 := z.EncBasicHandle().StructToArray
+			var yyq473 [3]bool
+			_, _, _ = yysep473, yyq473, yy2arr473
+			const yyr473 bool = false
+			yyq473[0] = x.Replicas != 0
+			yyq473[1] = x.UpdatedReplicas != 0
+			yyq473[2] = len(x.Conditions) != 0
+			if yyr473 || yy2arr473 {
+				r.EncodeArrayStart(3)
 			} else {
-				var yynn463 int = 0
-				for _, b := range yyq463 {
+				var yynn473 int = 0
+				for _, b := range yyq473 {
 					if b {
-						yynn463++
+						yynn473++
 					}
 				}
-				r.EncodeMapStart(yynn463)
+				r.EncodeMapStart(yynn473)
 			}
-			if yyr463 || yy2arr463 {
-				if yyq463[0] {
-					yym465 := z.EncBinary()
-					_ = yym465
+			if yyr473 || yy2arr473 {
+				if yyq473[0] {
+					yym475 := z.EncBinary()
+					_ = yym475
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Replicas))
@@ -5184,67 +5291,453 @@ func (x *DeploymentStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq463[0] {
+				if yyq473[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("replicas"))
-					yym466 := z.EncBinary()
-					_ = yym466
+					yym476 := z.EncBinary()
+					_ = yym476
+					if false {
+					} else {
+						r.EncodeInt(int64(x.Replicas))
+					}
+				}
+			}
+			if yyr473 || yy2arr473 {
+				if yyq473[1] {
+					yym478 := z.EncBinary()
+					_ = yym478
+					if false {
+					} else {
+						r.EncodeInt(int64(x.UpdatedReplicas))
+					}
+				} else {
+					r.EncodeInt(0)
+				}
+			} else {
+				if yyq473[1] {
+					r.EncodeString(codecSelferC_UTF81234, string("updatedReplicas"))
+					yym479 := z.EncBinary()
+					_ = yym479
+					if false {
+					} else {
+						r.EncodeInt(int64(x.UpdatedReplicas))
+					}
+				}
+			}
+			if yyr473 || yy2arr473 {
+				if yyq473[2] {
+					if x.Conditions == nil {
+						r.EncodeNil()
+					} else {
+						yym481 := z.EncBinary()
+						_ = yym481
+						if false {
+						} else {
+							h.encSliceDeploymentCondition(([]DeploymentCondition)(x.Conditions), e)
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq473[2] {
+					r.EncodeString(codecSelferC_UTF81234, string("conditions"))
+					if x.Conditions == nil {
+						r.EncodeNil()
+					} else {
+						yym482 := z.EncBinary()
+						_ = yym482
+						if false {
+						} else {
+							h.encSliceDeploymentCondition(([]DeploymentCondition)(x.Conditions), e)
+						}
+					}
+				}
+			}
+			if yysep473 {
+				r.EncodeEnd()
+			}
+		}
+	}
+}
+
+func (x *DeploymentStatus) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym483 := z.DecBinary()
+	_ = yym483
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		if r.IsContainerType(codecSelferValueTypeMap1234) {
+			yyl484 := r.ReadMapStart()
+			if yyl484 == 0 {
+				r.ReadEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl484, d)
+			}
+		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
+			yyl484 := r.ReadArrayStart()
+			if yyl484 == 0 {
+				r.ReadEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl484, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *DeploymentStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys485Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys485Slc
+	var yyhl485 bool = l >= 0
+	for yyj485 := 0; ; yyj485++ {
+		if yyhl485 {
+			if yyj485 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		yys485Slc = r.DecodeBytes(yys485Slc, true, true)
+		yys485 := string(yys485Slc)
+		switch yys485 {
+		case "replicas":
+			if r.TryDecodeAsNil() {
+				x.Replicas = 0
+			} else {
+				x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
+			}
+		case "updatedReplicas":
+			if r.TryDecodeAsNil() {
+				x.UpdatedReplicas = 0
+			} else {
+				x.UpdatedReplicas = int(r.DecodeInt(codecSelferBitsize1234))
+			}
+		case "conditions":
+			if r.TryDecodeAsNil() {
+				x.Conditions = nil
+			} else {
+				yyv488 := &x.Conditions
+				yym489 := z.DecBinary()
+				_ = yym489
+				if false {
+				} else {
+					h.decSliceDeploymentCondition((*[]DeploymentCondition)(yyv488), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys485)
+		} // end switch yys485
+	} // end for yyj485
+	if !yyhl485 {
+		r.ReadEnd()
+	}
+}
+
+func (x *DeploymentStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj490 int
+	var yyb490 bool
+	var yyhl490 bool = l >= 0
+	yyj490++
+	if yyhl490 {
+		yyb490 = yyj490 > l
+	} else {
+		yyb490 = r.CheckBreak()
+	}
+	if yyb490 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Replicas = 0
+	} else {
+		x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
+	}
+	yyj490++
+	if yyhl490 {
+		yyb490 = yyj490 > l
+	} else {
+		yyb490 = r.CheckBreak()
+	}
+	if yyb490 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.UpdatedReplicas = 0
+	} else {
+		x.UpdatedReplicas = int(r.DecodeInt(codecSelferBitsize1234))
+	}
+	yyj490++
+	if yyhl490 {
+		yyb490 = yyj490 > l
+	} else {
+		yyb490 = r.CheckBreak()
+	}
+	if yyb490 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Conditions = nil
+	} else {
+		yyv493 := &x.Conditions
+		yym494 := z.DecBinary()
+		_ = yym494
+		if false {
+		} else {
+			h.decSliceDeploymentCondition((*[]DeploymentCondition)(yyv493), d)
+		}
+	}
+	for {
+		yyj490++
+		if yyhl490 {
+			yyb490 = yyj490 > l
+		} else {
+			yyb490 = r.CheckBreak()
+		}
+		if yyb490 {
+			break
+		}
+		z.DecStructFieldNotFound(yyj490-1, "")
+	}
+	r.ReadEnd()
+}
+
+func (x DeploymentConditionType) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	yym495 := z.EncBinary()
+	_ = yym495
+	if false {
+	} else if z.HasExtensions() && z.EncExt(x) {
+	} else {
+		r.EncodeString(codecSelferC_UTF81234, string(x))
+	}
+}
+
+func (x *DeploymentConditionType) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym496 := z.DecBinary()
+	_ = yym496
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		*((*string)(x)) = r.DecodeString()
+	}
+}
+
+func (x *DeploymentCondition) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym497 := z.EncBinary()
+		_ = yym497
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep498 := !z.EncBinary()
+			yy2arr498 := z.EncBasicHandle().StructToArray
+			var yyq498 [6]bool
+			_, _, _ = yysep498, yyq498, yy2arr498
+			const yyr498 bool = false
+			yyq498[2] = true
+			yyq498[3] = true
+			yyq498[4] = x.Reason != ""
+			yyq498[5] = x.Message != ""
+			if yyr498 || yy2arr498 {
+				r.EncodeArrayStart(6)
+			} else {
+				var yynn498 int = 2
+				for _, b := range yyq498 {
+					if b {
+						yynn498++
+					}
+				}
+				r.EncodeMapStart(yynn498)
+			}
+			if yyr498 || yy2arr498 {
+				x.Type.CodecEncodeSelf(e)
+			} else {
+				r.EncodeString(codecSelferC_UTF81234, string("type"))
+				x.Type.CodecEncodeSelf(e)
+			}
+			if yyr498 || yy2arr498 {
+				yym501 := z.EncBinary()
+				_ = yym501
+				if false {
+				} else if z.HasExtensions() && z.EncExt(x.Status) {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Status))
+				}
+			} else {
+				r.EncodeString(codecSelferC_UTF81234, string("status"))
+				yym502 := z.EncBinary()
+				_ = yym502
+				if false {
+				} else if z.HasExtensions() && z.EncExt(x.Status) {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Status))
+				}
+			}
+			if yyr498 || yy2arr498 {
+				if yyq498[2] {
+					yy504 := &x.LastUpdateTime
+					yym505 := z.EncBinary()
+					_ = yym505
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy504) {
+					} else if yym505 {
+						z.EncBinaryMarshal(yy504)
+					} else if !yym505 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy504)
+					} else {
+						z.EncFallback(yy504)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq498[2] {
+					r.EncodeString(codecSelferC_UTF81234, string("lastUpdateTime"))
+					yy506 := &x.LastUpdateTime
+					yym507 := z.EncBinary()
+					_ = yym507
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy506) {
+					} else if yym507 {
+						z.EncBinaryMarshal(yy506)
+					} else if !yym507 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy506)
+					} else {
+						z.EncFallback(yy506)
+					}
+				}
+			}
+			if yyr498 || yy2arr498 {
+				if yyq498[3] {
+					yy509 := &x.LastTransitionTime
+					yym510 := z.EncBinary()
+					_ = yym510
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy509) {
+					} else if yym510 {
+						z.EncBinaryMarshal(yy509)
+					} else if !yym510 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy509)
+					} else {
+						z.EncFallback(yy509)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq498[3] {
+					r.EncodeString(codecSelferC_UTF81234, string("lastTransitionTime"))
+					yy511 := &x.LastTransitionTime
+					yym512 := z.EncBinary()
+					_ = yym512
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy511) {
+					} else if yym512 {
+						z.EncBinaryMarshal(yy511)
+					} else if !yym512 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy511)
+					} else {
+						z.EncFallback(yy511)
+					}
+				}
+			}
+			if yyr498 || yy2arr498 {
+				if yyq498[4] {
+					yym514 := z.EncBinary()
+					_ = yym514
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Reason))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq498[4] {
+					r.EncodeString(codecSelferC_UTF81234, string("reason"))
+					yym515 := z.EncBinary()
+					_ = yym515
 					if false {
 					} else {
-						r.EncodeInt(int64(x.Replicas))
+						r.EncodeString(codecSelferC_UTF81234, string(x.Reason))
 					}
 				}
 			}
-			if yyr463 || yy2arr463 {
-				if yyq463[1] {
-					yym468 := z.EncBinary()
-					_ = yym468
+			if yyr498 || yy2arr498 {
+				if yyq498[5] {
+					yym517 := z.EncBinary()
+					_ = yym517
 					if false {
 					} else {
-						r.EncodeInt(int64(x.UpdatedReplicas))
+						r.EncodeString(codecSelferC_UTF81234, string(x.Message))
 					}
 				} else {
-					r.EncodeInt(0)
+					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq463[1] {
-					r.EncodeString(codecSelferC_UTF81234, string("updatedReplicas"))
-					yym469 := z.EncBinary()
-					_ = yym469
+				if yyq498[5] {
+					r.EncodeString(codecSelferC_UTF81234, string("message"))
+					yym518 := z.EncBinary()
+					_ = yym518
 					if false {
 					} else {
-						r.EncodeInt(int64(x.UpdatedReplicas))
+						r.EncodeString(codecSelferC_UTF81234, string(x.Message))
 					}
 				}
 			}
-			if yysep463 {
+			if yysep498 {
 				r.EncodeEnd()
 			}
 		}
 	}
 }
 
-func (x *DeploymentStatus) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *DeploymentCondition) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym470 := z.DecBinary()
-	_ = yym470
+	yym519 := z.DecBinary()
+	_ = yym519
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl471 := r.ReadMapStart()
-			if yyl471 == 0 {
+			yyl520 := r.ReadMapStart()
+			if yyl520 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl471, d)
+				x.codecDecodeSelfFromMap(yyl520, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl471 := r.ReadArrayStart()
-			if yyl471 == 0 {
+			yyl520 := r.ReadArrayStart()
+			if yyl520 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl471, d)
+				x.codecDecodeSelfFromArray(yyl520, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -5252,16 +5745,16 @@ func (x *DeploymentStatus) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *DeploymentStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *DeploymentCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys472Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys472Slc
-	var yyhl472 bool = l >= 0
-	for yyj472 := 0; ; yyj472++ {
-		if yyhl472 {
-			if yyj472 >= l {
+	var yys521Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys521Slc
+	var yyhl521 bool = l >= 0
+	for yyj521 := 0; ; yyj521++ {
+		if yyhl521 {
+			if yyj521 >= l {
 				break
 			}
 		} else {
@@ -5269,78 +5762,206 @@ func (x *DeploymentStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys472Slc = r.DecodeBytes(yys472Slc, true, true)
-		yys472 := string(yys472Slc)
-		switch yys472 {
-		case "replicas":
+		yys521Slc = r.DecodeBytes(yys521Slc, true, true)
+		yys521 := string(yys521Slc)
+		switch yys521 {
+		case "type":
 			if r.TryDecodeAsNil() {
-				x.Replicas = 0
+				x.Type = ""
 			} else {
-				x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
+				x.Type = DeploymentConditionType(r.DecodeString())
 			}
-		case "updatedReplicas":
+		case "status":
 			if r.TryDecodeAsNil() {
-				x.UpdatedReplicas = 0
+				x.Status = ""
 			} else {
-				x.UpdatedReplicas = int(r.DecodeInt(codecSelferBitsize1234))
+				x.Status = pkg2_api.ConditionStatus(r.DecodeString())
+			}
+		case "lastUpdateTime":
+			if r.TryDecodeAsNil() {
+				x.LastUpdateTime = pkg1_unversioned.Time{}
+			} else {
+				yyv524 := &x.LastUpdateTime
+				yym525 := z.DecBinary()
+				_ = yym525
+				if false {
+				} else if z.HasExtensions() && z.DecExt(yyv524) {
+				} else if yym525 {
+					z.DecBinaryUnmarshal(yyv524)
+				} else if !yym525 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv524)
+				} else {
+					z.DecFallback(yyv524, false)
+				}
+			}
+		case "lastTransitionTime":
+			if r.TryDecodeAsNil() {
+				x.LastTransitionTime = pkg1_unversioned.Time{}
+			} else {
+				yyv526 := &x.LastTransitionTime
+				yym527 := z.DecBinary()
+				_ = yym527
+				if false {
+				} else if z.HasExtensions() && z.DecExt(yyv526) {
+				} else if yym527 {
+					z.DecBinaryUnmarshal(yyv526)
+				} else if !yym527 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv526)
+				} else {
+					z.DecFallback(yyv526, false)
+				}
+			}
+		case "reason":
+			if r.TryDecodeAsNil() {
+				x.Reason = ""
+			} else {
+				x.Reason = string(r.DecodeString())
+			}
+		case "message":
+			if r.TryDecodeAsNil() {
+				x.Message = ""
+			} else {
+				x.Message = string(r.DecodeString())
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys472)
-		} // end switch yys472
-	} // end for yyj472
-	if !yyhl472 {
+			z.DecStructFieldNotFound(-1, yys521)
+		} // end switch yys521
+	} // end for yyj521
+	if !yyhl521 {
 		r.ReadEnd()
 	}
 }
 
-func (x *DeploymentStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *DeploymentCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj475 int
-	var yyb475 bool
-	var yyhl475 bool = l >= 0
-	yyj475++
-	if yyhl475 {
-		yyb475 = yyj475 > l
+	var yyj530 int
+	var yyb530 bool
+	var yyhl530 bool = l >= 0
+	yyj530++
+	if yyhl530 {
+		yyb530 = yyj530 > l
 	} else {
-		yyb475 = r.CheckBreak()
+		yyb530 = r.CheckBreak()
 	}
-	if yyb475 {
+	if yyb530 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
-		x.Replicas = 0
+		x.Type = ""
 	} else {
-		x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
+		x.Type = DeploymentConditionType(r.DecodeString())
 	}
-	yyj475++
-	if yyhl475 {
-		yyb475 = yyj475 > l
+	yyj530++
+	if yyhl530 {
+		yyb530 = yyj530 > l
 	} else {
-		yyb475 = r.CheckBreak()
+		yyb530 = r.CheckBreak()
 	}
-	if yyb475 {
+	if yyb530 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
-		x.UpdatedReplicas = 0
+		x.Status = ""
 	} else {
-		x.UpdatedReplicas = int(r.DecodeInt(codecSelferBitsize1234))
+		x.Status = pkg2_api.ConditionStatus(r.DecodeString())
+	}
+	yyj530++
+	if yyhl530 {
+		yyb530 = yyj530 > l
+	} else {
+		yyb530 = r.CheckBreak()
+	}
+	if yyb530 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.LastUpdateTime = pkg1_unversioned.Time{}
+	} else {
+		yyv533 := &x.LastUpdateTime
+		yym534 := z.DecBinary()
+		_ = yym534
+		if false {
+		} else if z.HasExtensions() && z.DecExt(yyv533) {
+		} else if yym534 {
+			z.DecBinaryUnmarshal(yyv533)
+		} else if !yym534 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv533)
+		} else {
+			z.DecFallback(yyv533, false)
+		}
+	}
+	yyj530++
+	if yyhl530 {
+		yyb530 = yyj530 > l
+	} else {
+		yyb530 = r.CheckBreak()
+	}
+	if yyb530 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.LastTransitionTime = pkg1_unversioned.Time{}
+	} else {
+		yyv535 := &x.LastTransitionTime
+		yym536 := z.DecBinary()
+		_ = yym536
+		if false {
+		} else if z.HasExtensions() && z.DecExt(yyv535) {
+		} else if yym536 {
+			z.DecBinaryUnmarshal(yyv535)
+		} else if !yym536 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv535)
+		} else {
+			z.DecFallback(yyv535, false)
+		}
+	}
+	yyj530++
+	if yyhl530 {
+		yyb530 = yyj530 > l
+	} else {
+		yyb530 = r.CheckBreak()
+	}
+	if yyb530 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Reason = ""
+	} else {
+		x.Reason = string(r.DecodeString())
+	}
+	yyj530++
+	if yyhl530 {
+		yyb530 = yyj530 > l
+	} else {
+		yyb530 = r.CheckBreak()
+	}
+	if yyb530 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Message = ""
+	} else {
+		x.Message = string(r.DecodeString())
 	}
 	for {
-		yyj475++
-		if yyhl475 {
-			yyb475 = yyj475 > l
+		yyj530++
+		if yyhl530 {
+			yyb530 = yyj530 > l
 		} else {
-			yyb475 = r.CheckBreak()
+			yyb530 = r.CheckBreak()
 		}
-		if yyb475 {
+		if yyb530 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj475-1, "")
+		z.DecStructFieldNotFound(yyj530-1, "")
 	}
 	r.ReadEnd()
 }
@@ -5352,34 +5973,34 @@ func (x *DeploymentList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym478 := z.EncBinary()
-		_ = yym478
+		yym539 := z.EncBinary()
+		_ = yym539
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep479 := !z.EncBinary()
-			yy2arr479 := z.EncBasicHandle().StructToArray
-			var yyq479 [4]bool
-			_, _, _ = yysep479, yyq479, yy2arr479
-			const yyr479 bool = false
-			yyq479[0] = x.Kind != ""
-			yyq479[1] = x.APIVersion != ""
-			yyq479[2] = true
-			if yyr479 || yy2arr479 {
+			yysep540 := !z.EncBinary()
+			yy2arr540 := z.EncBasicHandle().StructToArray
+			var yyq540 [4]bool
+			_, _, _ = yysep540, yyq540, yy2arr540
+			const yyr540 bool = false
+			yyq540[0] = x.Kind != ""
+			yyq540[1] = x.APIVersion != ""
+			yyq540[2] = true
+			if yyr540 || yy2arr540 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn479 int = 1
-				for _, b := range yyq479 {
+				var yynn540 int = 1
+				for _, b := range yyq540 {
 					if b {
-						yynn479++
+						yynn540++
 					}
 				}
-				r.EncodeMapStart(yynn479)
+				r.EncodeMapStart(yynn540)
 			}
-			if yyr479 || yy2arr479 {
-				if yyq479[0] {
-					yym481 := z.EncBinary()
-					_ = yym481
+			if yyr540 || yy2arr540 {
+				if yyq540[0] {
+					yym542 := z.EncBinary()
+					_ = yym542
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -5388,20 +6009,20 @@ func (x *DeploymentList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq479[0] {
+				if yyq540[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym482 := z.EncBinary()
-					_ = yym482
+					yym543 := z.EncBinary()
+					_ = yym543
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr479 || yy2arr479 {
-				if yyq479[1] {
-					yym484 := z.EncBinary()
-					_ = yym484
+			if yyr540 || yy2arr540 {
+				if yyq540[1] {
+					yym545 := z.EncBinary()
+					_ = yym545
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -5410,48 +6031,48 @@ func (x *DeploymentList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq479[1] {
+				if yyq540[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym485 := z.EncBinary()
-					_ = yym485
+					yym546 := z.EncBinary()
+					_ = yym546
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr479 || yy2arr479 {
-				if yyq479[2] {
-					yy487 := &x.ListMeta
-					yym488 := z.EncBinary()
-					_ = yym488
+			if yyr540 || yy2arr540 {
+				if yyq540[2] {
+					yy548 := &x.ListMeta
+					yym549 := z.EncBinary()
+					_ = yym549
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy487) {
+					} else if z.HasExtensions() && z.EncExt(yy548) {
 					} else {
-						z.EncFallback(yy487)
+						z.EncFallback(yy548)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq479[2] {
+				if yyq540[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy489 := &x.ListMeta
-					yym490 := z.EncBinary()
-					_ = yym490
+					yy550 := &x.ListMeta
+					yym551 := z.EncBinary()
+					_ = yym551
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy489) {
+					} else if z.HasExtensions() && z.EncExt(yy550) {
 					} else {
-						z.EncFallback(yy489)
+						z.EncFallback(yy550)
 					}
 				}
 			}
-			if yyr479 || yy2arr479 {
+			if yyr540 || yy2arr540 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym492 := z.EncBinary()
-					_ = yym492
+					yym553 := z.EncBinary()
+					_ = yym553
 					if false {
 					} else {
 						h.encSliceDeployment(([]Deployment)(x.Items), e)
@@ -5462,15 +6083,15 @@ func (x *DeploymentList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym493 := z.EncBinary()
-					_ = yym493
+					yym554 := z.EncBinary()
+					_ = yym554
 					if false {
 					} else {
 						h.encSliceDeployment(([]Deployment)(x.Items), e)
 					}
 				}
 			}
-			if yysep479 {
+			if yysep540 {
 				r.EncodeEnd()
 			}
 		}
@@ -5481,24 +6102,24 @@ func (x *DeploymentList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym494 := z.DecBinary()
-	_ = yym494
+	yym555 := z.DecBinary()
+	_ = yym555
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl495 := r.ReadMapStart()
-			if yyl495 == 0 {
+			yyl556 := r.ReadMapStart()
+			if yyl556 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl495, d)
+				x.codecDecodeSelfFromMap(yyl556, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl495 := r.ReadArrayStart()
-			if yyl495 == 0 {
+			yyl556 := r.ReadArrayStart()
+			if yyl556 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl495, d)
+				x.codecDecodeSelfFromArray(yyl556, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -5510,12 +6131,12 @@ func (x *DeploymentList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys496Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys496Slc
-	var yyhl496 bool = l >= 0
-	for yyj496 := 0; ; yyj496++ {
-		if yyhl496 {
-			if yyj496 >= l {
+	var yys557Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys557Slc
+	var yyhl557 bool = l >= 0
+	for yyj557 := 0; ; yyj557++ {
+		if yyhl557 {
+			if yyj557 >= l {
 				break
 			}
 		} else {
@@ -5523,9 +6144,9 @@ func (x *DeploymentList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys496Slc = r.DecodeBytes(yys496Slc, true, true)
-		yys496 := string(yys496Slc)
-		switch yys496 {
+		yys557Slc = r.DecodeBytes(yys557Slc, true, true)
+		yys557 := string(yys557Slc)
+		switch yys557 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -5542,32 +6163,32 @@ func (x *DeploymentList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv499 := &x.ListMeta
-				yym500 := z.DecBinary()
-				_ = yym500
+				yyv560 := &x.ListMeta
+				yym561 := z.DecBinary()
+				_ = yym561
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv499) {
+				} else if z.HasExtensions() && z.DecExt(yyv560) {
 				} else {
-					z.DecFallback(yyv499, false)
+					z.DecFallback(yyv560, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv501 := &x.Items
-				yym502 := z.DecBinary()
-				_ = yym502
+				yyv562 := &x.Items
+				yym563 := z.DecBinary()
+				_ = yym563
 				if false {
 				} else {
-					h.decSliceDeployment((*[]Deployment)(yyv501), d)
+					h.decSliceDeployment((*[]Deployment)(yyv562), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys496)
-		} // end switch yys496
-	} // end for yyj496
-	if !yyhl496 {
+			z.DecStructFieldNotFound(-1, yys557)
+		} // end switch yys557
+	} // end for yyj557
+	if !yyhl557 {
 		r.ReadEnd()
 	}
 }
@@ -5576,16 +6197,16 @@ func (x *DeploymentList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj503 int
-	var yyb503 bool
-	var yyhl503 bool = l >= 0
-	yyj503++
-	if yyhl503 {
-		yyb503 = yyj503 > l
+	var yyj564 int
+	var yyb564 bool
+	var yyhl564 bool = l >= 0
+	yyj564++
+	if yyhl564 {
+		yyb564 = yyj564 > l
 	} else {
-		yyb503 = r.CheckBreak()
+		yyb564 = r.CheckBreak()
 	}
-	if yyb503 {
+	if yyb564 {
 		r.ReadEnd()
 		return
 	}
@@ -5594,13 +6215,13 @@ func (x *DeploymentList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj503++
-	if yyhl503 {
-		yyb503 = yyj503 > l
+	yyj564++
+	if yyhl564 {
+		yyb564 = yyj564 > l
 	} else {
-		yyb503 = r.CheckBreak()
+		yyb564 = r.CheckBreak()
 	}
-	if yyb503 {
+	if yyb564 {
 		r.ReadEnd()
 		return
 	}
@@ -5609,60 +6230,60 @@ func (x *DeploymentList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj503++
-	if yyhl503 {
-		yyb503 = yyj503 > l
+	yyj564++
+	if yyhl564 {
+		yyb564 = yyj564 > l
 	} else {
-		yyb503 = r.CheckBreak()
+		yyb564 = r.CheckBreak()
 	}
-	if yyb503 {
+	if yyb564 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv506 := &x.ListMeta
-		yym507 := z.DecBinary()
-		_ = yym507
+		yyv567 := &x.ListMeta
+		yym568 := z.DecBinary()
+		_ = yym568
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv506) {
+		} else if z.HasExtensions() && z.DecExt(yyv567) {
 		} else {
-			z.DecFallback(yyv506, false)
+			z.DecFallback(yyv567, false)
 		}
 	}
-	yyj503++
-	if yyhl503 {
-		yyb503 = yyj503 > l
+	yyj564++
+	if yyhl564 {
+		yyb564 = yyj564 > l
 	} else {
-		yyb503 = r.CheckBreak()
+		yyb564 = r.CheckBreak()
 	}
-	if yyb503 {
+	if yyb564 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv508 := &x.Items
-		yym509 := z.DecBinary()
-		_ = yym509
+		yyv569 := &x.Items
+		yym570 := z.DecBinary()
+		_ = yym570
 		if false {
 		} else {
-			h.decSliceDeployment((*[]Deployment)(yyv508), d)
+			h.decSliceDeployment((*[]Deployment)(yyv569), d)
 		}
 	}
 	for {
-		yyj503++
-		if yyhl503 {
-			yyb503 = yyj503 > l
+		yyj564++
+		if yyhl564 {
+			yyb564 = yyj564 > l
 		} else {
-			yyb503 = r.CheckBreak()
+			yyb564 = r.CheckBreak()
 		}
-		if yyb503 {
+		if yyb564 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj503-1, "")
+		z.DecStructFieldNotFound(yyj564-1, "")
 	}
 	r.ReadEnd()
 }
@@ -5674,36 +6295,36 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym510 := z.EncBinary()
-		_ = yym510
+		yym571 := z.EncBinary()
+		_ = yym571
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep511 := !z.EncBinary()
-			yy2arr511 := z.EncBasicHandle().StructToArray
-			var yyq511 [2]bool
-			_, _, _ = yysep511, yyq511, yy2arr511
-			const yyr511 bool = false
-			yyq511[0] = len(x.Selector) != 0
-			yyq511[1] = x.Template != nil
-			if yyr511 || yy2arr511 {
+			yysep572 := !z.EncBinary()
+			yy2arr572 := z.EncBasicHandle().StructToArray
+			var yyq572 [2]bool
+			_, _, _ = yysep572, yyq572, yy2arr572
+			const yyr572 bool = false
+			yyq572[0] = len(x.Selector) != 0
+			yyq572[1] = x.Template != nil
+			if yyr572 || yy2arr572 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn511 int = 0
-				for _, b := range yyq511 {
+				var yynn572 int = 0
+				for _, b := range yyq572 {
 					if b {
-						yynn511++
+						yynn572++
 					}
 				}
-				r.EncodeMapStart(yynn511)
+				r.EncodeMapStart(yynn572)
 			}
-			if yyr511 || yy2arr511 {
-				if yyq511[0] {
+			if yyr572 || yy2arr572 {
+				if yyq572[0] {
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
-						yym513 := z.EncBinary()
-						_ = yym513
+						yym574 := z.EncBinary()
+						_ = yym574
 						if false {
 						} else {
 							z.F.EncMapStringStringV(x.Selector, false, e)
@@ -5713,13 +6334,13 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq511[0] {
+				if yyq572[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("selector"))
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
-						yym514 := z.EncBinary()
-						_ = yym514
+						yym575 := z.EncBinary()
+						_ = yym575
 						if false {
 						} else {
 							z.F.EncMapStringStringV(x.Selector, false, e)
@@ -5727,8 +6348,8 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr511 || yy2arr511 {
-				if yyq511[1] {
+			if yyr572 || yy2arr572 {
+				if yyq572[1] {
 					if x.Template == nil {
 						r.EncodeNil()
 					} else {
@@ -5738,7 +6359,7 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq511[1] {
+				if yyq572[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("template"))
 					if x.Template == nil {
 						r.EncodeNil()
@@ -5747,7 +6368,7 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep511 {
+			if yysep572 {
 				r.EncodeEnd()
 			}
 		}
@@ -5758,24 +6379,24 @@ func (x *DaemonSetSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym516 := z.DecBinary()
-	_ = yym516
+	yym577 := z.DecBinary()
+	_ = yym577
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl517 := r.ReadMapStart()
-			if yyl517 == 0 {
+			yyl578 := r.ReadMapStart()
+			if yyl578 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl517, d)
+				x.codecDecodeSelfFromMap(yyl578, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl517 := r.ReadArrayStart()
-			if yyl517 == 0 {
+			yyl578 := r.ReadArrayStart()
+			if yyl578 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl517, d)
+				x.codecDecodeSelfFromArray(yyl578, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -5787,12 +6408,12 @@ func (x *DaemonSetSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys518Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys518Slc
-	var yyhl518 bool = l >= 0
-	for yyj518 := 0; ; yyj518++ {
-		if yyhl518 {
-			if yyj518 >= l {
+	var yys579Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys579Slc
+	var yyhl579 bool = l >= 0
+	for yyj579 := 0; ; yyj579++ {
+		if yyhl579 {
+			if yyj579 >= l {
 				break
 			}
 		} else {
@@ -5800,19 +6421,19 @@ func (x *DaemonSetSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys518Slc = r.DecodeBytes(yys518Slc, true, true)
-		yys518 := string(yys518Slc)
-		switch yys518 {
+		yys579Slc = r.DecodeBytes(yys579Slc, true, true)
+		yys579 := string(yys579Slc)
+		switch yys579 {
 		case "selector":
 			if r.TryDecodeAsNil() {
 				x.Selector = nil
 			} else {
-				yyv519 := &x.Selector
-				yym520 := z.DecBinary()
-				_ = yym520
+				yyv580 := &x.Selector
+				yym581 := z.DecBinary()
+				_ = yym581
 				if false {
 				} else {
-					z.F.DecMapStringStringX(yyv519, false, d)
+					z.F.DecMapStringStringX(yyv580, false, d)
 				}
 			}
 		case "template":
@@ -5827,10 +6448,10 @@ func (x *DaemonSetSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.Template.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys518)
-		} // end switch yys518
-	} // end for yyj518
-	if !yyhl518 {
+			z.DecStructFieldNotFound(-1, yys579)
+		} // end switch yys579
+	} // end for yyj579
+	if !yyhl579 {
 		r.ReadEnd()
 	}
 }
@@ -5839,37 +6460,37 @@ func (x *DaemonSetSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj522 int
-	var yyb522 bool
-	var yyhl522 bool = l >= 0
-	yyj522++
-	if yyhl522 {
-		yyb522 = yyj522 > l
+	var yyj583 int
+	var yyb583 bool
+	var yyhl583 bool = l >= 0
+	yyj583++
+	if yyhl583 {
+		yyb583 = yyj583 > l
 	} else {
-		yyb522 = r.CheckBreak()
+		yyb583 = r.CheckBreak()
 	}
-	if yyb522 {
+	if yyb583 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Selector = nil
 	} else {
-		yyv523 := &x.Selector
-		yym524 := z.DecBinary()
-		_ = yym524
+		yyv584 := &x.Selector
+		yym585 := z.DecBinary()
+		_ = yym585
 		if false {
 		} else {
-			z.F.DecMapStringStringX(yyv523, false, d)
+			z.F.DecMapStringStringX(yyv584, false, d)
 		}
 	}
-	yyj522++
-	if yyhl522 {
-		yyb522 = yyj522 > l
+	yyj583++
+	if yyhl583 {
+		yyb583 = yyj583 > l
 	} else {
-		yyb522 = r.CheckBreak()
+		yyb583 = r.CheckBreak()
 	}
-	if yyb522 {
+	if yyb583 {
 		r.ReadEnd()
 		return
 	}
@@ -5884,16 +6505,16 @@ func (x *DaemonSetSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		x.Template.CodecDecodeSelf(d)
 	}
 	for {
-		yyj522++
-		if yyhl522 {
-			yyb522 = yyj522 > l
+		yyj583++
+		if yyhl583 {
+			yyb583 = yyj583 > l
 		} else {
-			yyb522 = r.CheckBreak()
+			yyb583 = r.CheckBreak()
 		}
-		if yyb522 {
+		if yyb583 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj522-1, "")
+		z.DecStructFieldNotFound(yyj583-1, "")
 	}
 	r.ReadEnd()
 }
@@ -5905,76 +6526,76 @@ func (x *DaemonSetStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym526 := z.EncBinary()
-		_ = yym526
+		yym587 := z.EncBinary()
+		_ = yym587
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep527 := !z.EncBinary()
-			yy2arr527 := z.EncBasicHandle().StructToArray
-			var yyq527 [3]bool
-			_, _, _ = yysep527, yyq527, yy2arr527
-			const yyr527 bool = false
-			if yyr527 || yy2arr527 {
+			yysep588 := !z.EncBinary()
+			yy2arr588 := z.EncBasicHandle().StructToArray
+			var yyq588 [3]bool
+			_, _, _ = yysep588, yyq588, yy2arr588
+			const yyr588 bool = false
+			if yyr588 || yy2arr588 {
 				r.EncodeArrayStart(3)
 			} else {
-				var yynn527 int = 3
-				for _, b := range yyq527 {
+				var yynn588 int = 3
+				for _, b := range yyq588 {
 					if b {
-						yynn527++
+						yynn588++
 					}
 				}
-				r.EncodeMapStart(yynn527)
+				r.EncodeMapStart(yynn588)
 			}
-			if yyr527 || yy2arr527 {
-				yym529 := z.EncBinary()
-				_ = yym529
+			if yyr588 || yy2arr588 {
+				yym590 := z.EncBinary()
+				_ = yym590
 				if false {
 				} else {
 					r.EncodeInt(int64(x.CurrentNumberScheduled))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("currentNumberScheduled"))
-				yym530 := z.EncBinary()
-				_ = yym530
+				yym591 := z.EncBinary()
+				_ = yym591
 				if false {
 				} else {
 					r.EncodeInt(int64(x.CurrentNumberScheduled))
 				}
 			}
-			if yyr527 || yy2arr527 {
-				yym532 := z.EncBinary()
-				_ = yym532
+			if yyr588 || yy2arr588 {
+				yym593 := z.EncBinary()
+				_ = yym593
 				if false {
 				} else {
 					r.EncodeInt(int64(x.NumberMisscheduled))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("numberMisscheduled"))
-				yym533 := z.EncBinary()
-				_ = yym533
+				yym594 := z.EncBinary()
+				_ = yym594
 				if false {
 				} else {
 					r.EncodeInt(int64(x.NumberMisscheduled))
 				}
 			}
-			if yyr527 || yy2arr527 {
-				yym535 := z.EncBinary()
-				_ = yym535
+			if yyr588 || yy2arr588 {
+				yym596 := z.EncBinary()
+				_ = yym596
 				if false {
 				} else {
 					r.EncodeInt(int64(x.DesiredNumberScheduled))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("desiredNumberScheduled"))
-				yym536 := z.EncBinary()
-				_ = yym536
+				yym597 := z.EncBinary()
+				_ = yym597
 				if false {
 				} else {
 					r.EncodeInt(int64(x.DesiredNumberScheduled))
 				}
 			}
-			if yysep527 {
+			if yysep588 {
 				r.EncodeEnd()
 			}
 		}
@@ -5985,24 +6606,24 @@ func (x *DaemonSetStatus) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym537 := z.DecBinary()
-	_ = yym537
+	yym598 := z.DecBinary()
+	_ = yym598
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl538 := r.ReadMapStart()
-			if yyl538 == 0 {
+			yyl599 := r.ReadMapStart()
+			if yyl599 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl538, d)
+				x.codecDecodeSelfFromMap(yyl599, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl538 := r.ReadArrayStart()
-			if yyl538 == 0 {
+			yyl599 := r.ReadArrayStart()
+			if yyl599 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl538, d)
+				x.codecDecodeSelfFromArray(yyl599, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -6014,12 +6635,12 @@ func (x *DaemonSetStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys539Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys539Slc
-	var yyhl539 bool = l >= 0
-	for yyj539 := 0; ; yyj539++ {
-		if yyhl539 {
-			if yyj539 >= l {
+	var yys600Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys600Slc
+	var yyhl600 bool = l >= 0
+	for yyj600 := 0; ; yyj600++ {
+		if yyhl600 {
+			if yyj600 >= l {
 				break
 			}
 		} else {
@@ -6027,9 +6648,9 @@ func (x *DaemonSetStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys539Slc = r.DecodeBytes(yys539Slc, true, true)
-		yys539 := string(yys539Slc)
-		switch yys539 {
+		yys600Slc = r.DecodeBytes(yys600Slc, true, true)
+		yys600 := string(yys600Slc)
+		switch yys600 {
 		case "currentNumberScheduled":
 			if r.TryDecodeAsNil() {
 				x.CurrentNumberScheduled = 0
@@ -6049,10 +6670,10 @@ func (x *DaemonSetStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.DesiredNumberScheduled = int(r.DecodeInt(codecSelferBitsize1234))
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys539)
-		} // end switch yys539
-	} // end for yyj539
-	if !yyhl539 {
+			z.DecStructFieldNotFound(-1, yys600)
+		} // end switch yys600
+	} // end for yyj600
+	if !yyhl600 {
 		r.ReadEnd()
 	}
 }
@@ -6061,16 +6682,16 @@ func (x *DaemonSetStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj543 int
-	var yyb543 bool
-	var yyhl543 bool = l >= 0
-	yyj543++
-	if yyhl543 {
-		yyb543 = yyj543 > l
+	var yyj604 int
+	var yyb604 bool
+	var yyhl604 bool = l >= 0
+	yyj604++
+	if yyhl604 {
+		yyb604 = yyj604 > l
 	} else {
-		yyb543 = r.CheckBreak()
+		yyb604 = r.CheckBreak()
 	}
-	if yyb543 {
+	if yyb604 {
 		r.ReadEnd()
 		return
 	}
@@ -6079,13 +6700,13 @@ func (x *DaemonSetStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	} else {
 		x.CurrentNumberScheduled = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj543++
-	if yyhl543 {
-		yyb543 = yyj543 > l
+	yyj604++
+	if yyhl604 {
+		yyb604 = yyj604 > l
 	} else {
-		yyb543 = r.CheckBreak()
+		yyb604 = r.CheckBreak()
 	}
-	if yyb543 {
+	if yyb604 {
 		r.ReadEnd()
 		return
 	}
@@ -6094,13 +6715,13 @@ func (x *DaemonSetStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	} else {
 		x.NumberMisscheduled = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj543++
-	if yyhl543 {
-		yyb543 = yyj543 > l
+	yyj604++
+	if yyhl604 {
+		yyb604 = yyj604 > l
 	} else {
-		yyb543 = r.CheckBreak()
+		yyb604 = r.CheckBreak()
 	}
-	if yyb543 {
+	if yyb604 {
 		r.ReadEnd()
 		return
 	}
@@ -6110,16 +6731,16 @@ func (x *DaemonSetStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 		x.DesiredNumberScheduled = int(r.DecodeInt(codecSelferBitsize1234))
 	}
 	for {
-		yyj543++
-		if yyhl543 {
-			yyb543 = yyj543 > l
+		yyj604++
+		if yyhl604 {
+			yyb604 = yyj604 > l
 		} else {
-			yyb543 = r.CheckBreak()
+			yyb604 = r.CheckBreak()
 		}
-		if yyb543 {
+		if yyb604 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj543-1, "")
+		z.DecStructFieldNotFound(yyj604-1, "")
 	}
 	r.ReadEnd()
 }
@@ -6131,36 +6752,36 @@ func (x *DaemonSet) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym547 := z.EncBinary()
-		_ = yym547
+		yym608 := z.EncBinary()
+		_ = yym608
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep548 := !z.EncBinary()
-			yy2arr548 := z.EncBasicHandle().StructToArray
-			var yyq548 [5]bool
-			_, _, _ = yysep548, yyq548, yy2arr548
-			const yyr548 bool = false
-			yyq548[0] = x.Kind != ""
-			yyq548[1] = x.APIVersion != ""
-			yyq548[2] = true
-			yyq548[3] = true
-			yyq548[4] = true
-			if yyr548 || yy2arr548 {
+			yysep609 := !z.EncBinary()
+			yy2arr609 := z.EncBasicHandle().StructToArray
+			var yyq609 [5]bool
+			_, _, _ = yysep609, yyq609, yy2arr609
+			const yyr609 bool = false
+			yyq609[0] = x.Kind != ""
+			yyq609[1] = x.APIVersion != ""
+			yyq609[2] = true
+			yyq609[3] = true
+			yyq609[4] = true
+			if yyr609 || yy2arr609 {
 				r.EncodeArrayStart(5)
 			} else {
-				var yynn548 int = 0
-				for _, b := range yyq548 {
+				var yynn609 int = 0
+				for _, b := range yyq609 {
 					if b {
-						yynn548++
+						yynn609++
 					}
 				}
-				r.EncodeMapStart(yynn548)
+				r.EncodeMapStart(yynn609)
 			}
-			if yyr548 || yy2arr548 {
-				if yyq548[0] {
-					yym550 := z.EncBinary()
-					_ = yym550
+			if yyr609 || yy2arr609 {
+				if yyq609[0] {
+					yym611 := z.EncBinary()
+					_ = yym611
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -6169,20 +6790,20 @@ func (x *DaemonSet) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq548[0] {
+				if yyq609[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym551 := z.EncBinary()
-					_ = yym551
+					yym612 := z.EncBinary()
+					_ = yym612
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr548 || yy2arr548 {
-				if yyq548[1] {
-					yym553 := z.EncBinary()
-					_ = yym553
+			if yyr609 || yy2arr609 {
+				if yyq609[1] {
+					yym614 := z.EncBinary()
+					_ = yym614
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -6191,59 +6812,59 @@ func (x *DaemonSet) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq548[1] {
+				if yyq609[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym554 := z.EncBinary()
-					_ = yym554
+					yym615 := z.EncBinary()
+					_ = yym615
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr548 || yy2arr548 {
-				if yyq548[2] {
-					yy556 := &x.ObjectMeta
-					yy556.CodecEncodeSelf(e)
+			if yyr609 || yy2arr609 {
+				if yyq609[2] {
+					yy617 := &x.ObjectMeta
+					yy617.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq548[2] {
+				if yyq609[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy557 := &x.ObjectMeta
-					yy557.CodecEncodeSelf(e)
+					yy618 := &x.ObjectMeta
+					yy618.CodecEncodeSelf(e)
 				}
 			}
-			if yyr548 || yy2arr548 {
-				if yyq548[3] {
-					yy559 := &x.Spec
-					yy559.CodecEncodeSelf(e)
+			if yyr609 || yy2arr609 {
+				if yyq609[3] {
+					yy620 := &x.Spec
+					yy620.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq548[3] {
+				if yyq609[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("spec"))
-					yy560 := &x.Spec
-					yy560.CodecEncodeSelf(e)
+					yy621 := &x.Spec
+					yy621.CodecEncodeSelf(e)
 				}
 			}
-			if yyr548 || yy2arr548 {
-				if yyq548[4] {
-					yy562 := &x.Status
-					yy562.CodecEncodeSelf(e)
+			if yyr609 || yy2arr609 {
+				if yyq609[4] {
+					yy623 := &x.Status
+					yy623.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq548[4] {
+				if yyq609[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("status"))
-					yy563 := &x.Status
-					yy563.CodecEncodeSelf(e)
+					yy624 := &x.Status
+					yy624.CodecEncodeSelf(e)
 				}
 			}
-			if yysep548 {
+			if yysep609 {
 				r.EncodeEnd()
 			}
 		}
@@ -6254,24 +6875,24 @@ func (x *DaemonSet) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym564 := z.DecBinary()
-	_ = yym564
+	yym625 := z.DecBinary()
+	_ = yym625
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl565 := r.ReadMapStart()
-			if yyl565 == 0 {
+			yyl626 := r.ReadMapStart()
+			if yyl626 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl565, d)
+				x.codecDecodeSelfFromMap(yyl626, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl565 := r.ReadArrayStart()
-			if yyl565 == 0 {
+			yyl626 := r.ReadArrayStart()
+			if yyl626 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl565, d)
+				x.codecDecodeSelfFromArray(yyl626, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -6283,12 +6904,12 @@ func (x *DaemonSet) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys566Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys566Slc
-	var yyhl566 bool = l >= 0
-	for yyj566 := 0; ; yyj566++ {
-		if yyhl566 {
-			if yyj566 >= l {
+	var yys627Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys627Slc
+	var yyhl627 bool = l >= 0
+	for yyj627 := 0; ; yyj627++ {
+		if yyhl627 {
+			if yyj627 >= l {
 				break
 			}
 		} else {
@@ -6296,9 +6917,9 @@ func (x *DaemonSet) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys566Slc = r.DecodeBytes(yys566Slc, true, true)
-		yys566 := string(yys566Slc)
-		switch yys566 {
+		yys627Slc = r.DecodeBytes(yys627Slc, true, true)
+		yys627 := string(yys627Slc)
+		switch yys627 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -6315,28 +6936,28 @@ func (x *DaemonSet) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ObjectMeta = pkg2_api.ObjectMeta{}
 			} else {
-				yyv569 := &x.ObjectMeta
-				yyv569.CodecDecodeSelf(d)
+				yyv630 := &x.ObjectMeta
+				yyv630.CodecDecodeSelf(d)
 			}
 		case "spec":
 			if r.TryDecodeAsNil() {
 				x.Spec = DaemonSetSpec{}
 			} else {
-				yyv570 := &x.Spec
-				yyv570.CodecDecodeSelf(d)
+				yyv631 := &x.Spec
+				yyv631.CodecDecodeSelf(d)
 			}
 		case "status":
 			if r.TryDecodeAsNil() {
 				x.Status = DaemonSetStatus{}
 			} else {
-				yyv571 := &x.Status
-				yyv571.CodecDecodeSelf(d)
+				yyv632 := &x.Status
+				yyv632.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys566)
-		} // end switch yys566
-	} // end for yyj566
-	if !yyhl566 {
+			z.DecStructFieldNotFound(-1, yys627)
+		} // end switch yys627
+	} // end for yyj627
+	if !yyhl627 {
 		r.ReadEnd()
 	}
 }
@@ -6345,16 +6966,16 @@ func (x *DaemonSet) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj572 int
-	var yyb572 bool
-	var yyhl572 bool = l >= 0
-	yyj572++
-	if yyhl572 {
-		yyb572 = yyj572 > l
+	var yyj633 int
+	var yyb633 bool
+	var yyhl633 bool = l >= 0
+	yyj633++
+	if yyhl633 {
+		yyb633 = yyj633 > l
 	} else {
-		yyb572 = r.CheckBreak()
+		yyb633 = r.CheckBreak()
 	}
-	if yyb572 {
+	if yyb633 {
 		r.ReadEnd()
 		return
 	}
@@ -6363,13 +6984,13 @@ func (x *DaemonSet) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj572++
-	if yyhl572 {
-		yyb572 = yyj572 > l
+	yyj633++
+	if yyhl633 {
+		yyb633 = yyj633 > l
 	} else {
-		yyb572 = r.CheckBreak()
+		yyb633 = r.CheckBreak()
 	}
-	if yyb572 {
+	if yyb633 {
 		r.ReadEnd()
 		return
 	}
@@ -6378,65 +6999,65 @@ func (x *DaemonSet) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj572++
-	if yyhl572 {
-		yyb572 = yyj572 > l
+	yyj633++
+	if yyhl633 {
+		yyb633 = yyj633 > l
 	} else {
-		yyb572 = r.CheckBreak()
+		yyb633 = r.CheckBreak()
 	}
-	if yyb572 {
+	if yyb633 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ObjectMeta = pkg2_api.ObjectMeta{}
 	} else {
-		yyv575 := &x.ObjectMeta
-		yyv575.CodecDecodeSelf(d)
+		yyv636 := &x.ObjectMeta
+		yyv636.CodecDecodeSelf(d)
 	}
-	yyj572++
-	if yyhl572 {
-		yyb572 = yyj572 > l
+	yyj633++
+	if yyhl633 {
+		yyb633 = yyj633 > l
 	} else {
-		yyb572 = r.CheckBreak()
+		yyb633 = r.CheckBreak()
 	}
-	if yyb572 {
+	if yyb633 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Spec = DaemonSetSpec{}
 	} else {
-		yyv576 := &x.Spec
-		yyv576.CodecDecodeSelf(d)
+		yyv637 := &x.Spec
+		yyv637.CodecDecodeSelf(d)
 	}
-	yyj572++
-	if yyhl572 {
-		yyb572 = yyj572 > l
+	yyj633++
+	if yyhl633 {
+		yyb633 = yyj633 > l
 	} else {
-		yyb572 = r.CheckBreak()
+		yyb633 = r.CheckBreak()
 	}
-	if yyb572 {
+	if yyb633 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Status = DaemonSetStatus{}
 	} else {
-		yyv577 := &x.Status
-		yyv577.CodecDecodeSelf(d)
+		yyv638 := &x.Status
+		yyv638.CodecDecodeSelf(d)
 	}
 	for {
-		yyj572++
-		if yyhl572 {
-			yyb572 = yyj572 > l
+		yyj633++
+		if yyhl633 {
+			yyb633 = yyj633 > l
 		} else {
-			yyb572 = r.CheckBreak()
+			yyb633 = r.CheckBreak()
 		}
-		if yyb572 {
+		if yyb633 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj572-1, "")
+		z.DecStructFieldNotFound(yyj633-1, "")
 	}
 	r.ReadEnd()
 }
@@ -6448,34 +7069,34 @@ func (x *DaemonSetList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym578 := z.EncBinary()
-		_ = yym578
+		yym639 := z.EncBinary()
+		_ = yym639
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep579 := !z.EncBinary()
-			yy2arr579 := z.EncBasicHandle().StructToArray
-			var yyq579 [4]bool
-			_, _, _ = yysep579, yyq579, yy2arr579
-			const yyr579 bool = false
-			yyq579[0] = x.Kind != ""
-			yyq579[1] = x.APIVersion != ""
-			yyq579[2] = true
-			if yyr579 || yy2arr579 {
+			yysep640 := !z.EncBinary()
+			yy2arr640 := z.EncBasicHandle().StructToArray
+			var yyq640 [4]bool
+			_, _, _ = yysep640, yyq640, yy2arr640
+			const yyr640 bool = false
+			yyq640[0] = x.Kind != ""
+			yyq640[1] = x.APIVersion != ""
+			yyq640[2] = true
+			if yyr640 || yy2arr640 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn579 int = 1
-				for _, b := range yyq579 {
+				var yynn640 int = 1
+				for _, b := range yyq640 {
 					if b {
-						yynn579++
+						yynn640++
 					}
 				}
-				r.EncodeMapStart(yynn579)
+				r.EncodeMapStart(yynn640)
 			}
-			if yyr579 || yy2arr579 {
-				if yyq579[0] {
-					yym581 := z.EncBinary()
-					_ = yym581
+			if yyr640 || yy2arr640 {
+				if yyq640[0] {
+					yym642 := z.EncBinary()
+					_ = yym642
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -6484,20 +7105,20 @@ func (x *DaemonSetList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq579[0] {
+				if yyq640[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym582 := z.EncBinary()
-					_ = yym582
+					yym643 := z.EncBinary()
+					_ = yym643
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr579 || yy2arr579 {
-				if yyq579[1] {
-					yym584 := z.EncBinary()
-					_ = yym584
+			if yyr640 || yy2arr640 {
+				if yyq640[1] {
+					yym645 := z.EncBinary()
+					_ = yym645
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -6506,48 +7127,48 @@ func (x *DaemonSetList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq579[1] {
+				if yyq640[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym585 := z.EncBinary()
-					_ = yym585
+					yym646 := z.EncBinary()
+					_ = yym646
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr579 || yy2arr579 {
-				if yyq579[2] {
-					yy587 := &x.ListMeta
-					yym588 := z.EncBinary()
-					_ = yym588
+			if yyr640 || yy2arr640 {
+				if yyq640[2] {
+					yy648 := &x.ListMeta
+					yym649 := z.EncBinary()
+					_ = yym649
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy587) {
+					} else if z.HasExtensions() && z.EncExt(yy648) {
 					} else {
-						z.EncFallback(yy587)
+						z.EncFallback(yy648)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq579[2] {
+				if yyq640[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy589 := &x.ListMeta
-					yym590 := z.EncBinary()
-					_ = yym590
+					yy650 := &x.ListMeta
+					yym651 := z.EncBinary()
+					_ = yym651
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy589) {
+					} else if z.HasExtensions() && z.EncExt(yy650) {
 					} else {
-						z.EncFallback(yy589)
+						z.EncFallback(yy650)
 					}
 				}
 			}
-			if yyr579 || yy2arr579 {
+			if yyr640 || yy2arr640 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym592 := z.EncBinary()
-					_ = yym592
+					yym653 := z.EncBinary()
+					_ = yym653
 					if false {
 					} else {
 						h.encSliceDaemonSet(([]DaemonSet)(x.Items), e)
@@ -6558,15 +7179,15 @@ func (x *DaemonSetList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym593 := z.EncBinary()
-					_ = yym593
+					yym654 := z.EncBinary()
+					_ = yym654
 					if false {
 					} else {
 						h.encSliceDaemonSet(([]DaemonSet)(x.Items), e)
 					}
 				}
 			}
-			if yysep579 {
+			if yysep640 {
 				r.EncodeEnd()
 			}
 		}
@@ -6577,24 +7198,24 @@ func (x *DaemonSetList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym594 := z.DecBinary()
-	_ = yym594
+	yym655 := z.DecBinary()
+	_ = yym655
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl595 := r.ReadMapStart()
-			if yyl595 == 0 {
+			yyl656 := r.ReadMapStart()
+			if yyl656 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl595, d)
+				x.codecDecodeSelfFromMap(yyl656, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl595 := r.ReadArrayStart()
-			if yyl595 == 0 {
+			yyl656 := r.ReadArrayStart()
+			if yyl656 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl595, d)
+				x.codecDecodeSelfFromArray(yyl656, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -6606,12 +7227,12 @@ func (x *DaemonSetList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys596Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys596Slc
-	var yyhl596 bool = l >= 0
-	for yyj596 := 0; ; yyj596++ {
-		if yyhl596 {
-			if yyj596 >= l {
+	var yys657Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys657Slc
+	var yyhl657 bool = l >= 0
+	for yyj657 := 0; ; yyj657++ {
+		if yyhl657 {
+			if yyj657 >= l {
 				break
 			}
 		} else {
@@ -6619,9 +7240,9 @@ func (x *DaemonSetList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys596Slc = r.DecodeBytes(yys596Slc, true, true)
-		yys596 := string(yys596Slc)
-		switch yys596 {
+		yys657Slc = r.DecodeBytes(yys657Slc, true, true)
+		yys657 := string(yys657Slc)
+		switch yys657 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -6638,32 +7259,32 @@ func (x *DaemonSetList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv599 := &x.ListMeta
-				yym600 := z.DecBinary()
-				_ = yym600
+				yyv660 := &x.ListMeta
+				yym661 := z.DecBinary()
+				_ = yym661
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv599) {
+				} else if z.HasExtensions() && z.DecExt(yyv660) {
 				} else {
-					z.DecFallback(yyv599, false)
+					z.DecFallback(yyv660, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv601 := &x.Items
-				yym602 := z.DecBinary()
-				_ = yym602
+				yyv662 := &x.Items
+				yym663 := z.DecBinary()
+				_ = yym663
 				if false {
 				} else {
-					h.decSliceDaemonSet((*[]DaemonSet)(yyv601), d)
+					h.decSliceDaemonSet((*[]DaemonSet)(yyv662), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys596)
-		} // end switch yys596
-	} // end for yyj596
-	if !yyhl596 {
+			z.DecStructFieldNotFound(-1, yys657)
+		} // end switch yys657
+	} // end for yyj657
+	if !yyhl657 {
 		r.ReadEnd()
 	}
 }
@@ -6672,16 +7293,16 @@ func (x *DaemonSetList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj603 int
-	var yyb603 bool
-	var yyhl603 bool = l >= 0
-	yyj603++
-	if yyhl603 {
-		yyb603 = yyj603 > l
+	var yyj664 int
+	var yyb664 bool
+	var yyhl664 bool = l >= 0
+	yyj664++
+	if yyhl664 {
+		yyb664 = yyj664 > l
 	} else {
-		yyb603 = r.CheckBreak()
+		yyb664 = r.CheckBreak()
 	}
-	if yyb603 {
+	if yyb664 {
 		r.ReadEnd()
 		return
 	}
@@ -6690,13 +7311,13 @@ func (x *DaemonSetList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj603++
-	if yyhl603 {
-		yyb603 = yyj603 > l
+	yyj664++
+	if yyhl664 {
+		yyb664 = yyj664 > l
 	} else {
-		yyb603 = r.CheckBreak()
+		yyb664 = r.CheckBreak()
 	}
-	if yyb603 {
+	if yyb664 {
 		r.ReadEnd()
 		return
 	}
@@ -6705,60 +7326,60 @@ func (x *DaemonSetList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj603++
-	if yyhl603 {
-		yyb603 = yyj603 > l
+	yyj664++
+	if yyhl664 {
+		yyb664 = yyj664 > l
 	} else {
-		yyb603 = r.CheckBreak()
+		yyb664 = r.CheckBreak()
 	}
-	if yyb603 {
+	if yyb664 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv606 := &x.ListMeta
-		yym607 := z.DecBinary()
-		_ = yym607
+		yyv667 := &x.ListMeta
+		yym668 := z.DecBinary()
+		_ = yym668
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv606) {
+		} else if z.HasExtensions() && z.DecExt(yyv667) {
 		} else {
-			z.DecFallback(yyv606, false)
+			z.DecFallback(yyv667, false)
 		}
 	}
-	yyj603++
-	if yyhl603 {
-		yyb603 = yyj603 > l
+	yyj664++
+	if yyhl664 {
+		yyb664 = yyj664 > l
 	} else {
-		yyb603 = r.CheckBreak()
+		yyb664 = r.CheckBreak()
 	}
-	if yyb603 {
+	if yyb664 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv608 := &x.Items
-		yym609 := z.DecBinary()
-		_ = yym609
+		yyv669 := &x.Items
+		yym670 := z.DecBinary()
+		_ = yym670
 		if false {
 		} else {
-			h.decSliceDaemonSet((*[]DaemonSet)(yyv608), d)
+			h.decSliceDaemonSet((*[]DaemonSet)(yyv669), d)
 		}
 	}
 	for {
-		yyj603++
-		if yyhl603 {
-			yyb603 = yyj603 > l
+		yyj664++
+		if yyhl664 {
+			yyb664 = yyj664 > l
 		} else {
-			yyb603 = r.CheckBreak()
+			yyb664 = r.CheckBreak()
 		}
-		if yyb603 {
+		if yyb664 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj603-1, "")
+		z.DecStructFieldNotFound(yyj664-1, "")
 	}
 	r.ReadEnd()
 }
@@ -6770,34 +7391,34 @@ func (x *ThirdPartyResourceDataList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym610 := z.EncBinary()
-		_ = yym610
+		yym671 := z.EncBinary()
+		_ = yym671
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep611 := !z.EncBinary()
-			yy2arr611 := z.EncBasicHandle().StructToArray
-			var yyq611 [4]bool
-			_, _, _ = yysep611, yyq611, yy2arr611
-			const yyr611 bool = false
-			yyq611[0] = x.Kind != ""
-			yyq611[1] = x.APIVersion != ""
-			yyq611[2] = true
-			if yyr611 || yy2arr611 {
+			yysep672 := !z.EncBinary()
+			yy2arr672 := z.EncBasicHandle().StructToArray
+			var yyq672 [4]bool
+			_, _, _ = yysep672, yyq672, yy2arr672
+			const yyr672 bool = false
+			yyq672[0] = x.Kind != ""
+			yyq672[1] = x.APIVersion != ""
+			yyq672[2] = true
+			if yyr672 || yy2arr672 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn611 int = 1
-				for _, b := range yyq611 {
+				var yynn672 int = 1
+				for _, b := range yyq672 {
 					if b {
-						yynn611++
+						yynn672++
 					}
 				}
-				r.EncodeMapStart(yynn611)
+				r.EncodeMapStart(yynn672)
 			}
-			if yyr611 || yy2arr611 {
-				if yyq611[0] {
-					yym613 := z.EncBinary()
-					_ = yym613
+			if yyr672 || yy2arr672 {
+				if yyq672[0] {
+					yym674 := z.EncBinary()
+					_ = yym674
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -6806,20 +7427,20 @@ func (x *ThirdPartyResourceDataList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq611[0] {
+				if yyq672[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym614 := z.EncBinary()
-					_ = yym614
+					yym675 := z.EncBinary()
+					_ = yym675
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr611 || yy2arr611 {
-				if yyq611[1] {
-					yym616 := z.EncBinary()
-					_ = yym616
+			if yyr672 || yy2arr672 {
+				if yyq672[1] {
+					yym677 := z.EncBinary()
+					_ = yym677
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -6828,48 +7449,48 @@ func (x *ThirdPartyResourceDataList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq611[1] {
+				if yyq672[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym617 := z.EncBinary()
-					_ = yym617
+					yym678 := z.EncBinary()
+					_ = yym678
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr611 || yy2arr611 {
-				if yyq611[2] {
-					yy619 := &x.ListMeta
-					yym620 := z.EncBinary()
-					_ = yym620
+			if yyr672 || yy2arr672 {
+				if yyq672[2] {
+					yy680 := &x.ListMeta
+					yym681 := z.EncBinary()
+					_ = yym681
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy619) {
+					} else if z.HasExtensions() && z.EncExt(yy680) {
 					} else {
-						z.EncFallback(yy619)
+						z.EncFallback(yy680)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq611[2] {
+				if yyq672[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy621 := &x.ListMeta
-					yym622 := z.EncBinary()
-					_ = yym622
+					yy682 := &x.ListMeta
+					yym683 := z.EncBinary()
+					_ = yym683
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy621) {
+					} else if z.HasExtensions() && z.EncExt(yy682) {
 					} else {
-						z.EncFallback(yy621)
+						z.EncFallback(yy682)
 					}
 				}
 			}
-			if yyr611 || yy2arr611 {
+			if yyr672 || yy2arr672 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym624 := z.EncBinary()
-					_ = yym624
+					yym685 := z.EncBinary()
+					_ = yym685
 					if false {
 					} else {
 						h.encSliceThirdPartyResourceData(([]ThirdPartyResourceData)(x.Items), e)
@@ -6880,15 +7501,15 @@ func (x *ThirdPartyResourceDataList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym625 := z.EncBinary()
-					_ = yym625
+					yym686 := z.EncBinary()
+					_ = yym686
 					if false {
 					} else {
 						h.encSliceThirdPartyResourceData(([]ThirdPartyResourceData)(x.Items), e)
 					}
 				}
 			}
-			if yysep611 {
+			if yysep672 {
 				r.EncodeEnd()
 			}
 		}
@@ -6899,24 +7520,24 @@ func (x *ThirdPartyResourceDataList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym626 := z.DecBinary()
-	_ = yym626
+	yym687 := z.DecBinary()
+	_ = yym687
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl627 := r.ReadMapStart()
-			if yyl627 == 0 {
+			yyl688 := r.ReadMapStart()
+			if yyl688 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl627, d)
+				x.codecDecodeSelfFromMap(yyl688, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl627 := r.ReadArrayStart()
-			if yyl627 == 0 {
+			yyl688 := r.ReadArrayStart()
+			if yyl688 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl627, d)
+				x.codecDecodeSelfFromArray(yyl688, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -6928,12 +7549,12 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromMap(l int, d *codec1978.
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys628Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys628Slc
-	var yyhl628 bool = l >= 0
-	for yyj628 := 0; ; yyj628++ {
-		if yyhl628 {
-			if yyj628 >= l {
+	var yys689Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys689Slc
+	var yyhl689 bool = l >= 0
+	for yyj689 := 0; ; yyj689++ {
+		if yyhl689 {
+			if yyj689 >= l {
 				break
 			}
 		} else {
@@ -6941,9 +7562,9 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromMap(l int, d *codec1978.
 				break
 			}
 		}
-		yys628Slc = r.DecodeBytes(yys628Slc, true, true)
-		yys628 := string(yys628Slc)
-		switch yys628 {
+		yys689Slc = r.DecodeBytes(yys689Slc, true, true)
+		yys689 := string(yys689Slc)
+		switch yys689 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -6960,32 +7581,32 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromMap(l int, d *codec1978.
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv631 := &x.ListMeta
-				yym632 := z.DecBinary()
-				_ = yym632
+				yyv692 := &x.ListMeta
+				yym693 := z.DecBinary()
+				_ = yym693
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv631) {
+				} else if z.HasExtensions() && z.DecExt(yyv692) {
 				} else {
-					z.DecFallback(yyv631, false)
+					z.DecFallback(yyv692, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv633 := &x.Items
-				yym634 := z.DecBinary()
-				_ = yym634
+				yyv694 := &x.Items
+				yym695 := z.DecBinary()
+				_ = yym695
 				if false {
 				} else {
-					h.decSliceThirdPartyResourceData((*[]ThirdPartyResourceData)(yyv633), d)
+					h.decSliceThirdPartyResourceData((*[]ThirdPartyResourceData)(yyv694), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys628)
-		} // end switch yys628
-	} // end for yyj628
-	if !yyhl628 {
+			z.DecStructFieldNotFound(-1, yys689)
+		} // end switch yys689
+	} // end for yyj689
+	if !yyhl689 {
 		r.ReadEnd()
 	}
 }
@@ -6994,16 +7615,16 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromArray(l int, d *codec197
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj635 int
-	var yyb635 bool
-	var yyhl635 bool = l >= 0
-	yyj635++
-	if yyhl635 {
-		yyb635 = yyj635 > l
+	var yyj696 int
+	var yyb696 bool
+	var yyhl696 bool = l >= 0
+	yyj696++
+	if yyhl696 {
+		yyb696 = yyj696 > l
 	} else {
-		yyb635 = r.CheckBreak()
+		yyb696 = r.CheckBreak()
 	}
-	if yyb635 {
+	if yyb696 {
 		r.ReadEnd()
 		return
 	}
@@ -7012,13 +7633,13 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromArray(l int, d *codec197
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj635++
-	if yyhl635 {
-		yyb635 = yyj635 > l
+	yyj696++
+	if yyhl696 {
+		yyb696 = yyj696 > l
 	} else {
-		yyb635 = r.CheckBreak()
+		yyb696 = r.CheckBreak()
 	}
-	if yyb635 {
+	if yyb696 {
 		r.ReadEnd()
 		return
 	}
@@ -7027,60 +7648,60 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromArray(l int, d *codec197
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj635++
-	if yyhl635 {
-		yyb635 = yyj635 > l
+	yyj696++
+	if yyhl696 {
+		yyb696 = yyj696 > l
 	} else {
-		yyb635 = r.CheckBreak()
+		yyb696 = r.CheckBreak()
 	}
-	if yyb635 {
+	if yyb696 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv638 := &x.ListMeta
-		yym639 := z.DecBinary()
-		_ = yym639
+		yyv699 := &x.ListMeta
+		yym700 := z.DecBinary()
+		_ = yym700
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv638) {
+		} else if z.HasExtensions() && z.DecExt(yyv699) {
 		} else {
-			z.DecFallback(yyv638, false)
+			z.DecFallback(yyv699, false)
 		}
 	}
-	yyj635++
-	if yyhl635 {
-		yyb635 = yyj635 > l
+	yyj696++
+	if yyhl696 {
+		yyb696 = yyj696 > l
 	} else {
-		yyb635 = r.CheckBreak()
+		yyb696 = r.CheckBreak()
 	}
-	if yyb635 {
+	if yyb696 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv640 := &x.Items
-		yym641 := z.DecBinary()
-		_ = yym641
+		yyv701 := &x.Items
+		yym702 := z.DecBinary()
+		_ = yym702
 		if false {
 		} else {
-			h.decSliceThirdPartyResourceData((*[]ThirdPartyResourceData)(yyv640), d)
+			h.decSliceThirdPartyResourceData((*[]ThirdPartyResourceData)(yyv701), d)
 		}
 	}
 	for {
-		yyj635++
-		if yyhl635 {
-			yyb635 = yyj635 > l
+		yyj696++
+		if yyhl696 {
+			yyb696 = yyj696 > l
 		} else {
-			yyb635 = r.CheckBreak()
+			yyb696 = r.CheckBreak()
 		}
-		if yyb635 {
+		if yyb696 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj635-1, "")
+		z.DecStructFieldNotFound(yyj696-1, "")
 	}
 	r.ReadEnd()
 }
@@ -7092,36 +7713,36 @@ func (x *Job) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym642 := z.EncBinary()
-		_ = yym642
+		yym703 := z.EncBinary()
+		_ = yym703
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep643 := !z.EncBinary()
-			yy2arr643 := z.EncBasicHandle().StructToArray
-			var yyq643 [5]bool
-			_, _, _ = yysep643, yyq643, yy2arr643
-			const yyr643 bool = false
-			yyq643[0] = x.Kind != ""
-			yyq643[1] = x.APIVersion != ""
-			yyq643[2] = true
-			yyq643[3] = true
-			yyq643[4] = true
-			if yyr643 || yy2arr643 {
+			yysep704 := !z.EncBinary()
+			yy2arr704 := z.EncBasicHandle().StructToArray
+			var yyq704 [5]bool
+			_, _, _ = yysep704, yyq704, yy2arr704
+			const yyr704 bool = false
+			yyq704[0] = x.Kind != ""
+			yyq704[1] = x.APIVersion != ""
+			yyq704[2] = true
+			yyq704[3] = true
+			yyq704[4] = true
+			if yyr704 || yy2arr704 {
 				r.EncodeArrayStart(5)
 			} else {
-				var yynn643 int = 0
-				for _, b := range yyq643 {
+				var yynn704 int = 0
+				for _, b := range yyq704 {
 					if b {
-						yynn643++
+						yynn704++
 					}
 				}
-				r.EncodeMapStart(yynn643)
+				r.EncodeMapStart(yynn704)
 			}
-			if yyr643 || yy2arr643 {
-				if yyq643[0] {
-					yym645 := z.EncBinary()
-					_ = yym645
+			if yyr704 || yy2arr704 {
+				if yyq704[0] {
+					yym706 := z.EncBinary()
+					_ = yym706
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -7130,20 +7751,20 @@ func (x *Job) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq643[0] {
+				if yyq704[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym646 := z.EncBinary()
-					_ = yym646
+					yym707 := z.EncBinary()
+					_ = yym707
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr643 || yy2arr643 {
-				if yyq643[1] {
-					yym648 := z.EncBinary()
-					_ = yym648
+			if yyr704 || yy2arr704 {
+				if yyq704[1] {
+					yym709 := z.EncBinary()
+					_ = yym709
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -7152,59 +7773,59 @@ func (x *Job) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq643[1] {
+				if yyq704[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym649 := z.EncBinary()
-					_ = yym649
+					yym710 := z.EncBinary()
+					_ = yym710
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr643 || yy2arr643 {
-				if yyq643[2] {
-					yy651 := &x.ObjectMeta
-					yy651.CodecEncodeSelf(e)
+			if yyr704 || yy2arr704 {
+				if yyq704[2] {
+					yy712 := &x.ObjectMeta
+					yy712.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq643[2] {
+				if yyq704[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy652 := &x.ObjectMeta
-					yy652.CodecEncodeSelf(e)
+					yy713 := &x.ObjectMeta
+					yy713.CodecEncodeSelf(e)
 				}
 			}
-			if yyr643 || yy2arr643 {
-				if yyq643[3] {
-					yy654 := &x.Spec
-					yy654.CodecEncodeSelf(e)
+			if yyr704 || yy2arr704 {
+				if yyq704[3] {
+					yy715 := &x.Spec
+					yy715.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq643[3] {
+				if yyq704[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("spec"))
-					yy655 := &x.Spec
-					yy655.CodecEncodeSelf(e)
+					yy716 := &x.Spec
+					yy716.CodecEncodeSelf(e)
 				}
 			}
-			if yyr643 || yy2arr643 {
-				if yyq643[4] {
-					yy657 := &x.Status
-					yy657.CodecEncodeSelf(e)
+			if yyr704 || yy2arr704 {
+				if yyq704[4] {
+					yy718 := &x.Status
+					yy718.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq643[4] {
+				if yyq704[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("status"))
-					yy658 := &x.Status
-					yy658.CodecEncodeSelf(e)
+					yy719 := &x.Status
+					yy719.CodecEncodeSelf(e)
 				}
 			}
-			if yysep643 {
+			if yysep704 {
 				r.EncodeEnd()
 			}
 		}
@@ -7215,24 +7836,24 @@ func (x *Job) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym659 := z.DecBinary()
-	_ = yym659
+	yym720 := z.DecBinary()
+	_ = yym720
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl660 := r.ReadMapStart()
-			if yyl660 == 0 {
+			yyl721 := r.ReadMapStart()
+			if yyl721 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl660, d)
+				x.codecDecodeSelfFromMap(yyl721, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl660 := r.ReadArrayStart()
-			if yyl660 == 0 {
+			yyl721 := r.ReadArrayStart()
+			if yyl721 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl660, d)
+				x.codecDecodeSelfFromArray(yyl721, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -7244,12 +7865,12 @@ func (x *Job) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys661Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys661Slc
-	var yyhl661 bool = l >= 0
-	for yyj661 := 0; ; yyj661++ {
-		if yyhl661 {
-			if yyj661 >= l {
+	var yys722Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys722Slc
+	var yyhl722 bool = l >= 0
+	for yyj722 := 0; ; yyj722++ {
+		if yyhl722 {
+			if yyj722 >= l {
 				break
 			}
 		} else {
@@ -7257,9 +7878,9 @@ func (x *Job) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys661Slc = r.DecodeBytes(yys661Slc, true, true)
-		yys661 := string(yys661Slc)
-		switch yys661 {
+		yys722Slc = r.DecodeBytes(yys722Slc, true, true)
+		yys722 := string(yys722Slc)
+		switch yys722 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -7276,28 +7897,28 @@ func (x *Job) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ObjectMeta = pkg2_api.ObjectMeta{}
 			} else {
-				yyv664 := &x.ObjectMeta
-				yyv664.CodecDecodeSelf(d)
+				yyv725 := &x.ObjectMeta
+				yyv725.CodecDecodeSelf(d)
 			}
 		case "spec":
 			if r.TryDecodeAsNil() {
 				x.Spec = JobSpec{}
 			} else {
-				yyv665 := &x.Spec
-				yyv665.CodecDecodeSelf(d)
+				yyv726 := &x.Spec
+				yyv726.CodecDecodeSelf(d)
 			}
 		case "status":
 			if r.TryDecodeAsNil() {
 				x.Status = JobStatus{}
 			} else {
-				yyv666 := &x.Status
-				yyv666.CodecDecodeSelf(d)
+				yyv727 := &x.Status
+				yyv727.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys661)
-		} // end switch yys661
-	} // end for yyj661
-	if !yyhl661 {
+			z.DecStructFieldNotFound(-1, yys722)
+		} // end switch yys722
+	} // end for yyj722
+	if !yyhl722 {
 		r.ReadEnd()
 	}
 }
@@ -7306,16 +7927,16 @@ func (x *Job) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj667 int
-	var yyb667 bool
-	var yyhl667 bool = l >= 0
-	yyj667++
-	if yyhl667 {
-		yyb667 = yyj667 > l
+	var yyj728 int
+	var yyb728 bool
+	var yyhl728 bool = l >= 0
+	yyj728++
+	if yyhl728 {
+		yyb728 = yyj728 > l
 	} else {
-		yyb667 = r.CheckBreak()
+		yyb728 = r.CheckBreak()
 	}
-	if yyb667 {
+	if yyb728 {
 		r.ReadEnd()
 		return
 	}
@@ -7324,13 +7945,13 @@ func (x *Job) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj667++
-	if yyhl667 {
-		yyb667 = yyj667 > l
+	yyj728++
+	if yyhl728 {
+		yyb728 = yyj728 > l
 	} else {
-		yyb667 = r.CheckBreak()
+		yyb728 = r.CheckBreak()
 	}
-	if yyb667 {
+	if yyb728 {
 		r.ReadEnd()
 		return
 	}
@@ -7339,65 +7960,65 @@ func (x *Job) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj667++
-	if yyhl667 {
-		yyb667 = yyj667 > l
+	yyj728++
+	if yyhl728 {
+		yyb728 = yyj728 > l
 	} else {
-		yyb667 = r.CheckBreak()
+		yyb728 = r.CheckBreak()
 	}
-	if yyb667 {
+	if yyb728 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ObjectMeta = pkg2_api.ObjectMeta{}
 	} else {
-		yyv670 := &x.ObjectMeta
-		yyv670.CodecDecodeSelf(d)
+		yyv731 := &x.ObjectMeta
+		yyv731.CodecDecodeSelf(d)
 	}
-	yyj667++
-	if yyhl667 {
-		yyb667 = yyj667 > l
+	yyj728++
+	if yyhl728 {
+		yyb728 = yyj728 > l
 	} else {
-		yyb667 = r.CheckBreak()
+		yyb728 = r.CheckBreak()
 	}
-	if yyb667 {
+	if yyb728 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Spec = JobSpec{}
 	} else {
-		yyv671 := &x.Spec
-		yyv671.CodecDecodeSelf(d)
+		yyv732 := &x.Spec
+		yyv732.CodecDecodeSelf(d)
 	}
-	yyj667++
-	if yyhl667 {
-		yyb667 = yyj667 > l
+	yyj728++
+	if yyhl728 {
+		yyb728 = yyj728 > l
 	} else {
-		yyb667 = r.CheckBreak()
+		yyb728 = r.CheckBreak()
 	}
-	if yyb667 {
+	if yyb728 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Status = JobStatus{}
 	} else {
-		yyv672 := &x.Status
-		yyv672.CodecDecodeSelf(d)
+		yyv733 := &x.Status
+		yyv733.CodecDecodeSelf(d)
 	}
 	for {
-		yyj667++
-		if yyhl667 {
-			yyb667 = yyj667 > l
+		yyj728++
+		if yyhl728 {
+			yyb728 = yyj728 > l
 		} else {
-			yyb667 = r.CheckBreak()
+			yyb728 = r.CheckBreak()
 		}
-		if yyb667 {
+		if yyb728 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj667-1, "")
+		z.DecStructFieldNotFound(yyj728-1, "")
 	}
 	r.ReadEnd()
 }
@@ -7409,34 +8030,34 @@ func (x *JobList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym673 := z.EncBinary()
-		_ = yym673
+		yym734 := z.EncBinary()
+		_ = yym734
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep674 := !z.EncBinary()
-			yy2arr674 := z.EncBasicHandle().StructToArray
-			var yyq674 [4]bool
-			_, _, _ = yysep674, yyq674, yy2arr674
-			const yyr674 bool = false
-			yyq674[0] = x.Kind != ""
-			yyq674[1] = x.APIVersion != ""
-			yyq674[2] = true
-			if yyr674 || yy2arr674 {
+			yysep735 := !z.EncBinary()
+			yy2arr735 := z.EncBasicHandle().StructToArray
+			var yyq735 [4]bool
+			_, _, _ = yysep735, yyq735, yy2arr735
+			const yyr735 bool = false
+			yyq735[0] = x.Kind != ""
+			yyq735[1] = x.APIVersion != ""
+			yyq735[2] = true
+			if yyr735 || yy2arr735 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn674 int = 1
-				for _, b := range yyq674 {
+				var yynn735 int = 1
+				for _, b := range yyq735 {
 					if b {
-						yynn674++
+						yynn735++
 					}
 				}
-				r.EncodeMapStart(yynn674)
+				r.EncodeMapStart(yynn735)
 			}
-			if yyr674 || yy2arr674 {
-				if yyq674[0] {
-					yym676 := z.EncBinary()
-					_ = yym676
+			if yyr735 || yy2arr735 {
+				if yyq735[0] {
+					yym737 := z.EncBinary()
+					_ = yym737
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -7445,20 +8066,20 @@ func (x *JobList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq674[0] {
+				if yyq735[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym677 := z.EncBinary()
-					_ = yym677
+					yym738 := z.EncBinary()
+					_ = yym738
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr674 || yy2arr674 {
-				if yyq674[1] {
-					yym679 := z.EncBinary()
-					_ = yym679
+			if yyr735 || yy2arr735 {
+				if yyq735[1] {
+					yym740 := z.EncBinary()
+					_ = yym740
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -7467,48 +8088,48 @@ func (x *JobList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq674[1] {
+				if yyq735[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym680 := z.EncBinary()
-					_ = yym680
+					yym741 := z.EncBinary()
+					_ = yym741
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr674 || yy2arr674 {
-				if yyq674[2] {
-					yy682 := &x.ListMeta
-					yym683 := z.EncBinary()
-					_ = yym683
+			if yyr735 || yy2arr735 {
+				if yyq735[2] {
+					yy743 := &x.ListMeta
+					yym744 := z.EncBinary()
+					_ = yym744
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy682) {
+					} else if z.HasExtensions() && z.EncExt(yy743) {
 					} else {
-						z.EncFallback(yy682)
+						z.EncFallback(yy743)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq674[2] {
+				if yyq735[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy684 := &x.ListMeta
-					yym685 := z.EncBinary()
-					_ = yym685
+					yy745 := &x.ListMeta
+					yym746 := z.EncBinary()
+					_ = yym746
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy684) {
+					} else if z.HasExtensions() && z.EncExt(yy745) {
 					} else {
-						z.EncFallback(yy684)
+						z.EncFallback(yy745)
 					}
 				}
 			}
-			if yyr674 || yy2arr674 {
+			if yyr735 || yy2arr735 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym687 := z.EncBinary()
-					_ = yym687
+					yym748 := z.EncBinary()
+					_ = yym748
 					if false {
 					} else {
 						h.encSliceJob(([]Job)(x.Items), e)
@@ -7519,15 +8140,15 @@ func (x *JobList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym688 := z.EncBinary()
-					_ = yym688
+					yym749 := z.EncBinary()
+					_ = yym749
 					if false {
 					} else {
 						h.encSliceJob(([]Job)(x.Items), e)
 					}
 				}
 			}
-			if yysep674 {
+			if yysep735 {
 				r.EncodeEnd()
 			}
 		}
@@ -7538,24 +8159,24 @@ func (x *JobList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym689 := z.DecBinary()
-	_ = yym689
+	yym750 := z.DecBinary()
+	_ = yym750
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl690 := r.ReadMapStart()
-			if yyl690 == 0 {
+			yyl751 := r.ReadMapStart()
+			if yyl751 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl690, d)
+				x.codecDecodeSelfFromMap(yyl751, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl690 := r.ReadArrayStart()
-			if yyl690 == 0 {
+			yyl751 := r.ReadArrayStart()
+			if yyl751 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl690, d)
+				x.codecDecodeSelfFromArray(yyl751, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -7567,12 +8188,12 @@ func (x *JobList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys691Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys691Slc
-	var yyhl691 bool = l >= 0
-	for yyj691 := 0; ; yyj691++ {
-		if yyhl691 {
-			if yyj691 >= l {
+	var yys752Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys752Slc
+	var yyhl752 bool = l >= 0
+	for yyj752 := 0; ; yyj752++ {
+		if yyhl752 {
+			if yyj752 >= l {
 				break
 			}
 		} else {
@@ -7580,9 +8201,9 @@ func (x *JobList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys691Slc = r.DecodeBytes(yys691Slc, true, true)
-		yys691 := string(yys691Slc)
-		switch yys691 {
+		yys752Slc = r.DecodeBytes(yys752Slc, true, true)
+		yys752 := string(yys752Slc)
+		switch yys752 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -7599,32 +8220,32 @@ func (x *JobList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv694 := &x.ListMeta
-				yym695 := z.DecBinary()
-				_ = yym695
+				yyv755 := &x.ListMeta
+				yym756 := z.DecBinary()
+				_ = yym756
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv694) {
+				} else if z.HasExtensions() && z.DecExt(yyv755) {
 				} else {
-					z.DecFallback(yyv694, false)
+					z.DecFallback(yyv755, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv696 := &x.Items
-				yym697 := z.DecBinary()
-				_ = yym697
+				yyv757 := &x.Items
+				yym758 := z.DecBinary()
+				_ = yym758
 				if false {
 				} else {
-					h.decSliceJob((*[]Job)(yyv696), d)
+					h.decSliceJob((*[]Job)(yyv757), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys691)
-		} // end switch yys691
-	} // end for yyj691
-	if !yyhl691 {
+			z.DecStructFieldNotFound(-1, yys752)
+		} // end switch yys752
+	} // end for yyj752
+	if !yyhl752 {
 		r.ReadEnd()
 	}
 }
@@ -7633,16 +8254,16 @@ func (x *JobList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj698 int
-	var yyb698 bool
-	var yyhl698 bool = l >= 0
-	yyj698++
-	if yyhl698 {
-		yyb698 = yyj698 > l
+	var yyj759 int
+	var yyb759 bool
+	var yyhl759 bool = l >= 0
+	yyj759++
+	if yyhl759 {
+		yyb759 = yyj759 > l
 	} else {
-		yyb698 = r.CheckBreak()
+		yyb759 = r.CheckBreak()
 	}
-	if yyb698 {
+	if yyb759 {
 		r.ReadEnd()
 		return
 	}
@@ -7651,13 +8272,13 @@ func (x *JobList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj698++
-	if yyhl698 {
-		yyb698 = yyj698 > l
+	yyj759++
+	if yyhl759 {
+		yyb759 = yyj759 > l
 	} else {
-		yyb698 = r.CheckBreak()
+		yyb759 = r.CheckBreak()
 	}
-	if yyb698 {
+	if yyb759 {
 		r.ReadEnd()
 		return
 	}
@@ -7666,60 +8287,60 @@ func (x *JobList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj698++
-	if yyhl698 {
-		yyb698 = yyj698 > l
+	yyj759++
+	if yyhl759 {
+		yyb759 = yyj759 > l
 	} else {
-		yyb698 = r.CheckBreak()
+		yyb759 = r.CheckBreak()
 	}
-	if yyb698 {
+	if yyb759 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv701 := &x.ListMeta
-		yym702 := z.DecBinary()
-		_ = yym702
+		yyv762 := &x.ListMeta
+		yym763 := z.DecBinary()
+		_ = yym763
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv701) {
+		} else if z.HasExtensions() && z.DecExt(yyv762) {
 		} else {
-			z.DecFallback(yyv701, false)
+			z.DecFallback(yyv762, false)
 		}
 	}
-	yyj698++
-	if yyhl698 {
-		yyb698 = yyj698 > l
+	yyj759++
+	if yyhl759 {
+		yyb759 = yyj759 > l
 	} else {
-		yyb698 = r.CheckBreak()
+		yyb759 = r.CheckBreak()
 	}
-	if yyb698 {
+	if yyb759 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv703 := &x.Items
-		yym704 := z.DecBinary()
-		_ = yym704
+		yyv764 := &x.Items
+		yym765 := z.DecBinary()
+		_ = yym765
 		if false {
 		} else {
-			h.decSliceJob((*[]Job)(yyv703), d)
+			h.decSliceJob((*[]Job)(yyv764), d)
 		}
 	}
 	for {
-		yyj698++
-		if yyhl698 {
-			yyb698 = yyj698 > l
+		yyj759++
+		if yyhl759 {
+			yyb759 = yyj759 > l
 		} else {
-			yyb698 = r.CheckBreak()
+			yyb759 = r.CheckBreak()
 		}
-		if yyb698 {
+		if yyb759 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj698-1, "")
+		z.DecStructFieldNotFound(yyj759-1, "")
 	}
 	r.ReadEnd()
 }
@@ -7731,96 +8352,96 @@ func (x *JobSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym705 := z.EncBinary()
-		_ = yym705
+		yym766 := z.EncBinary()
+		_ = yym766
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep706 := !z.EncBinary()
-			yy2arr706 := z.EncBasicHandle().StructToArray
-			var yyq706 [4]bool
-			_, _, _ = yysep706, yyq706, yy2arr706
-			const yyr706 bool = false
-			yyq706[0] = x.Parallelism != nil
-			yyq706[1] = x.Completions != nil
-			yyq706[2] = x.Selector != nil
-			if yyr706 || yy2arr706 {
+			yysep767 := !z.EncBinary()
+			yy2arr767 := z.EncBasicHandle().StructToArray
+			var yyq767 [4]bool
+			_, _, _ = yysep767, yyq767, yy2arr767
+			const yyr767 bool = false
+			yyq767[0] = x.Parallelism != nil
+			yyq767[1] = x.Completions != nil
+			yyq767[2] = x.Selector != nil
+			if yyr767 || yy2arr767 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn706 int = 1
-				for _, b := range yyq706 {
+				var yynn767 int = 1
+				for _, b := range yyq767 {
 					if b {
-						yynn706++
+						yynn767++
 					}
 				}
-				r.EncodeMapStart(yynn706)
+				r.EncodeMapStart(yynn767)
 			}
-			if yyr706 || yy2arr706 {
-				if yyq706[0] {
+			if yyr767 || yy2arr767 {
+				if yyq767[0] {
 					if x.Parallelism == nil {
 						r.EncodeNil()
 					} else {
-						yy708 := *x.Parallelism
-						yym709 := z.EncBinary()
-						_ = yym709
+						yy769 := *x.Parallelism
+						yym770 := z.EncBinary()
+						_ = yym770
 						if false {
 						} else {
-							r.EncodeInt(int64(yy708))
+							r.EncodeInt(int64(yy769))
 						}
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq706[0] {
+				if yyq767[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("parallelism"))
 					if x.Parallelism == nil {
 						r.EncodeNil()
 					} else {
-						yy710 := *x.Parallelism
-						yym711 := z.EncBinary()
-						_ = yym711
+						yy771 := *x.Parallelism
+						yym772 := z.EncBinary()
+						_ = yym772
 						if false {
 						} else {
-							r.EncodeInt(int64(yy710))
+							r.EncodeInt(int64(yy771))
 						}
 					}
 				}
 			}
-			if yyr706 || yy2arr706 {
-				if yyq706[1] {
+			if yyr767 || yy2arr767 {
+				if yyq767[1] {
 					if x.Completions == nil {
 						r.EncodeNil()
 					} else {
-						yy713 := *x.Completions
-						yym714 := z.EncBinary()
-						_ = yym714
+						yy774 := *x.Completions
+						yym775 := z.EncBinary()
+						_ = yym775
 						if false {
 						} else {
-							r.EncodeInt(int64(yy713))
+							r.EncodeInt(int64(yy774))
 						}
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq706[1] {
+				if yyq767[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("completions"))
 					if x.Completions == nil {
 						r.EncodeNil()
 					} else {
-						yy715 := *x.Completions
-						yym716 := z.EncBinary()
-						_ = yym716
+						yy776 := *x.Completions
+						yym777 := z.EncBinary()
+						_ = yym777
 						if false {
 						} else {
-							r.EncodeInt(int64(yy715))
+							r.EncodeInt(int64(yy776))
 						}
 					}
 				}
 			}
-			if yyr706 || yy2arr706 {
-				if yyq706[2] {
+			if yyr767 || yy2arr767 {
+				if yyq767[2] {
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
@@ -7830,7 +8451,7 @@ func (x *JobSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq706[2] {
+				if yyq767[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("selector"))
 					if x.Selector == nil {
 						r.EncodeNil()
@@ -7839,15 +8460,15 @@ func (x *JobSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr706 || yy2arr706 {
-				yy719 := &x.Template
-				yy719.CodecEncodeSelf(e)
+			if yyr767 || yy2arr767 {
+				yy780 := &x.Template
+				yy780.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("template"))
-				yy720 := &x.Template
-				yy720.CodecEncodeSelf(e)
+				yy781 := &x.Template
+				yy781.CodecEncodeSelf(e)
 			}
-			if yysep706 {
+			if yysep767 {
 				r.EncodeEnd()
 			}
 		}
@@ -7858,24 +8479,24 @@ func (x *JobSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym721 := z.DecBinary()
-	_ = yym721
+	yym782 := z.DecBinary()
+	_ = yym782
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl722 := r.ReadMapStart()
-			if yyl722 == 0 {
+			yyl783 := r.ReadMapStart()
+			if yyl783 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl722, d)
+				x.codecDecodeSelfFromMap(yyl783, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl722 := r.ReadArrayStart()
-			if yyl722 == 0 {
+			yyl783 := r.ReadArrayStart()
+			if yyl783 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl722, d)
+				x.codecDecodeSelfFromArray(yyl783, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -7887,12 +8508,12 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys723Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys723Slc
-	var yyhl723 bool = l >= 0
-	for yyj723 := 0; ; yyj723++ {
-		if yyhl723 {
-			if yyj723 >= l {
+	var yys784Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys784Slc
+	var yyhl784 bool = l >= 0
+	for yyj784 := 0; ; yyj784++ {
+		if yyhl784 {
+			if yyj784 >= l {
 				break
 			}
 		} else {
@@ -7900,9 +8521,9 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys723Slc = r.DecodeBytes(yys723Slc, true, true)
-		yys723 := string(yys723Slc)
-		switch yys723 {
+		yys784Slc = r.DecodeBytes(yys784Slc, true, true)
+		yys784 := string(yys784Slc)
+		switch yys784 {
 		case "parallelism":
 			if r.TryDecodeAsNil() {
 				if x.Parallelism != nil {
@@ -7912,8 +8533,8 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.Parallelism == nil {
 					x.Parallelism = new(int)
 				}
-				yym725 := z.DecBinary()
-				_ = yym725
+				yym786 := z.DecBinary()
+				_ = yym786
 				if false {
 				} else {
 					*((*int)(x.Parallelism)) = int(r.DecodeInt(codecSelferBitsize1234))
@@ -7928,8 +8549,8 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.Completions == nil {
 					x.Completions = new(int)
 				}
-				yym727 := z.DecBinary()
-				_ = yym727
+				yym788 := z.DecBinary()
+				_ = yym788
 				if false {
 				} else {
 					*((*int)(x.Completions)) = int(r.DecodeInt(codecSelferBitsize1234))
@@ -7950,14 +8571,14 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.Template = pkg2_api.PodTemplateSpec{}
 			} else {
-				yyv729 := &x.Template
-				yyv729.CodecDecodeSelf(d)
+				yyv790 := &x.Template
+				yyv790.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys723)
-		} // end switch yys723
-	} // end for yyj723
-	if !yyhl723 {
+			z.DecStructFieldNotFound(-1, yys784)
+		} // end switch yys784
+	} // end for yyj784
+	if !yyhl784 {
 		r.ReadEnd()
 	}
 }
@@ -7966,16 +8587,16 @@ func (x *JobSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj730 int
-	var yyb730 bool
-	var yyhl730 bool = l >= 0
-	yyj730++
-	if yyhl730 {
-		yyb730 = yyj730 > l
+	var yyj791 int
+	var yyb791 bool
+	var yyhl791 bool = l >= 0
+	yyj791++
+	if yyhl791 {
+		yyb791 = yyj791 > l
 	} else {
-		yyb730 = r.CheckBreak()
+		yyb791 = r.CheckBreak()
 	}
-	if yyb730 {
+	if yyb791 {
 		r.ReadEnd()
 		return
 	}
@@ -7987,20 +8608,20 @@ func (x *JobSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.Parallelism == nil {
 			x.Parallelism = new(int)
 		}
-		yym732 := z.DecBinary()
-		_ = yym732
+		yym793 := z.DecBinary()
+		_ = yym793
 		if false {
 		} else {
 			*((*int)(x.Parallelism)) = int(r.DecodeInt(codecSelferBitsize1234))
 		}
 	}
-	yyj730++
-	if yyhl730 {
-		yyb730 = yyj730 > l
+	yyj791++
+	if yyhl791 {
+		yyb791 = yyj791 > l
 	} else {
-		yyb730 = r.CheckBreak()
+		yyb791 = r.CheckBreak()
 	}
-	if yyb730 {
+	if yyb791 {
 		r.ReadEnd()
 		return
 	}
@@ -8012,20 +8633,20 @@ func (x *JobSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.Completions == nil {
 			x.Completions = new(int)
 		}
-		yym734 := z.DecBinary()
-		_ = yym734
+		yym795 := z.DecBinary()
+		_ = yym795
 		if false {
 		} else {
 			*((*int)(x.Completions)) = int(r.DecodeInt(codecSelferBitsize1234))
 		}
 	}
-	yyj730++
-	if yyhl730 {
-		yyb730 = yyj730 > l
+	yyj791++
+	if yyhl791 {
+		yyb791 = yyj791 > l
 	} else {
-		yyb730 = r.CheckBreak()
+		yyb791 = r.CheckBreak()
 	}
-	if yyb730 {
+	if yyb791 {
 		r.ReadEnd()
 		return
 	}
@@ -8039,33 +8660,33 @@ func (x *JobSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		}
 		x.Selector.CodecDecodeSelf(d)
 	}
-	yyj730++
-	if yyhl730 {
-		yyb730 = yyj730 > l
+	yyj791++
+	if yyhl791 {
+		yyb791 = yyj791 > l
 	} else {
-		yyb730 = r.CheckBreak()
+		yyb791 = r.CheckBreak()
 	}
-	if yyb730 {
+	if yyb791 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Template = pkg2_api.PodTemplateSpec{}
 	} else {
-		yyv736 := &x.Template
-		yyv736.CodecDecodeSelf(d)
+		yyv797 := &x.Template
+		yyv797.CodecDecodeSelf(d)
 	}
 	for {
-		yyj730++
-		if yyhl730 {
-			yyb730 = yyj730 > l
+		yyj791++
+		if yyhl791 {
+			yyb791 = yyj791 > l
 		} else {
-			yyb730 = r.CheckBreak()
+			yyb791 = r.CheckBreak()
 		}
-		if yyb730 {
+		if yyb791 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj730-1, "")
+		z.DecStructFieldNotFound(yyj791-1, "")
 	}
 	r.ReadEnd()
 }
@@ -8077,40 +8698,40 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym737 := z.EncBinary()
-		_ = yym737
+		yym798 := z.EncBinary()
+		_ = yym798
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep738 := !z.EncBinary()
-			yy2arr738 := z.EncBasicHandle().StructToArray
-			var yyq738 [6]bool
-			_, _, _ = yysep738, yyq738, yy2arr738
-			const yyr738 bool = false
-			yyq738[0] = len(x.Conditions) != 0
-			yyq738[1] = x.StartTime != nil
-			yyq738[2] = x.CompletionTime != nil
-			yyq738[3] = x.Active != 0
-			yyq738[4] = x.Succeeded != 0
-			yyq738[5] = x.Failed != 0
-			if yyr738 || yy2arr738 {
+			yysep799 := !z.EncBinary()
+			yy2arr799 := z.EncBasicHandle().StructToArray
+			var yyq799 [6]bool
+			_, _, _ = yysep799, yyq799, yy2arr799
+			const yyr799 bool = false
+			yyq799[0] = len(x.Conditions) != 0
+			yyq799[1] = x.StartTime != nil
+			yyq799[2] = x.CompletionTime != nil
+			yyq799[3] = x.Active != 0
+			yyq799[4] = x.Succeeded != 0
+			yyq799[5] = x.Failed != 0
+			if yyr799 || yy2arr799 {
 				r.EncodeArrayStart(6)
 			} else {
-				var yynn738 int = 0
-				for _, b := range yyq738 {
+				var yynn799 int = 0
+				for _, b := range yyq799 {
 					if b {
-						yynn738++
+						yynn799++
 					}
 				}
-				r.EncodeMapStart(yynn738)
+				r.EncodeMapStart(yynn799)
 			}
-			if yyr738 || yy2arr738 {
-				if yyq738[0] {
+			if yyr799 || yy2arr799 {
+				if yyq799[0] {
 					if x.Conditions == nil {
 						r.EncodeNil()
 					} else {
-						yym740 := z.EncBinary()
-						_ = yym740
+						yym801 := z.EncBinary()
+						_ = yym801
 						if false {
 						} else {
 							h.encSliceJobCondition(([]JobCondition)(x.Conditions), e)
@@ -8120,13 +8741,13 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq738[0] {
+				if yyq799[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("conditions"))
 					if x.Conditions == nil {
 						r.EncodeNil()
 					} else {
-						yym741 := z.EncBinary()
-						_ = yym741
+						yym802 := z.EncBinary()
+						_ = yym802
 						if false {
 						} else {
 							h.encSliceJobCondition(([]JobCondition)(x.Conditions), e)
@@ -8134,18 +8755,18 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr738 || yy2arr738 {
-				if yyq738[1] {
+			if yyr799 || yy2arr799 {
+				if yyq799[1] {
 					if x.StartTime == nil {
 						r.EncodeNil()
 					} else {
-						yym743 := z.EncBinary()
-						_ = yym743
+						yym804 := z.EncBinary()
+						_ = yym804
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.StartTime) {
-						} else if yym743 {
+						} else if yym804 {
 							z.EncBinaryMarshal(x.StartTime)
-						} else if !yym743 && z.IsJSONHandle() {
+						} else if !yym804 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.StartTime)
 						} else {
 							z.EncFallback(x.StartTime)
@@ -8155,18 +8776,18 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq738[1] {
+				if yyq799[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("startTime"))
 					if x.StartTime == nil {
 						r.EncodeNil()
 					} else {
-						yym744 := z.EncBinary()
-						_ = yym744
+						yym805 := z.EncBinary()
+						_ = yym805
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.StartTime) {
-						} else if yym744 {
+						} else if yym805 {
 							z.EncBinaryMarshal(x.StartTime)
-						} else if !yym744 && z.IsJSONHandle() {
+						} else if !yym805 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.StartTime)
 						} else {
 							z.EncFallback(x.StartTime)
@@ -8174,18 +8795,18 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr738 || yy2arr738 {
-				if yyq738[2] {
+			if yyr799 || yy2arr799 {
+				if yyq799[2] {
 					if x.CompletionTime == nil {
 						r.EncodeNil()
 					} else {
-						yym746 := z.EncBinary()
-						_ = yym746
+						yym807 := z.EncBinary()
+						_ = yym807
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.CompletionTime) {
-						} else if yym746 {
+						} else if yym807 {
 							z.EncBinaryMarshal(x.CompletionTime)
-						} else if !yym746 && z.IsJSONHandle() {
+						} else if !yym807 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.CompletionTime)
 						} else {
 							z.EncFallback(x.CompletionTime)
@@ -8195,18 +8816,18 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq738[2] {
+				if yyq799[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("completionTime"))
 					if x.CompletionTime == nil {
 						r.EncodeNil()
 					} else {
-						yym747 := z.EncBinary()
-						_ = yym747
+						yym808 := z.EncBinary()
+						_ = yym808
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.CompletionTime) {
-						} else if yym747 {
+						} else if yym808 {
 							z.EncBinaryMarshal(x.CompletionTime)
-						} else if !yym747 && z.IsJSONHandle() {
+						} else if !yym808 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.CompletionTime)
 						} else {
 							z.EncFallback(x.CompletionTime)
@@ -8214,10 +8835,10 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr738 || yy2arr738 {
-				if yyq738[3] {
-					yym749 := z.EncBinary()
-					_ = yym749
+			if yyr799 || yy2arr799 {
+				if yyq799[3] {
+					yym810 := z.EncBinary()
+					_ = yym810
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Active))
@@ -8226,20 +8847,20 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq738[3] {
+				if yyq799[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("active"))
-					yym750 := z.EncBinary()
-					_ = yym750
+					yym811 := z.EncBinary()
+					_ = yym811
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Active))
 					}
 				}
 			}
-			if yyr738 || yy2arr738 {
-				if yyq738[4] {
-					yym752 := z.EncBinary()
-					_ = yym752
+			if yyr799 || yy2arr799 {
+				if yyq799[4] {
+					yym813 := z.EncBinary()
+					_ = yym813
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Succeeded))
@@ -8248,20 +8869,20 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq738[4] {
+				if yyq799[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("succeeded"))
-					yym753 := z.EncBinary()
-					_ = yym753
+					yym814 := z.EncBinary()
+					_ = yym814
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Succeeded))
 					}
 				}
 			}
-			if yyr738 || yy2arr738 {
-				if yyq738[5] {
-					yym755 := z.EncBinary()
-					_ = yym755
+			if yyr799 || yy2arr799 {
+				if yyq799[5] {
+					yym816 := z.EncBinary()
+					_ = yym816
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Failed))
@@ -8270,17 +8891,17 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq738[5] {
+				if yyq799[5] {
 					r.EncodeString(codecSelferC_UTF81234, string("failed"))
-					yym756 := z.EncBinary()
-					_ = yym756
+					yym817 := z.EncBinary()
+					_ = yym817
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Failed))
 					}
 				}
 			}
-			if yysep738 {
+			if yysep799 {
 				r.EncodeEnd()
 			}
 		}
@@ -8291,24 +8912,24 @@ func (x *JobStatus) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym757 := z.DecBinary()
-	_ = yym757
+	yym818 := z.DecBinary()
+	_ = yym818
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl758 := r.ReadMapStart()
-			if yyl758 == 0 {
+			yyl819 := r.ReadMapStart()
+			if yyl819 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl758, d)
+				x.codecDecodeSelfFromMap(yyl819, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl758 := r.ReadArrayStart()
-			if yyl758 == 0 {
+			yyl819 := r.ReadArrayStart()
+			if yyl819 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl758, d)
+				x.codecDecodeSelfFromArray(yyl819, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -8320,12 +8941,12 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys759Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys759Slc
-	var yyhl759 bool = l >= 0
-	for yyj759 := 0; ; yyj759++ {
-		if yyhl759 {
-			if yyj759 >= l {
+	var yys820Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys820Slc
+	var yyhl820 bool = l >= 0
+	for yyj820 := 0; ; yyj820++ {
+		if yyhl820 {
+			if yyj820 >= l {
 				break
 			}
 		} else {
@@ -8333,19 +8954,19 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys759Slc = r.DecodeBytes(yys759Slc, true, true)
-		yys759 := string(yys759Slc)
-		switch yys759 {
+		yys820Slc = r.DecodeBytes(yys820Slc, true, true)
+		yys820 := string(yys820Slc)
+		switch yys820 {
 		case "conditions":
 			if r.TryDecodeAsNil() {
 				x.Conditions = nil
 			} else {
-				yyv760 := &x.Conditions
-				yym761 := z.DecBinary()
-				_ = yym761
+				yyv821 := &x.Conditions
+				yym822 := z.DecBinary()
+				_ = yym822
 				if false {
 				} else {
-					h.decSliceJobCondition((*[]JobCondition)(yyv760), d)
+					h.decSliceJobCondition((*[]JobCondition)(yyv821), d)
 				}
 			}
 		case "startTime":
@@ -8357,13 +8978,13 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.StartTime == nil {
 					x.StartTime = new(pkg1_unversioned.Time)
 				}
-				yym763 := z.DecBinary()
-				_ = yym763
+				yym824 := z.DecBinary()
+				_ = yym824
 				if false {
 				} else if z.HasExtensions() && z.DecExt(x.StartTime) {
-				} else if yym763 {
+				} else if yym824 {
 					z.DecBinaryUnmarshal(x.StartTime)
-				} else if !yym763 && z.IsJSONHandle() {
+				} else if !yym824 && z.IsJSONHandle() {
 					z.DecJSONUnmarshal(x.StartTime)
 				} else {
 					z.DecFallback(x.StartTime, false)
@@ -8378,13 +8999,13 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.CompletionTime == nil {
 					x.CompletionTime = new(pkg1_unversioned.Time)
 				}
-				yym765 := z.DecBinary()
-				_ = yym765
+				yym826 := z.DecBinary()
+				_ = yym826
 				if false {
 				} else if z.HasExtensions() && z.DecExt(x.CompletionTime) {
-				} else if yym765 {
+				} else if yym826 {
 					z.DecBinaryUnmarshal(x.CompletionTime)
-				} else if !yym765 && z.IsJSONHandle() {
+				} else if !yym826 && z.IsJSONHandle() {
 					z.DecJSONUnmarshal(x.CompletionTime)
 				} else {
 					z.DecFallback(x.CompletionTime, false)
@@ -8409,10 +9030,10 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.Failed = int(r.DecodeInt(codecSelferBitsize1234))
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys759)
-		} // end switch yys759
-	} // end for yyj759
-	if !yyhl759 {
+			z.DecStructFieldNotFound(-1, yys820)
+		} // end switch yys820
+	} // end for yyj820
+	if !yyhl820 {
 		r.ReadEnd()
 	}
 }
@@ -8421,37 +9042,37 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj769 int
-	var yyb769 bool
-	var yyhl769 bool = l >= 0
-	yyj769++
-	if yyhl769 {
-		yyb769 = yyj769 > l
+	var yyj830 int
+	var yyb830 bool
+	var yyhl830 bool = l >= 0
+	yyj830++
+	if yyhl830 {
+		yyb830 = yyj830 > l
 	} else {
-		yyb769 = r.CheckBreak()
+		yyb830 = r.CheckBreak()
 	}
-	if yyb769 {
+	if yyb830 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Conditions = nil
 	} else {
-		yyv770 := &x.Conditions
-		yym771 := z.DecBinary()
-		_ = yym771
+		yyv831 := &x.Conditions
+		yym832 := z.DecBinary()
+		_ = yym832
 		if false {
 		} else {
-			h.decSliceJobCondition((*[]JobCondition)(yyv770), d)
+			h.decSliceJobCondition((*[]JobCondition)(yyv831), d)
 		}
 	}
-	yyj769++
-	if yyhl769 {
-		yyb769 = yyj769 > l
+	yyj830++
+	if yyhl830 {
+		yyb830 = yyj830 > l
 	} else {
-		yyb769 = r.CheckBreak()
+		yyb830 = r.CheckBreak()
 	}
-	if yyb769 {
+	if yyb830 {
 		r.ReadEnd()
 		return
 	}
@@ -8463,25 +9084,25 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.StartTime == nil {
 			x.StartTime = new(pkg1_unversioned.Time)
 		}
-		yym773 := z.DecBinary()
-		_ = yym773
+		yym834 := z.DecBinary()
+		_ = yym834
 		if false {
 		} else if z.HasExtensions() && z.DecExt(x.StartTime) {
-		} else if yym773 {
+		} else if yym834 {
 			z.DecBinaryUnmarshal(x.StartTime)
-		} else if !yym773 && z.IsJSONHandle() {
+		} else if !yym834 && z.IsJSONHandle() {
 			z.DecJSONUnmarshal(x.StartTime)
 		} else {
 			z.DecFallback(x.StartTime, false)
 		}
 	}
-	yyj769++
-	if yyhl769 {
-		yyb769 = yyj769 > l
+	yyj830++
+	if yyhl830 {
+		yyb830 = yyj830 > l
 	} else {
-		yyb769 = r.CheckBreak()
+		yyb830 = r.CheckBreak()
 	}
-	if yyb769 {
+	if yyb830 {
 		r.ReadEnd()
 		return
 	}
@@ -8493,25 +9114,25 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.CompletionTime == nil {
 			x.CompletionTime = new(pkg1_unversioned.Time)
 		}
-		yym775 := z.DecBinary()
-		_ = yym775
+		yym836 := z.DecBinary()
+		_ = yym836
 		if false {
 		} else if z.HasExtensions() && z.DecExt(x.CompletionTime) {
-		} else if yym775 {
+		} else if yym836 {
 			z.DecBinaryUnmarshal(x.CompletionTime)
-		} else if !yym775 && z.IsJSONHandle() {
+		} else if !yym836 && z.IsJSONHandle() {
 			z.DecJSONUnmarshal(x.CompletionTime)
 		} else {
 			z.DecFallback(x.CompletionTime, false)
 		}
 	}
-	yyj769++
-	if yyhl769 {
-		yyb769 = yyj769 > l
+	yyj830++
+	if yyhl830 {
+		yyb830 = yyj830 > l
 	} else {
-		yyb769 = r.CheckBreak()
+		yyb830 = r.CheckBreak()
 	}
-	if yyb769 {
+	if yyb830 {
 		r.ReadEnd()
 		return
 	}
@@ -8520,13 +9141,13 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Active = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj769++
-	if yyhl769 {
-		yyb769 = yyj769 > l
+	yyj830++
+	if yyhl830 {
+		yyb830 = yyj830 > l
 	} else {
-		yyb769 = r.CheckBreak()
+		yyb830 = r.CheckBreak()
 	}
-	if yyb769 {
+	if yyb830 {
 		r.ReadEnd()
 		return
 	}
@@ -8535,13 +9156,13 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Succeeded = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj769++
-	if yyhl769 {
-		yyb769 = yyj769 > l
+	yyj830++
+	if yyhl830 {
+		yyb830 = yyj830 > l
 	} else {
-		yyb769 = r.CheckBreak()
+		yyb830 = r.CheckBreak()
 	}
-	if yyb769 {
+	if yyb830 {
 		r.ReadEnd()
 		return
 	}
@@ -8551,16 +9172,16 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		x.Failed = int(r.DecodeInt(codecSelferBitsize1234))
 	}
 	for {
-		yyj769++
-		if yyhl769 {
-			yyb769 = yyj769 > l
+		yyj830++
+		if yyhl830 {
+			yyb830 = yyj830 > l
 		} else {
-			yyb769 = r.CheckBreak()
+			yyb830 = r.CheckBreak()
 		}
-		if yyb769 {
+		if yyb830 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj769-1, "")
+		z.DecStructFieldNotFound(yyj830-1, "")
 	}
 	r.ReadEnd()
 }
@@ -8569,8 +9190,8 @@ func (x JobConditionType) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	yym779 := z.EncBinary()
-	_ = yym779
+	yym840 := z.EncBinary()
+	_ = yym840
 	if false {
 	} else if z.HasExtensions() && z.EncExt(x) {
 	} else {
@@ -8582,8 +9203,8 @@ func (x *JobConditionType) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym780 := z.DecBinary()
-	_ = yym780
+	yym841 := z.DecBinary()
+	_ = yym841
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
@@ -8598,40 +9219,40 @@ func (x *JobCondition) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym781 := z.EncBinary()
-		_ = yym781
+		yym842 := z.EncBinary()
+		_ = yym842
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep782 := !z.EncBinary()
-			yy2arr782 := z.EncBasicHandle().StructToArray
-			var yyq782 [6]bool
-			_, _, _ = yysep782, yyq782, yy2arr782
-			const yyr782 bool = false
-			yyq782[2] = true
-			yyq782[3] = true
-			yyq782[4] = x.Reason != ""
-			yyq782[5] = x.Message != ""
-			if yyr782 || yy2arr782 {
+			yysep843 := !z.EncBinary()
+			yy2arr843 := z.EncBasicHandle().StructToArray
+			var yyq843 [6]bool
+			_, _, _ = yysep843, yyq843, yy2arr843
+			const yyr843 bool = false
+			yyq843[2] = true
+			yyq843[3] = true
+			yyq843[4] = x.Reason != ""
+			yyq843[5] = x.Message != ""
+			if yyr843 || yy2arr843 {
 				r.EncodeArrayStart(6)
 			} else {
-				var yynn782 int = 2
-				for _, b := range yyq782 {
+				var yynn843 int = 2
+				for _, b := range yyq843 {
 					if b {
-						yynn782++
+						yynn843++
 					}
 				}
-				r.EncodeMapStart(yynn782)
+				r.EncodeMapStart(yynn843)
 			}
-			if yyr782 || yy2arr782 {
+			if yyr843 || yy2arr843 {
 				x.Type.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("type"))
 				x.Type.CodecEncodeSelf(e)
 			}
-			if yyr782 || yy2arr782 {
-				yym785 := z.EncBinary()
-				_ = yym785
+			if yyr843 || yy2arr843 {
+				yym846 := z.EncBinary()
+				_ = yym846
 				if false {
 				} else if z.HasExtensions() && z.EncExt(x.Status) {
 				} else {
@@ -8639,86 +9260,86 @@ func (x *JobCondition) CodecEncodeSelf(e *codec1978.Encoder) {
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("status"))
-				yym786 := z.EncBinary()
-				_ = yym786
+				yym847 := z.EncBinary()
+				_ = yym847
 				if false {
 				} else if z.HasExtensions() && z.EncExt(x.Status) {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.Status))
 				}
 			}
-			if yyr782 || yy2arr782 {
-				if yyq782[2] {
-					yy788 := &x.LastProbeTime
-					yym789 := z.EncBinary()
-					_ = yym789
+			if yyr843 || yy2arr843 {
+				if yyq843[2] {
+					yy849 := &x.LastProbeTime
+					yym850 := z.EncBinary()
+					_ = yym850
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy788) {
-					} else if yym789 {
-						z.EncBinaryMarshal(yy788)
-					} else if !yym789 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy788)
+					} else if z.HasExtensions() && z.EncExt(yy849) {
+					} else if yym850 {
+						z.EncBinaryMarshal(yy849)
+					} else if !yym850 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy849)
 					} else {
-						z.EncFallback(yy788)
+						z.EncFallback(yy849)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq782[2] {
+				if yyq843[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("lastProbeTime"))
-					yy790 := &x.LastProbeTime
-					yym791 := z.EncBinary()
-					_ = yym791
+					yy851 := &x.LastProbeTime
+					yym852 := z.EncBinary()
+					_ = yym852
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy790) {
-					} else if yym791 {
-						z.EncBinaryMarshal(yy790)
-					} else if !yym791 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy790)
+					} else if z.HasExtensions() && z.EncExt(yy851) {
+					} else if yym852 {
+						z.EncBinaryMarshal(yy851)
+					} else if !yym852 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy851)
 					} else {
-						z.EncFallback(yy790)
+						z.EncFallback(yy851)
 					}
 				}
 			}
-			if yyr782 || yy2arr782 {
-				if yyq782[3] {
-					yy793 := &x.LastTransitionTime
-					yym794 := z.EncBinary()
-					_ = yym794
+			if yyr843 || yy2arr843 {
+				if yyq843[3] {
+					yy854 := &x.LastTransitionTime
+					yym855 := z.EncBinary()
+					_ = yym855
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy793) {
-					} else if yym794 {
-						z.EncBinaryMarshal(yy793)
-					} else if !yym794 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy793)
+					} else if z.HasExtensions() && z.EncExt(yy854) {
+					} else if yym855 {
+						z.EncBinaryMarshal(yy854)
+					} else if !yym855 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy854)
 					} else {
-						z.EncFallback(yy793)
+						z.EncFallback(yy854)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq782[3] {
+				if yyq843[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("lastTransitionTime"))
-					yy795 := &x.LastTransitionTime
-					yym796 := z.EncBinary()
-					_ = yym796
+					yy856 := &x.LastTransitionTime
+					yym857 := z.EncBinary()
+					_ = yym857
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy795) {
-					} else if yym796 {
-						z.EncBinaryMarshal(yy795)
-					} else if !yym796 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy795)
+					} else if z.HasExtensions() && z.EncExt(yy856) {
+					} else if yym857 {
+						z.EncBinaryMarshal(yy856)
+					} else if !yym857 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy856)
 					} else {
-						z.EncFallback(yy795)
+						z.EncFallback(yy856)
 					}
 				}
 			}
-			if yyr782 || yy2arr782 {
-				if yyq782[4] {
-					yym798 := z.EncBinary()
-					_ = yym798
+			if yyr843 || yy2arr843 {
+				if yyq843[4] {
+					yym859 := z.EncBinary()
+					_ = yym859
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Reason))
@@ -8727,20 +9348,20 @@ func (x *JobCondition) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq782[4] {
+				if yyq843[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("reason"))
-					yym799 := z.EncBinary()
-					_ = yym799
+					yym860 := z.EncBinary()
+					_ = yym860
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Reason))
 					}
 				}
 			}
-			if yyr782 || yy2arr782 {
-				if yyq782[5] {
-					yym801 := z.EncBinary()
-					_ = yym801
+			if yyr843 || yy2arr843 {
+				if yyq843[5] {
+					yym862 := z.EncBinary()
+					_ = yym862
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Message))
@@ -8749,17 +9370,17 @@ func (x *JobCondition) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq782[5] {
+				if yyq843[5] {
 					r.EncodeString(codecSelferC_UTF81234, string("message"))
-					yym802 := z.EncBinary()
-					_ = yym802
+					yym863 := z.EncBinary()
+					_ = yym863
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Message))
 					}
 				}
 			}
-			if yysep782 {
+			if yysep843 {
 				r.EncodeEnd()
 			}
 		}
@@ -8770,24 +9391,24 @@ func (x *JobCondition) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym803 := z.DecBinary()
-	_ = yym803
+	yym864 := z.DecBinary()
+	_ = yym864
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl804 := r.ReadMapStart()
-			if yyl804 == 0 {
+			yyl865 := r.ReadMapStart()
+			if yyl865 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl804, d)
+				x.codecDecodeSelfFromMap(yyl865, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl804 := r.ReadArrayStart()
-			if yyl804 == 0 {
+			yyl865 := r.ReadArrayStart()
+			if yyl865 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl804, d)
+				x.codecDecodeSelfFromArray(yyl865, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -8799,12 +9420,12 @@ func (x *JobCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys805Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys805Slc
-	var yyhl805 bool = l >= 0
-	for yyj805 := 0; ; yyj805++ {
-		if yyhl805 {
-			if yyj805 >= l {
+	var yys866Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys866Slc
+	var yyhl866 bool = l >= 0
+	for yyj866 := 0; ; yyj866++ {
+		if yyhl866 {
+			if yyj866 >= l {
 				break
 			}
 		} else {
@@ -8812,9 +9433,9 @@ func (x *JobCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys805Slc = r.DecodeBytes(yys805Slc, true, true)
-		yys805 := string(yys805Slc)
-		switch yys805 {
+		yys866Slc = r.DecodeBytes(yys866Slc, true, true)
+		yys866 := string(yys866Slc)
+		switch yys866 {
 		case "type":
 			if r.TryDecodeAsNil() {
 				x.Type = ""
@@ -8831,34 +9452,34 @@ func (x *JobCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.LastProbeTime = pkg1_unversioned.Time{}
 			} else {
-				yyv808 := &x.LastProbeTime
-				yym809 := z.DecBinary()
-				_ = yym809
+				yyv869 := &x.LastProbeTime
+				yym870 := z.DecBinary()
+				_ = yym870
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv808) {
-				} else if yym809 {
-					z.DecBinaryUnmarshal(yyv808)
-				} else if !yym809 && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(yyv808)
+				} else if z.HasExtensions() && z.DecExt(yyv869) {
+				} else if yym870 {
+					z.DecBinaryUnmarshal(yyv869)
+				} else if !yym870 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv869)
 				} else {
-					z.DecFallback(yyv808, false)
+					z.DecFallback(yyv869, false)
 				}
 			}
 		case "lastTransitionTime":
 			if r.TryDecodeAsNil() {
 				x.LastTransitionTime = pkg1_unversioned.Time{}
 			} else {
-				yyv810 := &x.LastTransitionTime
-				yym811 := z.DecBinary()
-				_ = yym811
+				yyv871 := &x.LastTransitionTime
+				yym872 := z.DecBinary()
+				_ = yym872
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv810) {
-				} else if yym811 {
-					z.DecBinaryUnmarshal(yyv810)
-				} else if !yym811 && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(yyv810)
+				} else if z.HasExtensions() && z.DecExt(yyv871) {
+				} else if yym872 {
+					z.DecBinaryUnmarshal(yyv871)
+				} else if !yym872 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv871)
 				} else {
-					z.DecFallback(yyv810, false)
+					z.DecFallback(yyv871, false)
 				}
 			}
 		case "reason":
@@ -8874,10 +9495,10 @@ func (x *JobCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.Message = string(r.DecodeString())
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys805)
-		} // end switch yys805
-	} // end for yyj805
-	if !yyhl805 {
+			z.DecStructFieldNotFound(-1, yys866)
+		} // end switch yys866
+	} // end for yyj866
+	if !yyhl866 {
 		r.ReadEnd()
 	}
 }
@@ -8886,16 +9507,16 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj814 int
-	var yyb814 bool
-	var yyhl814 bool = l >= 0
-	yyj814++
-	if yyhl814 {
-		yyb814 = yyj814 > l
+	var yyj875 int
+	var yyb875 bool
+	var yyhl875 bool = l >= 0
+	yyj875++
+	if yyhl875 {
+		yyb875 = yyj875 > l
 	} else {
-		yyb814 = r.CheckBreak()
+		yyb875 = r.CheckBreak()
 	}
-	if yyb814 {
+	if yyb875 {
 		r.ReadEnd()
 		return
 	}
@@ -8904,13 +9525,13 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Type = JobConditionType(r.DecodeString())
 	}
-	yyj814++
-	if yyhl814 {
-		yyb814 = yyj814 > l
+	yyj875++
+	if yyhl875 {
+		yyb875 = yyj875 > l
 	} else {
-		yyb814 = r.CheckBreak()
+		yyb875 = r.CheckBreak()
 	}
-	if yyb814 {
+	if yyb875 {
 		r.ReadEnd()
 		return
 	}
@@ -8919,65 +9540,65 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Status = pkg2_api.ConditionStatus(r.DecodeString())
 	}
-	yyj814++
-	if yyhl814 {
-		yyb814 = yyj814 > l
+	yyj875++
+	if yyhl875 {
+		yyb875 = yyj875 > l
 	} else {
-		yyb814 = r.CheckBreak()
+		yyb875 = r.CheckBreak()
 	}
-	if yyb814 {
+	if yyb875 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.LastProbeTime = pkg1_unversioned.Time{}
 	} else {
-		yyv817 := &x.LastProbeTime
-		yym818 := z.DecBinary()
-		_ = yym818
+		yyv878 := &x.LastProbeTime
+		yym879 := z.DecBinary()
+		_ = yym879
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv817) {
-		} else if yym818 {
-			z.DecBinaryUnmarshal(yyv817)
-		} else if !yym818 && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(yyv817)
+		} else if z.HasExtensions() && z.DecExt(yyv878) {
+		} else if yym879 {
+			z.DecBinaryUnmarshal(yyv878)
+		} else if !yym879 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv878)
 		} else {
-			z.DecFallback(yyv817, false)
+			z.DecFallback(yyv878, false)
 		}
 	}
-	yyj814++
-	if yyhl814 {
-		yyb814 = yyj814 > l
+	yyj875++
+	if yyhl875 {
+		yyb875 = yyj875 > l
 	} else {
-		yyb814 = r.CheckBreak()
+		yyb875 = r.CheckBreak()
 	}
-	if yyb814 {
+	if yyb875 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.LastTransitionTime = pkg1_unversioned.Time{}
 	} else {
-		yyv819 := &x.LastTransitionTime
-		yym820 := z.DecBinary()
-		_ = yym820
+		yyv880 := &x.LastTransitionTime
+		yym881 := z.DecBinary()
+		_ = yym881
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv819) {
-		} else if yym820 {
-			z.DecBinaryUnmarshal(yyv819)
-		} else if !yym820 && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(yyv819)
+		} else if z.HasExtensions() && z.DecExt(yyv880) {
+		} else if yym881 {
+			z.DecBinaryUnmarshal(yyv880)
+		} else if !yym881 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv880)
 		} else {
-			z.DecFallback(yyv819, false)
+			z.DecFallback(yyv880, false)
 		}
 	}
-	yyj814++
-	if yyhl814 {
-		yyb814 = yyj814 > l
+	yyj875++
+	if yyhl875 {
+		yyb875 = yyj875 > l
 	} else {
-		yyb814 = r.CheckBreak()
+		yyb875 = r.CheckBreak()
 	}
-	if yyb814 {
+	if yyb875 {
 		r.ReadEnd()
 		return
 	}
@@ -8986,13 +9607,13 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Reason = string(r.DecodeString())
 	}
-	yyj814++
-	if yyhl814 {
-		yyb814 = yyj814 > l
+	yyj875++
+	if yyhl875 {
+		yyb875 = yyj875 > l
 	} else {
-		yyb814 = r.CheckBreak()
+		yyb875 = r.CheckBreak()
 	}
-	if yyb814 {
+	if yyb875 {
 		r.ReadEnd()
 		return
 	}
@@ -9002,16 +9623,16 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		x.Message = string(r.DecodeString())
 	}
 	for {
-		yyj814++
-		if yyhl814 {
-			yyb814 = yyj814 > l
+		yyj875++
+		if yyhl875 {
+			yyb875 = yyj875 > l
 		} else {
-			yyb814 = r.CheckBreak()
+			yyb875 = r.CheckBreak()
 		}
-		if yyb814 {
+		if yyb875 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj814-1, "")
+		z.DecStructFieldNotFound(yyj875-1, "")
 	}
 	r.ReadEnd()
 }
@@ -9023,36 +9644,36 @@ func (x *Ingress) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym823 := z.EncBinary()
-		_ = yym823
+		yym884 := z.EncBinary()
+		_ = yym884
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep824 := !z.EncBinary()
-			yy2arr824 := z.EncBasicHandle().StructToArray
-			var yyq824 [5]bool
-			_, _, _ = yysep824, yyq824, yy2arr824
-			const yyr824 bool = false
-			yyq824[0] = x.Kind != ""
-			yyq824[1] = x.APIVersion != ""
-			yyq824[2] = true
-			yyq824[3] = true
-			yyq824[4] = true
-			if yyr824 || yy2arr824 {
+			yysep885 := !z.EncBinary()
+			yy2arr885 := z.EncBasicHandle().StructToArray
+			var yyq885 [5]bool
+			_, _, _ = yysep885, yyq885, yy2arr885
+			const yyr885 bool = false
+			yyq885[0] = x.Kind != ""
+			yyq885[1] = x.APIVersion != ""
+			yyq885[2] = true
+			yyq885[3] = true
+			yyq885[4] = true
+			if yyr885 || yy2arr885 {
 				r.EncodeArrayStart(5)
 			} else {
-				var yynn824 int = 0
-				for _, b := range yyq824 {
+				var yynn885 int = 0
+				for _, b := range yyq885 {
 					if b {
-						yynn824++
+						yynn885++
 					}
 				}
-				r.EncodeMapStart(yynn824)
+				r.EncodeMapStart(yynn885)
 			}
-			if yyr824 || yy2arr824 {
-				if yyq824[0] {
-					yym826 := z.EncBinary()
-					_ = yym826
+			if yyr885 || yy2arr885 {
+				if yyq885[0] {
+					yym887 := z.EncBinary()
+					_ = yym887
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -9061,20 +9682,20 @@ func (x *Ingress) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq824[0] {
+				if yyq885[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym827 := z.EncBinary()
-					_ = yym827
+					yym888 := z.EncBinary()
+					_ = yym888
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr824 || yy2arr824 {
-				if yyq824[1] {
-					yym829 := z.EncBinary()
-					_ = yym829
+			if yyr885 || yy2arr885 {
+				if yyq885[1] {
+					yym890 := z.EncBinary()
+					_ = yym890
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -9083,59 +9704,59 @@ func (x *Ingress) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq824[1] {
+				if yyq885[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym830 := z.EncBinary()
-					_ = yym830
+					yym891 := z.EncBinary()
+					_ = yym891
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr824 || yy2arr824 {
-				if yyq824[2] {
-					yy832 := &x.ObjectMeta
-					yy832.CodecEncodeSelf(e)
+			if yyr885 || yy2arr885 {
+				if yyq885[2] {
+					yy893 := &x.ObjectMeta
+					yy893.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq824[2] {
+				if yyq885[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy833 := &x.ObjectMeta
-					yy833.CodecEncodeSelf(e)
+					yy894 := &x.ObjectMeta
+					yy894.CodecEncodeSelf(e)
 				}
 			}
-			if yyr824 || yy2arr824 {
-				if yyq824[3] {
-					yy835 := &x.Spec
-					yy835.CodecEncodeSelf(e)
+			if yyr885 || yy2arr885 {
+				if yyq885[3] {
+					yy896 := &x.Spec
+					yy896.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq824[3] {
+				if yyq885[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("spec"))
-					yy836 := &x.Spec
-					yy836.CodecEncodeSelf(e)
+					yy897 := &x.Spec
+					yy897.CodecEncodeSelf(e)
 				}
 			}
-			if yyr824 || yy2arr824 {
-				if yyq824[4] {
-					yy838 := &x.Status
-					yy838.CodecEncodeSelf(e)
+			if yyr885 || yy2arr885 {
+				if yyq885[4] {
+					yy899 := &x.Status
+					yy899.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq824[4] {
+				if yyq885[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("status"))
-					yy839 := &x.Status
-					yy839.CodecEncodeSelf(e)
+					yy900 := &x.Status
+					yy900.CodecEncodeSelf(e)
 				}
 			}
-			if yysep824 {
+			if yysep885 {
 				r.EncodeEnd()
 			}
 		}
@@ -9146,24 +9767,24 @@ func (x *Ingress) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym840 := z.DecBinary()
-	_ = yym840
+	yym901 := z.DecBinary()
+	_ = yym901
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl841 := r.ReadMapStart()
-			if yyl841 == 0 {
+			yyl902 := r.ReadMapStart()
+			if yyl902 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl841, d)
+				x.codecDecodeSelfFromMap(yyl902, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl841 := r.ReadArrayStart()
-			if yyl841 == 0 {
+			yyl902 := r.ReadArrayStart()
+			if yyl902 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl841, d)
+				x.codecDecodeSelfFromArray(yyl902, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -9175,12 +9796,12 @@ func (x *Ingress) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys842Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys842Slc
-	var yyhl842 bool = l >= 0
-	for yyj842 := 0; ; yyj842++ {
-		if yyhl842 {
-			if yyj842 >= l {
+	var yys903Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys903Slc
+	var yyhl903 bool = l >= 0
+	for yyj903 := 0; ; yyj903++ {
+		if yyhl903 {
+			if yyj903 >= l {
 				break
 			}
 		} else {
@@ -9188,9 +9809,9 @@ func (x *Ingress) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys842Slc = r.DecodeBytes(yys842Slc, true, true)
-		yys842 := string(yys842Slc)
-		switch yys842 {
+		yys903Slc = r.DecodeBytes(yys903Slc, true, true)
+		yys903 := string(yys903Slc)
+		switch yys903 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -9207,28 +9828,28 @@ func (x *Ingress) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ObjectMeta = pkg2_api.ObjectMeta{}
 			} else {
-				yyv845 := &x.ObjectMeta
-				yyv845.CodecDecodeSelf(d)
+				yyv906 := &x.ObjectMeta
+				yyv906.CodecDecodeSelf(d)
 			}
 		case "spec":
 			if r.TryDecodeAsNil() {
 				x.Spec = IngressSpec{}
 			} else {
-				yyv846 := &x.Spec
-				yyv846.CodecDecodeSelf(d)
+				yyv907 := &x.Spec
+				yyv907.CodecDecodeSelf(d)
 			}
 		case "status":
 			if r.TryDecodeAsNil() {
 				x.Status = IngressStatus{}
 			} else {
-				yyv847 := &x.Status
-				yyv847.CodecDecodeSelf(d)
+				yyv908 := &x.Status
+				yyv908.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys842)
-		} // end switch yys842
-	} // end for yyj842
-	if !yyhl842 {
+			z.DecStructFieldNotFound(-1, yys903)
+		} // end switch yys903
+	} // end for yyj903
+	if !yyhl903 {
 		r.ReadEnd()
 	}
 }
@@ -9237,16 +9858,16 @@ func (x *Ingress) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj848 int
-	var yyb848 bool
-	var yyhl848 bool = l >= 0
-	yyj848++
-	if yyhl848 {
-		yyb848 = yyj848 > l
+	var yyj909 int
+	var yyb909 bool
+	var yyhl909 bool = l >= 0
+	yyj909++
+	if yyhl909 {
+		yyb909 = yyj909 > l
 	} else {
-		yyb848 = r.CheckBreak()
+		yyb909 = r.CheckBreak()
 	}
-	if yyb848 {
+	if yyb909 {
 		r.ReadEnd()
 		return
 	}
@@ -9255,13 +9876,13 @@ func (x *Ingress) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj848++
-	if yyhl848 {
-		yyb848 = yyj848 > l
+	yyj909++
+	if yyhl909 {
+		yyb909 = yyj909 > l
 	} else {
-		yyb848 = r.CheckBreak()
+		yyb909 = r.CheckBreak()
 	}
-	if yyb848 {
+	if yyb909 {
 		r.ReadEnd()
 		return
 	}
@@ -9270,65 +9891,65 @@ func (x *Ingress) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj848++
-	if yyhl848 {
-		yyb848 = yyj848 > l
+	yyj909++
+	if yyhl909 {
+		yyb909 = yyj909 > l
 	} else {
-		yyb848 = r.CheckBreak()
+		yyb909 = r.CheckBreak()
 	}
-	if yyb848 {
+	if yyb909 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ObjectMeta = pkg2_api.ObjectMeta{}
 	} else {
-		yyv851 := &x.ObjectMeta
-		yyv851.CodecDecodeSelf(d)
+		yyv912 := &x.ObjectMeta
+		yyv912.CodecDecodeSelf(d)
 	}
-	yyj848++
-	if yyhl848 {
-		yyb848 = yyj848 > l
+	yyj909++
+	if yyhl909 {
+		yyb909 = yyj909 > l
 	} else {
-		yyb848 = r.CheckBreak()
+		yyb909 = r.CheckBreak()
 	}
-	if yyb848 {
+	if yyb909 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Spec = IngressSpec{}
 	} else {
-		yyv852 := &x.Spec
-		yyv852.CodecDecodeSelf(d)
+		yyv913 := &x.Spec
+		yyv913.CodecDecodeSelf(d)
 	}
-	yyj848++
-	if yyhl848 {
-		yyb848 = yyj848 > l
+	yyj909++
+	if yyhl909 {
+		yyb909 = yyj909 > l
 	} else {
-		yyb848 = r.CheckBreak()
+		yyb909 = r.CheckBreak()
 	}
-	if yyb848 {
+	if yyb909 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Status = IngressStatus{}
 	} else {
-		yyv853 := &x.Status
-		yyv853.CodecDecodeSelf(d)
+		yyv914 := &x.Status
+		yyv914.CodecDecodeSelf(d)
 	}
 	for {
-		yyj848++
-		if yyhl848 {
-			yyb848 = yyj848 > l
+		yyj909++
+		if yyhl909 {
+			yyb909 = yyj909 > l
 		} else {
-			yyb848 = r.CheckBreak()
+			yyb909 = r.CheckBreak()
 		}
-		if yyb848 {
+		if yyb909 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj848-1, "")
+		z.DecStructFieldNotFound(yyj909-1, "")
 	}
 	r.ReadEnd()
 }
@@ -9340,34 +9961,34 @@ func (x *IngressList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym854 := z.EncBinary()
-		_ = yym854
+		yym915 := z.EncBinary()
+		_ = yym915
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep855 := !z.EncBinary()
-			yy2arr855 := z.EncBasicHandle().StructToArray
-			var yyq855 [4]bool
-			_, _, _ = yysep855, yyq855, yy2arr855
-			const yyr855 bool = false
-			yyq855[0] = x.Kind != ""
-			yyq855[1] = x.APIVersion != ""
-			yyq855[2] = true
-			if yyr855 || yy2arr855 {
+			yysep916 := !z.EncBinary()
+			yy2arr916 := z.EncBasicHandle().StructToArray
+			var yyq916 [4]bool
+			_, _, _ = yysep916, yyq916, yy2arr916
+			const yyr916 bool = false
+			yyq916[0] = x.Kind != ""
+			yyq916[1] = x.APIVersion != ""
+			yyq916[2] = true
+			if yyr916 || yy2arr916 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn855 int = 1
-				for _, b := range yyq855 {
+				var yynn916 int = 1
+				for _, b := range yyq916 {
 					if b {
-						yynn855++
+						yynn916++
 					}
 				}
-				r.EncodeMapStart(yynn855)
+				r.EncodeMapStart(yynn916)
 			}
-			if yyr855 || yy2arr855 {
-				if yyq855[0] {
-					yym857 := z.EncBinary()
-					_ = yym857
+			if yyr916 || yy2arr916 {
+				if yyq916[0] {
+					yym918 := z.EncBinary()
+					_ = yym918
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -9376,20 +9997,20 @@ func (x *IngressList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq855[0] {
+				if yyq916[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym858 := z.EncBinary()
-					_ = yym858
+					yym919 := z.EncBinary()
+					_ = yym919
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr855 || yy2arr855 {
-				if yyq855[1] {
-					yym860 := z.EncBinary()
-					_ = yym860
+			if yyr916 || yy2arr916 {
+				if yyq916[1] {
+					yym921 := z.EncBinary()
+					_ = yym921
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -9398,48 +10019,48 @@ func (x *IngressList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq855[1] {
+				if yyq916[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym861 := z.EncBinary()
-					_ = yym861
+					yym922 := z.EncBinary()
+					_ = yym922
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr855 || yy2arr855 {
-				if yyq855[2] {
-					yy863 := &x.ListMeta
-					yym864 := z.EncBinary()
-					_ = yym864
+			if yyr916 || yy2arr916 {
+				if yyq916[2] {
+					yy924 := &x.ListMeta
+					yym925 := z.EncBinary()
+					_ = yym925
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy863) {
+					} else if z.HasExtensions() && z.EncExt(yy924) {
 					} else {
-						z.EncFallback(yy863)
+						z.EncFallback(yy924)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq855[2] {
+				if yyq916[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy865 := &x.ListMeta
-					yym866 := z.EncBinary()
-					_ = yym866
+					yy926 := &x.ListMeta
+					yym927 := z.EncBinary()
+					_ = yym927
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy865) {
+					} else if z.HasExtensions() && z.EncExt(yy926) {
 					} else {
-						z.EncFallback(yy865)
+						z.EncFallback(yy926)
 					}
 				}
 			}
-			if yyr855 || yy2arr855 {
+			if yyr916 || yy2arr916 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym868 := z.EncBinary()
-					_ = yym868
+					yym929 := z.EncBinary()
+					_ = yym929
 					if false {
 					} else {
 						h.encSliceIngress(([]Ingress)(x.Items), e)
@@ -9450,15 +10071,15 @@ func (x *IngressList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym869 := z.EncBinary()
-					_ = yym869
+					yym930 := z.EncBinary()
+					_ = yym930
 					if false {
 					} else {
 						h.encSliceIngress(([]Ingress)(x.Items), e)
 					}
 				}
 			}
-			if yysep855 {
+			if yysep916 {
 				r.EncodeEnd()
 			}
 		}
@@ -9469,24 +10090,24 @@ func (x *IngressList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym870 := z.DecBinary()
-	_ = yym870
+	yym931 := z.DecBinary()
+	_ = yym931
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl871 := r.ReadMapStart()
-			if yyl871 == 0 {
+			yyl932 := r.ReadMapStart()
+			if yyl932 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl871, d)
+				x.codecDecodeSelfFromMap(yyl932, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl871 := r.ReadArrayStart()
-			if yyl871 == 0 {
+			yyl932 := r.ReadArrayStart()
+			if yyl932 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl871, d)
+				x.codecDecodeSelfFromArray(yyl932, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -9498,12 +10119,12 @@ func (x *IngressList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys872Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys872Slc
-	var yyhl872 bool = l >= 0
-	for yyj872 := 0; ; yyj872++ {
-		if yyhl872 {
-			if yyj872 >= l {
+	var yys933Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys933Slc
+	var yyhl933 bool = l >= 0
+	for yyj933 := 0; ; yyj933++ {
+		if yyhl933 {
+			if yyj933 >= l {
 				break
 			}
 		} else {
@@ -9511,9 +10132,9 @@ func (x *IngressList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys872Slc = r.DecodeBytes(yys872Slc, true, true)
-		yys872 := string(yys872Slc)
-		switch yys872 {
+		yys933Slc = r.DecodeBytes(yys933Slc, true, true)
+		yys933 := string(yys933Slc)
+		switch yys933 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -9530,32 +10151,32 @@ func (x *IngressList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv875 := &x.ListMeta
-				yym876 := z.DecBinary()
-				_ = yym876
+				yyv936 := &x.ListMeta
+				yym937 := z.DecBinary()
+				_ = yym937
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv875) {
+				} else if z.HasExtensions() && z.DecExt(yyv936) {
 				} else {
-					z.DecFallback(yyv875, false)
+					z.DecFallback(yyv936, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv877 := &x.Items
-				yym878 := z.DecBinary()
-				_ = yym878
+				yyv938 := &x.Items
+				yym939 := z.DecBinary()
+				_ = yym939
 				if false {
 				} else {
-					h.decSliceIngress((*[]Ingress)(yyv877), d)
+					h.decSliceIngress((*[]Ingress)(yyv938), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys872)
-		} // end switch yys872
-	} // end for yyj872
-	if !yyhl872 {
+			z.DecStructFieldNotFound(-1, yys933)
+		} // end switch yys933
+	} // end for yyj933
+	if !yyhl933 {
 		r.ReadEnd()
 	}
 }
@@ -9564,16 +10185,16 @@ func (x *IngressList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj879 int
-	var yyb879 bool
-	var yyhl879 bool = l >= 0
-	yyj879++
-	if yyhl879 {
-		yyb879 = yyj879 > l
+	var yyj940 int
+	var yyb940 bool
+	var yyhl940 bool = l >= 0
+	yyj940++
+	if yyhl940 {
+		yyb940 = yyj940 > l
 	} else {
-		yyb879 = r.CheckBreak()
+		yyb940 = r.CheckBreak()
 	}
-	if yyb879 {
+	if yyb940 {
 		r.ReadEnd()
 		return
 	}
@@ -9582,13 +10203,13 @@ func (x *IngressList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj879++
-	if yyhl879 {
-		yyb879 = yyj879 > l
+	yyj940++
+	if yyhl940 {
+		yyb940 = yyj940 > l
 	} else {
-		yyb879 = r.CheckBreak()
+		yyb940 = r.CheckBreak()
 	}
-	if yyb879 {
+	if yyb940 {
 		r.ReadEnd()
 		return
 	}
@@ -9597,60 +10218,60 @@ func (x *IngressList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj879++
-	if yyhl879 {
-		yyb879 = yyj879 > l
+	yyj940++
+	if yyhl940 {
+		yyb940 = yyj940 > l
 	} else {
-		yyb879 = r.CheckBreak()
+		yyb940 = r.CheckBreak()
 	}
-	if yyb879 {
+	if yyb940 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv882 := &x.ListMeta
-		yym883 := z.DecBinary()
-		_ = yym883
+		yyv943 := &x.ListMeta
+		yym944 := z.DecBinary()
+		_ = yym944
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv882) {
+		} else if z.HasExtensions() && z.DecExt(yyv943) {
 		} else {
-			z.DecFallback(yyv882, false)
+			z.DecFallback(yyv943, false)
 		}
 	}
-	yyj879++
-	if yyhl879 {
-		yyb879 = yyj879 > l
+	yyj940++
+	if yyhl940 {
+		yyb940 = yyj940 > l
 	} else {
-		yyb879 = r.CheckBreak()
+		yyb940 = r.CheckBreak()
 	}
-	if yyb879 {
+	if yyb940 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv884 := &x.Items
-		yym885 := z.DecBinary()
-		_ = yym885
+		yyv945 := &x.Items
+		yym946 := z.DecBinary()
+		_ = yym946
 		if false {
 		} else {
-			h.decSliceIngress((*[]Ingress)(yyv884), d)
+			h.decSliceIngress((*[]Ingress)(yyv945), d)
 		}
 	}
 	for {
-		yyj879++
-		if yyhl879 {
-			yyb879 = yyj879 > l
+		yyj940++
+		if yyhl940 {
+			yyb940 = yyj940 > l
 		} else {
-			yyb879 = r.CheckBreak()
+			yyb940 = r.CheckBreak()
 		}
-		if yyb879 {
+		if yyb940 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj879-1, "")
+		z.DecStructFieldNotFound(yyj940-1, "")
 	}
 	r.ReadEnd()
 }
@@ -9662,31 +10283,31 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym886 := z.EncBinary()
-		_ = yym886
+		yym947 := z.EncBinary()
+		_ = yym947
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep887 := !z.EncBinary()
-			yy2arr887 := z.EncBasicHandle().StructToArray
-			var yyq887 [2]bool
-			_, _, _ = yysep887, yyq887, yy2arr887
-			const yyr887 bool = false
-			yyq887[0] = x.Backend != nil
-			yyq887[1] = len(x.Rules) != 0
-			if yyr887 || yy2arr887 {
+			yysep948 := !z.EncBinary()
+			yy2arr948 := z.EncBasicHandle().StructToArray
+			var yyq948 [2]bool
+			_, _, _ = yysep948, yyq948, yy2arr948
+			const yyr948 bool = false
+			yyq948[0] = x.Backend != nil
+			yyq948[1] = len(x.Rules) != 0
+			if yyr948 || yy2arr948 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn887 int = 0
-				for _, b := range yyq887 {
+				var yynn948 int = 0
+				for _, b := range yyq948 {
 					if b {
-						yynn887++
+						yynn948++
 					}
 				}
-				r.EncodeMapStart(yynn887)
+				r.EncodeMapStart(yynn948)
 			}
-			if yyr887 || yy2arr887 {
-				if yyq887[0] {
+			if yyr948 || yy2arr948 {
+				if yyq948[0] {
 					if x.Backend == nil {
 						r.EncodeNil()
 					} else {
@@ -9696,7 +10317,7 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq887[0] {
+				if yyq948[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("backend"))
 					if x.Backend == nil {
 						r.EncodeNil()
@@ -9705,13 +10326,13 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr887 || yy2arr887 {
-				if yyq887[1] {
+			if yyr948 || yy2arr948 {
+				if yyq948[1] {
 					if x.Rules == nil {
 						r.EncodeNil()
 					} else {
-						yym890 := z.EncBinary()
-						_ = yym890
+						yym951 := z.EncBinary()
+						_ = yym951
 						if false {
 						} else {
 							h.encSliceIngressRule(([]IngressRule)(x.Rules), e)
@@ -9721,13 +10342,13 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq887[1] {
+				if yyq948[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("rules"))
 					if x.Rules == nil {
 						r.EncodeNil()
 					} else {
-						yym891 := z.EncBinary()
-						_ = yym891
+						yym952 := z.EncBinary()
+						_ = yym952
 						if false {
 						} else {
 							h.encSliceIngressRule(([]IngressRule)(x.Rules), e)
@@ -9735,7 +10356,7 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep887 {
+			if yysep948 {
 				r.EncodeEnd()
 			}
 		}
@@ -9746,24 +10367,24 @@ func (x *IngressSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym892 := z.DecBinary()
-	_ = yym892
+	yym953 := z.DecBinary()
+	_ = yym953
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl893 := r.ReadMapStart()
-			if yyl893 == 0 {
+			yyl954 := r.ReadMapStart()
+			if yyl954 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl893, d)
+				x.codecDecodeSelfFromMap(yyl954, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl893 := r.ReadArrayStart()
-			if yyl893 == 0 {
+			yyl954 := r.ReadArrayStart()
+			if yyl954 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl893, d)
+				x.codecDecodeSelfFromArray(yyl954, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -9775,12 +10396,12 @@ func (x *IngressSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys894Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys894Slc
-	var yyhl894 bool = l >= 0
-	for yyj894 := 0; ; yyj894++ {
-		if yyhl894 {
-			if yyj894 >= l {
+	var yys955Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys955Slc
+	var yyhl955 bool = l >= 0
+	for yyj955 := 0; ; yyj955++ {
+		if yyhl955 {
+			if yyj955 >= l {
 				break
 			}
 		} else {
@@ -9788,9 +10409,9 @@ func (x *IngressSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys894Slc = r.DecodeBytes(yys894Slc, true, true)
-		yys894 := string(yys894Slc)
-		switch yys894 {
+		yys955Slc = r.DecodeBytes(yys955Slc, true, true)
+		yys955 := string(yys955Slc)
+		switch yys955 {
 		case "backend":
 			if r.TryDecodeAsNil() {
 				if x.Backend != nil {
@@ -9806,19 +10427,19 @@ func (x *IngressSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.Rules = nil
 			} else {
-				yyv896 := &x.Rules
-				yym897 := z.DecBinary()
-				_ = yym897
+				yyv957 := &x.Rules
+				yym958 := z.DecBinary()
+				_ = yym958
 				if false {
 				} else {
-					h.decSliceIngressRule((*[]IngressRule)(yyv896), d)
+					h.decSliceIngressRule((*[]IngressRule)(yyv957), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys894)
-		} // end switch yys894
-	} // end for yyj894
-	if !yyhl894 {
+			z.DecStructFieldNotFound(-1, yys955)
+		} // end switch yys955
+	} // end for yyj955
+	if !yyhl955 {
 		r.ReadEnd()
 	}
 }
@@ -9827,16 +10448,16 @@ func (x *IngressSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj898 int
-	var yyb898 bool
-	var yyhl898 bool = l >= 0
-	yyj898++
-	if yyhl898 {
-		yyb898 = yyj898 > l
+	var yyj959 int
+	var yyb959 bool
+	var yyhl959 bool = l >= 0
+	yyj959++
+	if yyhl959 {
+		yyb959 = yyj959 > l
 	} else {
-		yyb898 = r.CheckBreak()
+		yyb959 = r.CheckBreak()
 	}
-	if yyb898 {
+	if yyb959 {
 		r.ReadEnd()
 		return
 	}
@@ -9850,38 +10471,38 @@ func (x *IngressSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		}
 		x.Backend.CodecDecodeSelf(d)
 	}
-	yyj898++
-	if yyhl898 {
-		yyb898 = yyj898 > l
+	yyj959++
+	if yyhl959 {
+		yyb959 = yyj959 > l
 	} else {
-		yyb898 = r.CheckBreak()
+		yyb959 = r.CheckBreak()
 	}
-	if yyb898 {
+	if yyb959 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Rules = nil
 	} else {
-		yyv900 := &x.Rules
-		yym901 := z.DecBinary()
-		_ = yym901
+		yyv961 := &x.Rules
+		yym962 := z.DecBinary()
+		_ = yym962
 		if false {
 		} else {
-			h.decSliceIngressRule((*[]IngressRule)(yyv900), d)
+			h.decSliceIngressRule((*[]IngressRule)(yyv961), d)
 		}
 	}
 	for {
-		yyj898++
-		if yyhl898 {
-			yyb898 = yyj898 > l
+		yyj959++
+		if yyhl959 {
+			yyb959 = yyj959 > l
 		} else {
-			yyb898 = r.CheckBreak()
+			yyb959 = r.CheckBreak()
 		}
-		if yyb898 {
+		if yyb959 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj898-1, "")
+		z.DecStructFieldNotFound(yyj959-1, "")
 	}
 	r.ReadEnd()
 }
@@ -9893,43 +10514,43 @@ func (x *IngressStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym902 := z.EncBinary()
-		_ = yym902
+		yym963 := z.EncBinary()
+		_ = yym963
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep903 := !z.EncBinary()
-			yy2arr903 := z.EncBasicHandle().StructToArray
-			var yyq903 [1]bool
-			_, _, _ = yysep903, yyq903, yy2arr903
-			const yyr903 bool = false
-			yyq903[0] = true
-			if yyr903 || yy2arr903 {
+			yysep964 := !z.EncBinary()
+			yy2arr964 := z.EncBasicHandle().StructToArray
+			var yyq964 [1]bool
+			_, _, _ = yysep964, yyq964, yy2arr964
+			const yyr964 bool = false
+			yyq964[0] = true
+			if yyr964 || yy2arr964 {
 				r.EncodeArrayStart(1)
 			} else {
-				var yynn903 int = 0
-				for _, b := range yyq903 {
+				var yynn964 int = 0
+				for _, b := range yyq964 {
 					if b {
-						yynn903++
+						yynn964++
 					}
 				}
-				r.EncodeMapStart(yynn903)
+				r.EncodeMapStart(yynn964)
 			}
-			if yyr903 || yy2arr903 {
-				if yyq903[0] {
-					yy905 := &x.LoadBalancer
-					yy905.CodecEncodeSelf(e)
+			if yyr964 || yy2arr964 {
+				if yyq964[0] {
+					yy966 := &x.LoadBalancer
+					yy966.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq903[0] {
+				if yyq964[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("loadBalancer"))
-					yy906 := &x.LoadBalancer
-					yy906.CodecEncodeSelf(e)
+					yy967 := &x.LoadBalancer
+					yy967.CodecEncodeSelf(e)
 				}
 			}
-			if yysep903 {
+			if yysep964 {
 				r.EncodeEnd()
 			}
 		}
@@ -9940,24 +10561,24 @@ func (x *IngressStatus) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym907 := z.DecBinary()
-	_ = yym907
+	yym968 := z.DecBinary()
+	_ = yym968
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl908 := r.ReadMapStart()
-			if yyl908 == 0 {
+			yyl969 := r.ReadMapStart()
+			if yyl969 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl908, d)
+				x.codecDecodeSelfFromMap(yyl969, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl908 := r.ReadArrayStart()
-			if yyl908 == 0 {
+			yyl969 := r.ReadArrayStart()
+			if yyl969 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl908, d)
+				x.codecDecodeSelfFromArray(yyl969, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -9969,12 +10590,12 @@ func (x *IngressStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys909Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys909Slc
-	var yyhl909 bool = l >= 0
-	for yyj909 := 0; ; yyj909++ {
-		if yyhl909 {
-			if yyj909 >= l {
+	var yys970Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys970Slc
+	var yyhl970 bool = l >= 0
+	for yyj970 := 0; ; yyj970++ {
+		if yyhl970 {
+			if yyj970 >= l {
 				break
 			}
 		} else {
@@ -9982,21 +10603,21 @@ func (x *IngressStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys909Slc = r.DecodeBytes(yys909Slc, true, true)
-		yys909 := string(yys909Slc)
-		switch yys909 {
+		yys970Slc = r.DecodeBytes(yys970Slc, true, true)
+		yys970 := string(yys970Slc)
+		switch yys970 {
 		case "loadBalancer":
 			if r.TryDecodeAsNil() {
 				x.LoadBalancer = pkg2_api.LoadBalancerStatus{}
 			} else {
-				yyv910 := &x.LoadBalancer
-				yyv910.CodecDecodeSelf(d)
+				yyv971 := &x.LoadBalancer
+				yyv971.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys909)
-		} // end switch yys909
-	} // end for yyj909
-	if !yyhl909 {
+			z.DecStructFieldNotFound(-1, yys970)
+		} // end switch yys970
+	} // end for yyj970
+	if !yyhl970 {
 		r.ReadEnd()
 	}
 }
@@ -10005,36 +10626,36 @@ func (x *IngressStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj911 int
-	var yyb911 bool
-	var yyhl911 bool = l >= 0
-	yyj911++
-	if yyhl911 {
-		yyb911 = yyj911 > l
+	var yyj972 int
+	var yyb972 bool
+	var yyhl972 bool = l >= 0
+	yyj972++
+	if yyhl972 {
+		yyb972 = yyj972 > l
 	} else {
-		yyb911 = r.CheckBreak()
+		yyb972 = r.CheckBreak()
 	}
-	if yyb911 {
+	if yyb972 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.LoadBalancer = pkg2_api.LoadBalancerStatus{}
 	} else {
-		yyv912 := &x.LoadBalancer
-		yyv912.CodecDecodeSelf(d)
+		yyv973 := &x.LoadBalancer
+		yyv973.CodecDecodeSelf(d)
 	}
 	for {
-		yyj911++
-		if yyhl911 {
-			yyb911 = yyj911 > l
+		yyj972++
+		if yyhl972 {
+			yyb972 = yyj972 > l
 		} else {
-			yyb911 = r.CheckBreak()
+			yyb972 = r.CheckBreak()
 		}
-		if yyb911 {
+		if yyb972 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj911-1, "")
+		z.DecStructFieldNotFound(yyj972-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10046,33 +10667,33 @@ func (x *IngressRule) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym913 := z.EncBinary()
-		_ = yym913
+		yym974 := z.EncBinary()
+		_ = yym974
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep914 := !z.EncBinary()
-			yy2arr914 := z.EncBasicHandle().StructToArray
-			var yyq914 [2]bool
-			_, _, _ = yysep914, yyq914, yy2arr914
-			const yyr914 bool = false
-			yyq914[0] = x.Host != ""
-			yyq914[1] = x.IngressRuleValue.HTTP != nil && x.HTTP != nil
-			if yyr914 || yy2arr914 {
+			yysep975 := !z.EncBinary()
+			yy2arr975 := z.EncBasicHandle().StructToArray
+			var yyq975 [2]bool
+			_, _, _ = yysep975, yyq975, yy2arr975
+			const yyr975 bool = false
+			yyq975[0] = x.Host != ""
+			yyq975[1] = x.IngressRuleValue.HTTP != nil && x.HTTP != nil
+			if yyr975 || yy2arr975 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn914 int = 0
-				for _, b := range yyq914 {
+				var yynn975 int = 0
+				for _, b := range yyq975 {
 					if b {
-						yynn914++
+						yynn975++
 					}
 				}
-				r.EncodeMapStart(yynn914)
+				r.EncodeMapStart(yynn975)
 			}
-			if yyr914 || yy2arr914 {
-				if yyq914[0] {
-					yym916 := z.EncBinary()
-					_ = yym916
+			if yyr975 || yy2arr975 {
+				if yyq975[0] {
+					yym977 := z.EncBinary()
+					_ = yym977
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Host))
@@ -10081,27 +10702,27 @@ func (x *IngressRule) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq914[0] {
+				if yyq975[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("host"))
-					yym917 := z.EncBinary()
-					_ = yym917
+					yym978 := z.EncBinary()
+					_ = yym978
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Host))
 					}
 				}
 			}
-			var yyn918 bool
+			var yyn979 bool
 			if x.IngressRuleValue.HTTP == nil {
-				yyn918 = true
-				goto LABEL918
+				yyn979 = true
+				goto LABEL979
 			}
-		LABEL918:
-			if yyr914 || yy2arr914 {
-				if yyn918 {
+		LABEL979:
+			if yyr975 || yy2arr975 {
+				if yyn979 {
 					r.EncodeNil()
 				} else {
-					if yyq914[1] {
+					if yyq975[1] {
 						if x.HTTP == nil {
 							r.EncodeNil()
 						} else {
@@ -10112,9 +10733,9 @@ func (x *IngressRule) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			} else {
-				if yyq914[1] {
+				if yyq975[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("http"))
-					if yyn918 {
+					if yyn979 {
 						r.EncodeNil()
 					} else {
 						if x.HTTP == nil {
@@ -10125,7 +10746,7 @@ func (x *IngressRule) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep914 {
+			if yysep975 {
 				r.EncodeEnd()
 			}
 		}
@@ -10136,24 +10757,24 @@ func (x *IngressRule) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym919 := z.DecBinary()
-	_ = yym919
+	yym980 := z.DecBinary()
+	_ = yym980
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl920 := r.ReadMapStart()
-			if yyl920 == 0 {
+			yyl981 := r.ReadMapStart()
+			if yyl981 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl920, d)
+				x.codecDecodeSelfFromMap(yyl981, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl920 := r.ReadArrayStart()
-			if yyl920 == 0 {
+			yyl981 := r.ReadArrayStart()
+			if yyl981 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl920, d)
+				x.codecDecodeSelfFromArray(yyl981, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10165,12 +10786,12 @@ func (x *IngressRule) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys921Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys921Slc
-	var yyhl921 bool = l >= 0
-	for yyj921 := 0; ; yyj921++ {
-		if yyhl921 {
-			if yyj921 >= l {
+	var yys982Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys982Slc
+	var yyhl982 bool = l >= 0
+	for yyj982 := 0; ; yyj982++ {
+		if yyhl982 {
+			if yyj982 >= l {
 				break
 			}
 		} else {
@@ -10178,9 +10799,9 @@ func (x *IngressRule) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys921Slc = r.DecodeBytes(yys921Slc, true, true)
-		yys921 := string(yys921Slc)
-		switch yys921 {
+		yys982Slc = r.DecodeBytes(yys982Slc, true, true)
+		yys982 := string(yys982Slc)
+		switch yys982 {
 		case "host":
 			if r.TryDecodeAsNil() {
 				x.Host = ""
@@ -10202,10 +10823,10 @@ func (x *IngressRule) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.HTTP.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys921)
-		} // end switch yys921
-	} // end for yyj921
-	if !yyhl921 {
+			z.DecStructFieldNotFound(-1, yys982)
+		} // end switch yys982
+	} // end for yyj982
+	if !yyhl982 {
 		r.ReadEnd()
 	}
 }
@@ -10214,16 +10835,16 @@ func (x *IngressRule) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj924 int
-	var yyb924 bool
-	var yyhl924 bool = l >= 0
-	yyj924++
-	if yyhl924 {
-		yyb924 = yyj924 > l
+	var yyj985 int
+	var yyb985 bool
+	var yyhl985 bool = l >= 0
+	yyj985++
+	if yyhl985 {
+		yyb985 = yyj985 > l
 	} else {
-		yyb924 = r.CheckBreak()
+		yyb985 = r.CheckBreak()
 	}
-	if yyb924 {
+	if yyb985 {
 		r.ReadEnd()
 		return
 	}
@@ -10232,13 +10853,13 @@ func (x *IngressRule) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Host = string(r.DecodeString())
 	}
-	yyj924++
-	if yyhl924 {
-		yyb924 = yyj924 > l
+	yyj985++
+	if yyhl985 {
+		yyb985 = yyj985 > l
 	} else {
-		yyb924 = r.CheckBreak()
+		yyb985 = r.CheckBreak()
 	}
-	if yyb924 {
+	if yyb985 {
 		r.ReadEnd()
 		return
 	}
@@ -10253,16 +10874,16 @@ func (x *IngressRule) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		x.HTTP.CodecDecodeSelf(d)
 	}
 	for {
-		yyj924++
-		if yyhl924 {
-			yyb924 = yyj924 > l
+		yyj985++
+		if yyhl985 {
+			yyb985 = yyj985 > l
 		} else {
-			yyb924 = r.CheckBreak()
+			yyb985 = r.CheckBreak()
 		}
-		if yyb924 {
+		if yyb985 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj924-1, "")
+		z.DecStructFieldNotFound(yyj985-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10274,30 +10895,30 @@ func (x *IngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym927 := z.EncBinary()
-		_ = yym927
+		yym988 := z.EncBinary()
+		_ = yym988
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep928 := !z.EncBinary()
-			yy2arr928 := z.EncBasicHandle().StructToArray
-			var yyq928 [1]bool
-			_, _, _ = yysep928, yyq928, yy2arr928
-			const yyr928 bool = false
-			yyq928[0] = x.HTTP != nil
-			if yyr928 || yy2arr928 {
+			yysep989 := !z.EncBinary()
+			yy2arr989 := z.EncBasicHandle().StructToArray
+			var yyq989 [1]bool
+			_, _, _ = yysep989, yyq989, yy2arr989
+			const yyr989 bool = false
+			yyq989[0] = x.HTTP != nil
+			if yyr989 || yy2arr989 {
 				r.EncodeArrayStart(1)
 			} else {
-				var yynn928 int = 0
-				for _, b := range yyq928 {
+				var yynn989 int = 0
+				for _, b := range yyq989 {
 					if b {
-						yynn928++
+						yynn989++
 					}
 				}
-				r.EncodeMapStart(yynn928)
+				r.EncodeMapStart(yynn989)
 			}
-			if yyr928 || yy2arr928 {
-				if yyq928[0] {
+			if yyr989 || yy2arr989 {
+				if yyq989[0] {
 					if x.HTTP == nil {
 						r.EncodeNil()
 					} else {
@@ -10307,7 +10928,7 @@ func (x *IngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq928[0] {
+				if yyq989[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("http"))
 					if x.HTTP == nil {
 						r.EncodeNil()
@@ -10316,7 +10937,7 @@ func (x *IngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep928 {
+			if yysep989 {
 				r.EncodeEnd()
 			}
 		}
@@ -10327,24 +10948,24 @@ func (x *IngressRuleValue) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym930 := z.DecBinary()
-	_ = yym930
+	yym991 := z.DecBinary()
+	_ = yym991
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl931 := r.ReadMapStart()
-			if yyl931 == 0 {
+			yyl992 := r.ReadMapStart()
+			if yyl992 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl931, d)
+				x.codecDecodeSelfFromMap(yyl992, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl931 := r.ReadArrayStart()
-			if yyl931 == 0 {
+			yyl992 := r.ReadArrayStart()
+			if yyl992 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl931, d)
+				x.codecDecodeSelfFromArray(yyl992, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10356,12 +10977,12 @@ func (x *IngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys932Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys932Slc
-	var yyhl932 bool = l >= 0
-	for yyj932 := 0; ; yyj932++ {
-		if yyhl932 {
-			if yyj932 >= l {
+	var yys993Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys993Slc
+	var yyhl993 bool = l >= 0
+	for yyj993 := 0; ; yyj993++ {
+		if yyhl993 {
+			if yyj993 >= l {
 				break
 			}
 		} else {
@@ -10369,9 +10990,9 @@ func (x *IngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys932Slc = r.DecodeBytes(yys932Slc, true, true)
-		yys932 := string(yys932Slc)
-		switch yys932 {
+		yys993Slc = r.DecodeBytes(yys993Slc, true, true)
+		yys993 := string(yys993Slc)
+		switch yys993 {
 		case "http":
 			if r.TryDecodeAsNil() {
 				if x.HTTP != nil {
@@ -10384,10 +11005,10 @@ func (x *IngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.HTTP.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys932)
-		} // end switch yys932
-	} // end for yyj932
-	if !yyhl932 {
+			z.DecStructFieldNotFound(-1, yys993)
+		} // end switch yys993
+	} // end for yyj993
+	if !yyhl993 {
 		r.ReadEnd()
 	}
 }
@@ -10396,16 +11017,16 @@ func (x *IngressRuleValue) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj934 int
-	var yyb934 bool
-	var yyhl934 bool = l >= 0
-	yyj934++
-	if yyhl934 {
-		yyb934 = yyj934 > l
+	var yyj995 int
+	var yyb995 bool
+	var yyhl995 bool = l >= 0
+	yyj995++
+	if yyhl995 {
+		yyb995 = yyj995 > l
 	} else {
-		yyb934 = r.CheckBreak()
+		yyb995 = r.CheckBreak()
 	}
-	if yyb934 {
+	if yyb995 {
 		r.ReadEnd()
 		return
 	}
@@ -10420,16 +11041,16 @@ func (x *IngressRuleValue) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 		x.HTTP.CodecDecodeSelf(d)
 	}
 	for {
-		yyj934++
-		if yyhl934 {
-			yyb934 = yyj934 > l
+		yyj995++
+		if yyhl995 {
+			yyb995 = yyj995 > l
 		} else {
-			yyb934 = r.CheckBreak()
+			yyb995 = r.CheckBreak()
 		}
-		if yyb934 {
+		if yyb995 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj934-1, "")
+		z.DecStructFieldNotFound(yyj995-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10441,33 +11062,33 @@ func (x *HTTPIngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym936 := z.EncBinary()
-		_ = yym936
+		yym997 := z.EncBinary()
+		_ = yym997
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep937 := !z.EncBinary()
-			yy2arr937 := z.EncBasicHandle().StructToArray
-			var yyq937 [1]bool
-			_, _, _ = yysep937, yyq937, yy2arr937
-			const yyr937 bool = false
-			if yyr937 || yy2arr937 {
+			yysep998 := !z.EncBinary()
+			yy2arr998 := z.EncBasicHandle().StructToArray
+			var yyq998 [1]bool
+			_, _, _ = yysep998, yyq998, yy2arr998
+			const yyr998 bool = false
+			if yyr998 || yy2arr998 {
 				r.EncodeArrayStart(1)
 			} else {
-				var yynn937 int = 1
-				for _, b := range yyq937 {
+				var yynn998 int = 1
+				for _, b := range yyq998 {
 					if b {
-						yynn937++
+						yynn998++
 					}
 				}
-				r.EncodeMapStart(yynn937)
+				r.EncodeMapStart(yynn998)
 			}
-			if yyr937 || yy2arr937 {
+			if yyr998 || yy2arr998 {
 				if x.Paths == nil {
 					r.EncodeNil()
 				} else {
-					yym939 := z.EncBinary()
-					_ = yym939
+					yym1000 := z.EncBinary()
+					_ = yym1000
 					if false {
 					} else {
 						h.encSliceHTTPIngressPath(([]HTTPIngressPath)(x.Paths), e)
@@ -10478,15 +11099,15 @@ func (x *HTTPIngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Paths == nil {
 					r.EncodeNil()
 				} else {
-					yym940 := z.EncBinary()
-					_ = yym940
+					yym1001 := z.EncBinary()
+					_ = yym1001
 					if false {
 					} else {
 						h.encSliceHTTPIngressPath(([]HTTPIngressPath)(x.Paths), e)
 					}
 				}
 			}
-			if yysep937 {
+			if yysep998 {
 				r.EncodeEnd()
 			}
 		}
@@ -10497,24 +11118,24 @@ func (x *HTTPIngressRuleValue) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym941 := z.DecBinary()
-	_ = yym941
+	yym1002 := z.DecBinary()
+	_ = yym1002
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl942 := r.ReadMapStart()
-			if yyl942 == 0 {
+			yyl1003 := r.ReadMapStart()
+			if yyl1003 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl942, d)
+				x.codecDecodeSelfFromMap(yyl1003, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl942 := r.ReadArrayStart()
-			if yyl942 == 0 {
+			yyl1003 := r.ReadArrayStart()
+			if yyl1003 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl942, d)
+				x.codecDecodeSelfFromArray(yyl1003, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10526,12 +11147,12 @@ func (x *HTTPIngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decode
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys943Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys943Slc
-	var yyhl943 bool = l >= 0
-	for yyj943 := 0; ; yyj943++ {
-		if yyhl943 {
-			if yyj943 >= l {
+	var yys1004Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1004Slc
+	var yyhl1004 bool = l >= 0
+	for yyj1004 := 0; ; yyj1004++ {
+		if yyhl1004 {
+			if yyj1004 >= l {
 				break
 			}
 		} else {
@@ -10539,26 +11160,26 @@ func (x *HTTPIngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decode
 				break
 			}
 		}
-		yys943Slc = r.DecodeBytes(yys943Slc, true, true)
-		yys943 := string(yys943Slc)
-		switch yys943 {
+		yys1004Slc = r.DecodeBytes(yys1004Slc, true, true)
+		yys1004 := string(yys1004Slc)
+		switch yys1004 {
 		case "paths":
 			if r.TryDecodeAsNil() {
 				x.Paths = nil
 			} else {
-				yyv944 := &x.Paths
-				yym945 := z.DecBinary()
-				_ = yym945
+				yyv1005 := &x.Paths
+				yym1006 := z.DecBinary()
+				_ = yym1006
 				if false {
 				} else {
-					h.decSliceHTTPIngressPath((*[]HTTPIngressPath)(yyv944), d)
+					h.decSliceHTTPIngressPath((*[]HTTPIngressPath)(yyv1005), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys943)
-		} // end switch yys943
-	} // end for yyj943
-	if !yyhl943 {
+			z.DecStructFieldNotFound(-1, yys1004)
+		} // end switch yys1004
+	} // end for yyj1004
+	if !yyhl1004 {
 		r.ReadEnd()
 	}
 }
@@ -10567,41 +11188,41 @@ func (x *HTTPIngressRuleValue) codecDecodeSelfFromArray(l int, d *codec1978.Deco
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj946 int
-	var yyb946 bool
-	var yyhl946 bool = l >= 0
-	yyj946++
-	if yyhl946 {
-		yyb946 = yyj946 > l
+	var yyj1007 int
+	var yyb1007 bool
+	var yyhl1007 bool = l >= 0
+	yyj1007++
+	if yyhl1007 {
+		yyb1007 = yyj1007 > l
 	} else {
-		yyb946 = r.CheckBreak()
+		yyb1007 = r.CheckBreak()
 	}
-	if yyb946 {
+	if yyb1007 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Paths = nil
 	} else {
-		yyv947 := &x.Paths
-		yym948 := z.DecBinary()
-		_ = yym948
+		yyv1008 := &x.Paths
+		yym1009 := z.DecBinary()
+		_ = yym1009
 		if false {
 		} else {
-			h.decSliceHTTPIngressPath((*[]HTTPIngressPath)(yyv947), d)
+			h.decSliceHTTPIngressPath((*[]HTTPIngressPath)(yyv1008), d)
 		}
 	}
 	for {
-		yyj946++
-		if yyhl946 {
-			yyb946 = yyj946 > l
+		yyj1007++
+		if yyhl1007 {
+			yyb1007 = yyj1007 > l
 		} else {
-			yyb946 = r.CheckBreak()
+			yyb1007 = r.CheckBreak()
 		}
-		if yyb946 {
+		if yyb1007 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj946-1, "")
+		z.DecStructFieldNotFound(yyj1007-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10613,32 +11234,32 @@ func (x *HTTPIngressPath) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym949 := z.EncBinary()
-		_ = yym949
+		yym1010 := z.EncBinary()
+		_ = yym1010
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep950 := !z.EncBinary()
-			yy2arr950 := z.EncBasicHandle().StructToArray
-			var yyq950 [2]bool
-			_, _, _ = yysep950, yyq950, yy2arr950
-			const yyr950 bool = false
-			yyq950[0] = x.Path != ""
-			if yyr950 || yy2arr950 {
+			yysep1011 := !z.EncBinary()
+			yy2arr1011 := z.EncBasicHandle().StructToArray
+			var yyq1011 [2]bool
+			_, _, _ = yysep1011, yyq1011, yy2arr1011
+			const yyr1011 bool = false
+			yyq1011[0] = x.Path != ""
+			if yyr1011 || yy2arr1011 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn950 int = 1
-				for _, b := range yyq950 {
+				var yynn1011 int = 1
+				for _, b := range yyq1011 {
 					if b {
-						yynn950++
+						yynn1011++
 					}
 				}
-				r.EncodeMapStart(yynn950)
+				r.EncodeMapStart(yynn1011)
 			}
-			if yyr950 || yy2arr950 {
-				if yyq950[0] {
-					yym952 := z.EncBinary()
-					_ = yym952
+			if yyr1011 || yy2arr1011 {
+				if yyq1011[0] {
+					yym1013 := z.EncBinary()
+					_ = yym1013
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Path))
@@ -10647,25 +11268,25 @@ func (x *HTTPIngressPath) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq950[0] {
+				if yyq1011[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("path"))
-					yym953 := z.EncBinary()
-					_ = yym953
+					yym1014 := z.EncBinary()
+					_ = yym1014
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Path))
 					}
 				}
 			}
-			if yyr950 || yy2arr950 {
-				yy955 := &x.Backend
-				yy955.CodecEncodeSelf(e)
+			if yyr1011 || yy2arr1011 {
+				yy1016 := &x.Backend
+				yy1016.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("backend"))
-				yy956 := &x.Backend
-				yy956.CodecEncodeSelf(e)
+				yy1017 := &x.Backend
+				yy1017.CodecEncodeSelf(e)
 			}
-			if yysep950 {
+			if yysep1011 {
 				r.EncodeEnd()
 			}
 		}
@@ -10676,24 +11297,24 @@ func (x *HTTPIngressPath) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym957 := z.DecBinary()
-	_ = yym957
+	yym1018 := z.DecBinary()
+	_ = yym1018
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl958 := r.ReadMapStart()
-			if yyl958 == 0 {
+			yyl1019 := r.ReadMapStart()
+			if yyl1019 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl958, d)
+				x.codecDecodeSelfFromMap(yyl1019, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl958 := r.ReadArrayStart()
-			if yyl958 == 0 {
+			yyl1019 := r.ReadArrayStart()
+			if yyl1019 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl958, d)
+				x.codecDecodeSelfFromArray(yyl1019, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10705,12 +11326,12 @@ func (x *HTTPIngressPath) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys959Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys959Slc
-	var yyhl959 bool = l >= 0
-	for yyj959 := 0; ; yyj959++ {
-		if yyhl959 {
-			if yyj959 >= l {
+	var yys1020Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1020Slc
+	var yyhl1020 bool = l >= 0
+	for yyj1020 := 0; ; yyj1020++ {
+		if yyhl1020 {
+			if yyj1020 >= l {
 				break
 			}
 		} else {
@@ -10718,9 +11339,9 @@ func (x *HTTPIngressPath) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys959Slc = r.DecodeBytes(yys959Slc, true, true)
-		yys959 := string(yys959Slc)
-		switch yys959 {
+		yys1020Slc = r.DecodeBytes(yys1020Slc, true, true)
+		yys1020 := string(yys1020Slc)
+		switch yys1020 {
 		case "path":
 			if r.TryDecodeAsNil() {
 				x.Path = ""
@@ -10731,14 +11352,14 @@ func (x *HTTPIngressPath) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.Backend = IngressBackend{}
 			} else {
-				yyv961 := &x.Backend
-				yyv961.CodecDecodeSelf(d)
+				yyv1022 := &x.Backend
+				yyv1022.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys959)
-		} // end switch yys959
-	} // end for yyj959
-	if !yyhl959 {
+			z.DecStructFieldNotFound(-1, yys1020)
+		} // end switch yys1020
+	} // end for yyj1020
+	if !yyhl1020 {
 		r.ReadEnd()
 	}
 }
@@ -10747,16 +11368,16 @@ func (x *HTTPIngressPath) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj962 int
-	var yyb962 bool
-	var yyhl962 bool = l >= 0
-	yyj962++
-	if yyhl962 {
-		yyb962 = yyj962 > l
+	var yyj1023 int
+	var yyb1023 bool
+	var yyhl1023 bool = l >= 0
+	yyj1023++
+	if yyhl1023 {
+		yyb1023 = yyj1023 > l
 	} else {
-		yyb962 = r.CheckBreak()
+		yyb1023 = r.CheckBreak()
 	}
-	if yyb962 {
+	if yyb1023 {
 		r.ReadEnd()
 		return
 	}
@@ -10765,33 +11386,33 @@ func (x *HTTPIngressPath) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	} else {
 		x.Path = string(r.DecodeString())
 	}
-	yyj962++
-	if yyhl962 {
-		yyb962 = yyj962 > l
+	yyj1023++
+	if yyhl1023 {
+		yyb1023 = yyj1023 > l
 	} else {
-		yyb962 = r.CheckBreak()
+		yyb1023 = r.CheckBreak()
 	}
-	if yyb962 {
+	if yyb1023 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Backend = IngressBackend{}
 	} else {
-		yyv964 := &x.Backend
-		yyv964.CodecDecodeSelf(d)
+		yyv1025 := &x.Backend
+		yyv1025.CodecDecodeSelf(d)
 	}
 	for {
-		yyj962++
-		if yyhl962 {
-			yyb962 = yyj962 > l
+		yyj1023++
+		if yyhl1023 {
+			yyb1023 = yyj1023 > l
 		} else {
-			yyb962 = r.CheckBreak()
+			yyb1023 = r.CheckBreak()
 		}
-		if yyb962 {
+		if yyb1023 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj962-1, "")
+		z.DecStructFieldNotFound(yyj1023-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10803,68 +11424,68 @@ func (x *IngressBackend) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym965 := z.EncBinary()
-		_ = yym965
+		yym1026 := z.EncBinary()
+		_ = yym1026
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep966 := !z.EncBinary()
-			yy2arr966 := z.EncBasicHandle().StructToArray
-			var yyq966 [2]bool
-			_, _, _ = yysep966, yyq966, yy2arr966
-			const yyr966 bool = false
-			if yyr966 || yy2arr966 {
+			yysep1027 := !z.EncBinary()
+			yy2arr1027 := z.EncBasicHandle().StructToArray
+			var yyq1027 [2]bool
+			_, _, _ = yysep1027, yyq1027, yy2arr1027
+			const yyr1027 bool = false
+			if yyr1027 || yy2arr1027 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn966 int = 2
-				for _, b := range yyq966 {
+				var yynn1027 int = 2
+				for _, b := range yyq1027 {
 					if b {
-						yynn966++
+						yynn1027++
 					}
 				}
-				r.EncodeMapStart(yynn966)
+				r.EncodeMapStart(yynn1027)
 			}
-			if yyr966 || yy2arr966 {
-				yym968 := z.EncBinary()
-				_ = yym968
+			if yyr1027 || yy2arr1027 {
+				yym1029 := z.EncBinary()
+				_ = yym1029
 				if false {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.ServiceName))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("serviceName"))
-				yym969 := z.EncBinary()
-				_ = yym969
+				yym1030 := z.EncBinary()
+				_ = yym1030
 				if false {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.ServiceName))
 				}
 			}
-			if yyr966 || yy2arr966 {
-				yy971 := &x.ServicePort
-				yym972 := z.EncBinary()
-				_ = yym972
+			if yyr1027 || yy2arr1027 {
+				yy1032 := &x.ServicePort
+				yym1033 := z.EncBinary()
+				_ = yym1033
 				if false {
-				} else if z.HasExtensions() && z.EncExt(yy971) {
-				} else if !yym972 && z.IsJSONHandle() {
-					z.EncJSONMarshal(yy971)
+				} else if z.HasExtensions() && z.EncExt(yy1032) {
+				} else if !yym1033 && z.IsJSONHandle() {
+					z.EncJSONMarshal(yy1032)
 				} else {
-					z.EncFallback(yy971)
+					z.EncFallback(yy1032)
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("servicePort"))
-				yy973 := &x.ServicePort
-				yym974 := z.EncBinary()
-				_ = yym974
+				yy1034 := &x.ServicePort
+				yym1035 := z.EncBinary()
+				_ = yym1035
 				if false {
-				} else if z.HasExtensions() && z.EncExt(yy973) {
-				} else if !yym974 && z.IsJSONHandle() {
-					z.EncJSONMarshal(yy973)
+				} else if z.HasExtensions() && z.EncExt(yy1034) {
+				} else if !yym1035 && z.IsJSONHandle() {
+					z.EncJSONMarshal(yy1034)
 				} else {
-					z.EncFallback(yy973)
+					z.EncFallback(yy1034)
 				}
 			}
-			if yysep966 {
+			if yysep1027 {
 				r.EncodeEnd()
 			}
 		}
@@ -10875,24 +11496,24 @@ func (x *IngressBackend) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym975 := z.DecBinary()
-	_ = yym975
+	yym1036 := z.DecBinary()
+	_ = yym1036
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl976 := r.ReadMapStart()
-			if yyl976 == 0 {
+			yyl1037 := r.ReadMapStart()
+			if yyl1037 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl976, d)
+				x.codecDecodeSelfFromMap(yyl1037, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl976 := r.ReadArrayStart()
-			if yyl976 == 0 {
+			yyl1037 := r.ReadArrayStart()
+			if yyl1037 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl976, d)
+				x.codecDecodeSelfFromArray(yyl1037, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10904,12 +11525,12 @@ func (x *IngressBackend) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys977Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys977Slc
-	var yyhl977 bool = l >= 0
-	for yyj977 := 0; ; yyj977++ {
-		if yyhl977 {
-			if yyj977 >= l {
+	var yys1038Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1038Slc
+	var yyhl1038 bool = l >= 0
+	for yyj1038 := 0; ; yyj1038++ {
+		if yyhl1038 {
+			if yyj1038 >= l {
 				break
 			}
 		} else {
@@ -10917,9 +11538,9 @@ func (x *IngressBackend) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys977Slc = r.DecodeBytes(yys977Slc, true, true)
-		yys977 := string(yys977Slc)
-		switch yys977 {
+		yys1038Slc = r.DecodeBytes(yys1038Slc, true, true)
+		yys1038 := string(yys1038Slc)
+		switch yys1038 {
 		case "serviceName":
 			if r.TryDecodeAsNil() {
 				x.ServiceName = ""
@@ -10930,22 +11551,22 @@ func (x *IngressBackend) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ServicePort = pkg6_util.IntOrString{}
 			} else {
-				yyv979 := &x.ServicePort
-				yym980 := z.DecBinary()
-				_ = yym980
+				yyv1040 := &x.ServicePort
+				yym1041 := z.DecBinary()
+				_ = yym1041
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv979) {
-				} else if !yym980 && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(yyv979)
+				} else if z.HasExtensions() && z.DecExt(yyv1040) {
+				} else if !yym1041 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv1040)
 				} else {
-					z.DecFallback(yyv979, false)
+					z.DecFallback(yyv1040, false)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys977)
-		} // end switch yys977
-	} // end for yyj977
-	if !yyhl977 {
+			z.DecStructFieldNotFound(-1, yys1038)
+		} // end switch yys1038
+	} // end for yyj1038
+	if !yyhl1038 {
 		r.ReadEnd()
 	}
 }
@@ -10954,16 +11575,16 @@ func (x *IngressBackend) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj981 int
-	var yyb981 bool
-	var yyhl981 bool = l >= 0
-	yyj981++
-	if yyhl981 {
-		yyb981 = yyj981 > l
+	var yyj1042 int
+	var yyb1042 bool
+	var yyhl1042 bool = l >= 0
+	yyj1042++
+	if yyhl1042 {
+		yyb1042 = yyj1042 > l
 	} else {
-		yyb981 = r.CheckBreak()
+		yyb1042 = r.CheckBreak()
 	}
-	if yyb981 {
+	if yyb1042 {
 		r.ReadEnd()
 		return
 	}
@@ -10972,41 +11593,41 @@ func (x *IngressBackend) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.ServiceName = string(r.DecodeString())
 	}
-	yyj981++
-	if yyhl981 {
-		yyb981 = yyj981 > l
+	yyj1042++
+	if yyhl1042 {
+		yyb1042 = yyj1042 > l
 	} else {
-		yyb981 = r.CheckBreak()
+		yyb1042 = r.CheckBreak()
 	}
-	if yyb981 {
+	if yyb1042 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ServicePort = pkg6_util.IntOrString{}
 	} else {
-		yyv983 := &x.ServicePort
-		yym984 := z.DecBinary()
-		_ = yym984
+		yyv1044 := &x.ServicePort
+		yym1045 := z.DecBinary()
+		_ = yym1045
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv983) {
-		} else if !yym984 && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(yyv983)
+		} else if z.HasExtensions() && z.DecExt(yyv1044) {
+		} else if !yym1045 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv1044)
 		} else {
-			z.DecFallback(yyv983, false)
+			z.DecFallback(yyv1044, false)
 		}
 	}
 	for {
-		yyj981++
-		if yyhl981 {
-			yyb981 = yyj981 > l
+		yyj1042++
+		if yyhl1042 {
+			yyb1042 = yyj1042 > l
 		} else {
-			yyb981 = r.CheckBreak()
+			yyb1042 = r.CheckBreak()
 		}
-		if yyb981 {
+		if yyb1042 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj981-1, "")
+		z.DecStructFieldNotFound(yyj1042-1, "")
 	}
 	r.ReadEnd()
 }
@@ -11015,8 +11636,8 @@ func (x NodeResource) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	yym985 := z.EncBinary()
-	_ = yym985
+	yym1046 := z.EncBinary()
+	_ = yym1046
 	if false {
 	} else if z.HasExtensions() && z.EncExt(x) {
 	} else {
@@ -11028,8 +11649,8 @@ func (x *NodeResource) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym986 := z.DecBinary()
-	_ = yym986
+	yym1047 := z.DecBinary()
+	_ = yym1047
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
@@ -11044,50 +11665,50 @@ func (x *NodeUtilization) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym987 := z.EncBinary()
-		_ = yym987
+		yym1048 := z.EncBinary()
+		_ = yym1048
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep988 := !z.EncBinary()
-			yy2arr988 := z.EncBasicHandle().StructToArray
-			var yyq988 [2]bool
-			_, _, _ = yysep988, yyq988, yy2arr988
-			const yyr988 bool = false
-			if yyr988 || yy2arr988 {
+			yysep1049 := !z.EncBinary()
+			yy2arr1049 := z.EncBasicHandle().StructToArray
+			var yyq1049 [2]bool
+			_, _, _ = yysep1049, yyq1049, yy2arr1049
+			const yyr1049 bool = false
+			if yyr1049 || yy2arr1049 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn988 int = 2
-				for _, b := range yyq988 {
+				var yynn1049 int = 2
+				for _, b := range yyq1049 {
 					if b {
-						yynn988++
+						yynn1049++
 					}
 				}
-				r.EncodeMapStart(yynn988)
+				r.EncodeMapStart(yynn1049)
 			}
-			if yyr988 || yy2arr988 {
+			if yyr1049 || yy2arr1049 {
 				x.Resource.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("resource"))
 				x.Resource.CodecEncodeSelf(e)
 			}
-			if yyr988 || yy2arr988 {
-				yym991 := z.EncBinary()
-				_ = yym991
+			if yyr1049 || yy2arr1049 {
+				yym1052 := z.EncBinary()
+				_ = yym1052
 				if false {
 				} else {
 					r.EncodeFloat64(float64(x.Value))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("value"))
-				yym992 := z.EncBinary()
-				_ = yym992
+				yym1053 := z.EncBinary()
+				_ = yym1053
 				if false {
 				} else {
 					r.EncodeFloat64(float64(x.Value))
 				}
 			}
-			if yysep988 {
+			if yysep1049 {
 				r.EncodeEnd()
 			}
 		}
@@ -11098,24 +11719,24 @@ func (x *NodeUtilization) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym993 := z.DecBinary()
-	_ = yym993
+	yym1054 := z.DecBinary()
+	_ = yym1054
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl994 := r.ReadMapStart()
-			if yyl994 == 0 {
+			yyl1055 := r.ReadMapStart()
+			if yyl1055 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl994, d)
+				x.codecDecodeSelfFromMap(yyl1055, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl994 := r.ReadArrayStart()
-			if yyl994 == 0 {
+			yyl1055 := r.ReadArrayStart()
+			if yyl1055 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl994, d)
+				x.codecDecodeSelfFromArray(yyl1055, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -11127,12 +11748,12 @@ func (x *NodeUtilization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys995Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys995Slc
-	var yyhl995 bool = l >= 0
-	for yyj995 := 0; ; yyj995++ {
-		if yyhl995 {
-			if yyj995 >= l {
+	var yys1056Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1056Slc
+	var yyhl1056 bool = l >= 0
+	for yyj1056 := 0; ; yyj1056++ {
+		if yyhl1056 {
+			if yyj1056 >= l {
 				break
 			}
 		} else {
@@ -11140,9 +11761,9 @@ func (x *NodeUtilization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys995Slc = r.DecodeBytes(yys995Slc, true, true)
-		yys995 := string(yys995Slc)
-		switch yys995 {
+		yys1056Slc = r.DecodeBytes(yys1056Slc, true, true)
+		yys1056 := string(yys1056Slc)
+		switch yys1056 {
 		case "resource":
 			if r.TryDecodeAsNil() {
 				x.Resource = ""
@@ -11156,10 +11777,10 @@ func (x *NodeUtilization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.Value = float64(r.DecodeFloat(false))
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys995)
-		} // end switch yys995
-	} // end for yyj995
-	if !yyhl995 {
+			z.DecStructFieldNotFound(-1, yys1056)
+		} // end switch yys1056
+	} // end for yyj1056
+	if !yyhl1056 {
 		r.ReadEnd()
 	}
 }
@@ -11168,16 +11789,16 @@ func (x *NodeUtilization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj998 int
-	var yyb998 bool
-	var yyhl998 bool = l >= 0
-	yyj998++
-	if yyhl998 {
-		yyb998 = yyj998 > l
+	var yyj1059 int
+	var yyb1059 bool
+	var yyhl1059 bool = l >= 0
+	yyj1059++
+	if yyhl1059 {
+		yyb1059 = yyj1059 > l
 	} else {
-		yyb998 = r.CheckBreak()
+		yyb1059 = r.CheckBreak()
 	}
-	if yyb998 {
+	if yyb1059 {
 		r.ReadEnd()
 		return
 	}
@@ -11186,13 +11807,13 @@ func (x *NodeUtilization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	} else {
 		x.Resource = NodeResource(r.DecodeString())
 	}
-	yyj998++
-	if yyhl998 {
-		yyb998 = yyj998 > l
+	yyj1059++
+	if yyhl1059 {
+		yyb1059 = yyj1059 > l
 	} else {
-		yyb998 = r.CheckBreak()
+		yyb1059 = r.CheckBreak()
 	}
-	if yyb998 {
+	if yyb1059 {
 		r.ReadEnd()
 		return
 	}
@@ -11202,16 +11823,16 @@ func (x *NodeUtilization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 		x.Value = float64(r.DecodeFloat(false))
 	}
 	for {
-		yyj998++
-		if yyhl998 {
-			yyb998 = yyj998 > l
+		yyj1059++
+		if yyhl1059 {
+			yyb1059 = yyj1059 > l
 		} else {
-			yyb998 = r.CheckBreak()
+			yyb1059 = r.CheckBreak()
 		}
-		if yyb998 {
+		if yyb1059 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj998-1, "")
+		z.DecStructFieldNotFound(yyj1059-1, "")
 	}
 	r.ReadEnd()
 }
@@ -11223,65 +11844,65 @@ func (x *ClusterAutoscalerSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1001 := z.EncBinary()
-		_ = yym1001
+		yym1062 := z.EncBinary()
+		_ = yym1062
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1002 := !z.EncBinary()
-			yy2arr1002 := z.EncBasicHandle().StructToArray
-			var yyq1002 [3]bool
-			_, _, _ = yysep1002, yyq1002, yy2arr1002
-			const yyr1002 bool = false
-			if yyr1002 || yy2arr1002 {
+			yysep1063 := !z.EncBinary()
+			yy2arr1063 := z.EncBasicHandle().StructToArray
+			var yyq1063 [3]bool
+			_, _, _ = yysep1063, yyq1063, yy2arr1063
+			const yyr1063 bool = false
+			if yyr1063 || yy2arr1063 {
 				r.EncodeArrayStart(3)
 			} else {
-				var yynn1002 int = 3
-				for _, b := range yyq1002 {
+				var yynn1063 int = 3
+				for _, b := range yyq1063 {
 					if b {
-						yynn1002++
+						yynn1063++
 					}
 				}
-				r.EncodeMapStart(yynn1002)
+				r.EncodeMapStart(yynn1063)
 			}
-			if yyr1002 || yy2arr1002 {
-				yym1004 := z.EncBinary()
-				_ = yym1004
+			if yyr1063 || yy2arr1063 {
+				yym1065 := z.EncBinary()
+				_ = yym1065
 				if false {
 				} else {
 					r.EncodeInt(int64(x.MinNodes))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("minNodes"))
-				yym1005 := z.EncBinary()
-				_ = yym1005
+				yym1066 := z.EncBinary()
+				_ = yym1066
 				if false {
 				} else {
 					r.EncodeInt(int64(x.MinNodes))
 				}
 			}
-			if yyr1002 || yy2arr1002 {
-				yym1007 := z.EncBinary()
-				_ = yym1007
+			if yyr1063 || yy2arr1063 {
+				yym1068 := z.EncBinary()
+				_ = yym1068
 				if false {
 				} else {
 					r.EncodeInt(int64(x.MaxNodes))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("maxNodes"))
-				yym1008 := z.EncBinary()
-				_ = yym1008
+				yym1069 := z.EncBinary()
+				_ = yym1069
 				if false {
 				} else {
 					r.EncodeInt(int64(x.MaxNodes))
 				}
 			}
-			if yyr1002 || yy2arr1002 {
+			if yyr1063 || yy2arr1063 {
 				if x.TargetUtilization == nil {
 					r.EncodeNil()
 				} else {
-					yym1010 := z.EncBinary()
-					_ = yym1010
+					yym1071 := z.EncBinary()
+					_ = yym1071
 					if false {
 					} else {
 						h.encSliceNodeUtilization(([]NodeUtilization)(x.TargetUtilization), e)
@@ -11292,15 +11913,15 @@ func (x *ClusterAutoscalerSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.TargetUtilization == nil {
 					r.EncodeNil()
 				} else {
-					yym1011 := z.EncBinary()
-					_ = yym1011
+					yym1072 := z.EncBinary()
+					_ = yym1072
 					if false {
 					} else {
 						h.encSliceNodeUtilization(([]NodeUtilization)(x.TargetUtilization), e)
 					}
 				}
 			}
-			if yysep1002 {
+			if yysep1063 {
 				r.EncodeEnd()
 			}
 		}
@@ -11311,24 +11932,24 @@ func (x *ClusterAutoscalerSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1012 := z.DecBinary()
-	_ = yym1012
+	yym1073 := z.DecBinary()
+	_ = yym1073
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1013 := r.ReadMapStart()
-			if yyl1013 == 0 {
+			yyl1074 := r.ReadMapStart()
+			if yyl1074 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1013, d)
+				x.codecDecodeSelfFromMap(yyl1074, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1013 := r.ReadArrayStart()
-			if yyl1013 == 0 {
+			yyl1074 := r.ReadArrayStart()
+			if yyl1074 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1013, d)
+				x.codecDecodeSelfFromArray(yyl1074, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -11340,12 +11961,12 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1014Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1014Slc
-	var yyhl1014 bool = l >= 0
-	for yyj1014 := 0; ; yyj1014++ {
-		if yyhl1014 {
-			if yyj1014 >= l {
+	var yys1075Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1075Slc
+	var yyhl1075 bool = l >= 0
+	for yyj1075 := 0; ; yyj1075++ {
+		if yyhl1075 {
+			if yyj1075 >= l {
 				break
 			}
 		} else {
@@ -11353,9 +11974,9 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 				break
 			}
 		}
-		yys1014Slc = r.DecodeBytes(yys1014Slc, true, true)
-		yys1014 := string(yys1014Slc)
-		switch yys1014 {
+		yys1075Slc = r.DecodeBytes(yys1075Slc, true, true)
+		yys1075 := string(yys1075Slc)
+		switch yys1075 {
 		case "minNodes":
 			if r.TryDecodeAsNil() {
 				x.MinNodes = 0
@@ -11372,19 +11993,19 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 			if r.TryDecodeAsNil() {
 				x.TargetUtilization = nil
 			} else {
-				yyv1017 := &x.TargetUtilization
-				yym1018 := z.DecBinary()
-				_ = yym1018
+				yyv1078 := &x.TargetUtilization
+				yym1079 := z.DecBinary()
+				_ = yym1079
 				if false {
 				} else {
-					h.decSliceNodeUtilization((*[]NodeUtilization)(yyv1017), d)
+					h.decSliceNodeUtilization((*[]NodeUtilization)(yyv1078), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1014)
-		} // end switch yys1014
-	} // end for yyj1014
-	if !yyhl1014 {
+			z.DecStructFieldNotFound(-1, yys1075)
+		} // end switch yys1075
+	} // end for yyj1075
+	if !yyhl1075 {
 		r.ReadEnd()
 	}
 }
@@ -11393,16 +12014,16 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1019 int
-	var yyb1019 bool
-	var yyhl1019 bool = l >= 0
-	yyj1019++
-	if yyhl1019 {
-		yyb1019 = yyj1019 > l
+	var yyj1080 int
+	var yyb1080 bool
+	var yyhl1080 bool = l >= 0
+	yyj1080++
+	if yyhl1080 {
+		yyb1080 = yyj1080 > l
 	} else {
-		yyb1019 = r.CheckBreak()
+		yyb1080 = r.CheckBreak()
 	}
-	if yyb1019 {
+	if yyb1080 {
 		r.ReadEnd()
 		return
 	}
@@ -11411,13 +12032,13 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	} else {
 		x.MinNodes = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj1019++
-	if yyhl1019 {
-		yyb1019 = yyj1019 > l
+	yyj1080++
+	if yyhl1080 {
+		yyb1080 = yyj1080 > l
 	} else {
-		yyb1019 = r.CheckBreak()
+		yyb1080 = r.CheckBreak()
 	}
-	if yyb1019 {
+	if yyb1080 {
 		r.ReadEnd()
 		return
 	}
@@ -11426,38 +12047,38 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	} else {
 		x.MaxNodes = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj1019++
-	if yyhl1019 {
-		yyb1019 = yyj1019 > l
+	yyj1080++
+	if yyhl1080 {
+		yyb1080 = yyj1080 > l
 	} else {
-		yyb1019 = r.CheckBreak()
+		yyb1080 = r.CheckBreak()
 	}
-	if yyb1019 {
+	if yyb1080 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.TargetUtilization = nil
 	} else {
-		yyv1022 := &x.TargetUtilization
-		yym1023 := z.DecBinary()
-		_ = yym1023
+		yyv1083 := &x.TargetUtilization
+		yym1084 := z.DecBinary()
+		_ = yym1084
 		if false {
 		} else {
-			h.decSliceNodeUtilization((*[]NodeUtilization)(yyv1022), d)
+			h.decSliceNodeUtilization((*[]NodeUtilization)(yyv1083), d)
 		}
 	}
 	for {
-		yyj1019++
-		if yyhl1019 {
-			yyb1019 = yyj1019 > l
+		yyj1080++
+		if yyhl1080 {
+			yyb1080 = yyj1080 > l
 		} else {
-			yyb1019 = r.CheckBreak()
+			yyb1080 = r.CheckBreak()
 		}
-		if yyb1019 {
+		if yyb1080 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1019-1, "")
+		z.DecStructFieldNotFound(yyj1080-1, "")
 	}
 	r.ReadEnd()
 }
@@ -11469,35 +12090,35 @@ func (x *ClusterAutoscaler) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1024 := z.EncBinary()
-		_ = yym1024
+		yym1085 := z.EncBinary()
+		_ = yym1085
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1025 := !z.EncBinary()
-			yy2arr1025 := z.EncBasicHandle().StructToArray
-			var yyq1025 [4]bool
-			_, _, _ = yysep1025, yyq1025, yy2arr1025
-			const yyr1025 bool = false
-			yyq1025[0] = x.Kind != ""
-			yyq1025[1] = x.APIVersion != ""
-			yyq1025[2] = true
-			yyq1025[3] = true
-			if yyr1025 || yy2arr1025 {
+			yysep1086 := !z.EncBinary()
+			yy2arr1086 := z.EncBasicHandle().StructToArray
+			var yyq1086 [4]bool
+			_, _, _ = yysep1086, yyq1086, yy2arr1086
+			const yyr1086 bool = false
+			yyq1086[0] = x.Kind != ""
+			yyq1086[1] = x.APIVersion != ""
+			yyq1086[2] = true
+			yyq1086[3] = true
+			if yyr1086 || yy2arr1086 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn1025 int = 0
-				for _, b := range yyq1025 {
+				var yynn1086 int = 0
+				for _, b := range yyq1086 {
 					if b {
-						yynn1025++
+						yynn1086++
 					}
 				}
-				r.EncodeMapStart(yynn1025)
+				r.EncodeMapStart(yynn1086)
 			}
-			if yyr1025 || yy2arr1025 {
-				if yyq1025[0] {
-					yym1027 := z.EncBinary()
-					_ = yym1027
+			if yyr1086 || yy2arr1086 {
+				if yyq1086[0] {
+					yym1088 := z.EncBinary()
+					_ = yym1088
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -11506,20 +12127,20 @@ func (x *ClusterAutoscaler) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq1025[0] {
+				if yyq1086[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym1028 := z.EncBinary()
-					_ = yym1028
+					yym1089 := z.EncBinary()
+					_ = yym1089
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr1025 || yy2arr1025 {
-				if yyq1025[1] {
-					yym1030 := z.EncBinary()
-					_ = yym1030
+			if yyr1086 || yy2arr1086 {
+				if yyq1086[1] {
+					yym1091 := z.EncBinary()
+					_ = yym1091
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -11528,45 +12149,45 @@ func (x *ClusterAutoscaler) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq1025[1] {
+				if yyq1086[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym1031 := z.EncBinary()
-					_ = yym1031
+					yym1092 := z.EncBinary()
+					_ = yym1092
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr1025 || yy2arr1025 {
-				if yyq1025[2] {
-					yy1033 := &x.ObjectMeta
-					yy1033.CodecEncodeSelf(e)
+			if yyr1086 || yy2arr1086 {
+				if yyq1086[2] {
+					yy1094 := &x.ObjectMeta
+					yy1094.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1025[2] {
+				if yyq1086[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy1034 := &x.ObjectMeta
-					yy1034.CodecEncodeSelf(e)
+					yy1095 := &x.ObjectMeta
+					yy1095.CodecEncodeSelf(e)
 				}
 			}
-			if yyr1025 || yy2arr1025 {
-				if yyq1025[3] {
-					yy1036 := &x.Spec
-					yy1036.CodecEncodeSelf(e)
+			if yyr1086 || yy2arr1086 {
+				if yyq1086[3] {
+					yy1097 := &x.Spec
+					yy1097.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1025[3] {
+				if yyq1086[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("spec"))
-					yy1037 := &x.Spec
-					yy1037.CodecEncodeSelf(e)
+					yy1098 := &x.Spec
+					yy1098.CodecEncodeSelf(e)
 				}
 			}
-			if yysep1025 {
+			if yysep1086 {
 				r.EncodeEnd()
 			}
 		}
@@ -11577,24 +12198,24 @@ func (x *ClusterAutoscaler) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1038 := z.DecBinary()
-	_ = yym1038
+	yym1099 := z.DecBinary()
+	_ = yym1099
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1039 := r.ReadMapStart()
-			if yyl1039 == 0 {
+			yyl1100 := r.ReadMapStart()
+			if yyl1100 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1039, d)
+				x.codecDecodeSelfFromMap(yyl1100, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1039 := r.ReadArrayStart()
-			if yyl1039 == 0 {
+			yyl1100 := r.ReadArrayStart()
+			if yyl1100 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1039, d)
+				x.codecDecodeSelfFromArray(yyl1100, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -11606,12 +12227,12 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1040Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1040Slc
-	var yyhl1040 bool = l >= 0
-	for yyj1040 := 0; ; yyj1040++ {
-		if yyhl1040 {
-			if yyj1040 >= l {
+	var yys1101Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1101Slc
+	var yyhl1101 bool = l >= 0
+	for yyj1101 := 0; ; yyj1101++ {
+		if yyhl1101 {
+			if yyj1101 >= l {
 				break
 			}
 		} else {
@@ -11619,9 +12240,9 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 				break
 			}
 		}
-		yys1040Slc = r.DecodeBytes(yys1040Slc, true, true)
-		yys1040 := string(yys1040Slc)
-		switch yys1040 {
+		yys1101Slc = r.DecodeBytes(yys1101Slc, true, true)
+		yys1101 := string(yys1101Slc)
+		switch yys1101 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -11638,21 +12259,21 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 			if r.TryDecodeAsNil() {
 				x.ObjectMeta = pkg2_api.ObjectMeta{}
 			} else {
-				yyv1043 := &x.ObjectMeta
-				yyv1043.CodecDecodeSelf(d)
+				yyv1104 := &x.ObjectMeta
+				yyv1104.CodecDecodeSelf(d)
 			}
 		case "spec":
 			if r.TryDecodeAsNil() {
 				x.Spec = ClusterAutoscalerSpec{}
 			} else {
-				yyv1044 := &x.Spec
-				yyv1044.CodecDecodeSelf(d)
+				yyv1105 := &x.Spec
+				yyv1105.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1040)
-		} // end switch yys1040
-	} // end for yyj1040
-	if !yyhl1040 {
+			z.DecStructFieldNotFound(-1, yys1101)
+		} // end switch yys1101
+	} // end for yyj1101
+	if !yyhl1101 {
 		r.ReadEnd()
 	}
 }
@@ -11661,16 +12282,16 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromArray(l int, d *codec1978.Decoder
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1045 int
-	var yyb1045 bool
-	var yyhl1045 bool = l >= 0
-	yyj1045++
-	if yyhl1045 {
-		yyb1045 = yyj1045 > l
+	var yyj1106 int
+	var yyb1106 bool
+	var yyhl1106 bool = l >= 0
+	yyj1106++
+	if yyhl1106 {
+		yyb1106 = yyj1106 > l
 	} else {
-		yyb1045 = r.CheckBreak()
+		yyb1106 = r.CheckBreak()
 	}
-	if yyb1045 {
+	if yyb1106 {
 		r.ReadEnd()
 		return
 	}
@@ -11679,13 +12300,13 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromArray(l int, d *codec1978.Decoder
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj1045++
-	if yyhl1045 {
-		yyb1045 = yyj1045 > l
+	yyj1106++
+	if yyhl1106 {
+		yyb1106 = yyj1106 > l
 	} else {
-		yyb1045 = r.CheckBreak()
+		yyb1106 = r.CheckBreak()
 	}
-	if yyb1045 {
+	if yyb1106 {
 		r.ReadEnd()
 		return
 	}
@@ -11694,49 +12315,49 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromArray(l int, d *codec1978.Decoder
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj1045++
-	if yyhl1045 {
-		yyb1045 = yyj1045 > l
+	yyj1106++
+	if yyhl1106 {
+		yyb1106 = yyj1106 > l
 	} else {
-		yyb1045 = r.CheckBreak()
+		yyb1106 = r.CheckBreak()
 	}
-	if yyb1045 {
+	if yyb1106 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ObjectMeta = pkg2_api.ObjectMeta{}
 	} else {
-		yyv1048 := &x.ObjectMeta
-		yyv1048.CodecDecodeSelf(d)
+		yyv1109 := &x.ObjectMeta
+		yyv1109.CodecDecodeSelf(d)
 	}
-	yyj1045++
-	if yyhl1045 {
-		yyb1045 = yyj1045 > l
+	yyj1106++
+	if yyhl1106 {
+		yyb1106 = yyj1106 > l
 	} else {
-		yyb1045 = r.CheckBreak()
+		yyb1106 = r.CheckBreak()
 	}
-	if yyb1045 {
+	if yyb1106 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Spec = ClusterAutoscalerSpec{}
 	} else {
-		yyv1049 := &x.Spec
-		yyv1049.CodecDecodeSelf(d)
+		yyv1110 := &x.Spec
+		yyv1110.CodecDecodeSelf(d)
 	}
 	for {
-		yyj1045++
-		if yyhl1045 {
-			yyb1045 = yyj1045 > l
+		yyj1106++
+		if yyhl1106 {
+			yyb1106 = yyj1106 > l
 		} else {
-			yyb1045 = r.CheckBreak()
+			yyb1106 = r.CheckBreak()
 		}
-		if yyb1045 {
+		if yyb1106 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1045-1, "")
+		z.DecStructFieldNotFound(yyj1106-1, "")
 	}
 	r.ReadEnd()
 }
@@ -11748,34 +12369,34 @@ func (x *ClusterAutoscalerList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1050 := z.EncBinary()
-		_ = yym1050
+		yym1111 := z.EncBinary()
+		_ = yym1111
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1051 := !z.EncBinary()
-			yy2arr1051 := z.EncBasicHandle().StructToArray
-			var yyq1051 [4]bool
-			_, _, _ = yysep1051, yyq1051, yy2arr1051
-			const yyr1051 bool = false
-			yyq1051[0] = x.Kind != ""
-			yyq1051[1] = x.APIVersion != ""
-			yyq1051[2] = true
-			if yyr1051 || yy2arr1051 {
+			yysep1112 := !z.EncBinary()
+			yy2arr1112 := z.EncBasicHandle().StructToArray
+			var yyq1112 [4]bool
+			_, _, _ = yysep1112, yyq1112, yy2arr1112
+			const yyr1112 bool = false
+			yyq1112[0] = x.Kind != ""
+			yyq1112[1] = x.APIVersion != ""
+			yyq1112[2] = true
+			if yyr1112 || yy2arr1112 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn1051 int = 1
-				for _, b := range yyq1051 {
+				var yynn1112 int = 1
+				for _, b := range yyq1112 {
 					if b {
-						yynn1051++
+						yynn1112++
 					}
 				}
-				r.EncodeMapStart(yynn1051)
+				r.EncodeMapStart(yynn1112)
 			}
-			if yyr1051 || yy2arr1051 {
-				if yyq1051[0] {
-					yym1053 := z.EncBinary()
-					_ = yym1053
+			if yyr1112 || yy2arr1112 {
+				if yyq1112[0] {
+					yym1114 := z.EncBinary()
+					_ = yym1114
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -11784,20 +12405,20 @@ func (x *ClusterAutoscalerList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq1051[0] {
+				if yyq1112[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym1054 := z.EncBinary()
-					_ = yym1054
+					yym1115 := z.EncBinary()
+					_ = yym1115
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr1051 || yy2arr1051 {
-				if yyq1051[1] {
-					yym1056 := z.EncBinary()
-					_ = yym1056
+			if yyr1112 || yy2arr1112 {
+				if yyq1112[1] {
+					yym1117 := z.EncBinary()
+					_ = yym1117
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -11806,48 +12427,48 @@ func (x *ClusterAutoscalerList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq1051[1] {
+				if yyq1112[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym1057 := z.EncBinary()
-					_ = yym1057
+					yym1118 := z.EncBinary()
+					_ = yym1118
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr1051 || yy2arr1051 {
-				if yyq1051[2] {
-					yy1059 := &x.ListMeta
-					yym1060 := z.EncBinary()
-					_ = yym1060
+			if yyr1112 || yy2arr1112 {
+				if yyq1112[2] {
+					yy1120 := &x.ListMeta
+					yym1121 := z.EncBinary()
+					_ = yym1121
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy1059) {
+					} else if z.HasExtensions() && z.EncExt(yy1120) {
 					} else {
-						z.EncFallback(yy1059)
+						z.EncFallback(yy1120)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1051[2] {
+				if yyq1112[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy1061 := &x.ListMeta
-					yym1062 := z.EncBinary()
-					_ = yym1062
+					yy1122 := &x.ListMeta
+					yym1123 := z.EncBinary()
+					_ = yym1123
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy1061) {
+					} else if z.HasExtensions() && z.EncExt(yy1122) {
 					} else {
-						z.EncFallback(yy1061)
+						z.EncFallback(yy1122)
 					}
 				}
 			}
-			if yyr1051 || yy2arr1051 {
+			if yyr1112 || yy2arr1112 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym1064 := z.EncBinary()
-					_ = yym1064
+					yym1125 := z.EncBinary()
+					_ = yym1125
 					if false {
 					} else {
 						h.encSliceClusterAutoscaler(([]ClusterAutoscaler)(x.Items), e)
@@ -11858,15 +12479,15 @@ func (x *ClusterAutoscalerList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym1065 := z.EncBinary()
-					_ = yym1065
+					yym1126 := z.EncBinary()
+					_ = yym1126
 					if false {
 					} else {
 						h.encSliceClusterAutoscaler(([]ClusterAutoscaler)(x.Items), e)
 					}
 				}
 			}
-			if yysep1051 {
+			if yysep1112 {
 				r.EncodeEnd()
 			}
 		}
@@ -11877,24 +12498,24 @@ func (x *ClusterAutoscalerList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1066 := z.DecBinary()
-	_ = yym1066
+	yym1127 := z.DecBinary()
+	_ = yym1127
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1067 := r.ReadMapStart()
-			if yyl1067 == 0 {
+			yyl1128 := r.ReadMapStart()
+			if yyl1128 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1067, d)
+				x.codecDecodeSelfFromMap(yyl1128, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1067 := r.ReadArrayStart()
-			if yyl1067 == 0 {
+			yyl1128 := r.ReadArrayStart()
+			if yyl1128 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1067, d)
+				x.codecDecodeSelfFromArray(yyl1128, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -11906,12 +12527,12 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1068Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1068Slc
-	var yyhl1068 bool = l >= 0
-	for yyj1068 := 0; ; yyj1068++ {
-		if yyhl1068 {
-			if yyj1068 >= l {
+	var yys1129Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1129Slc
+	var yyhl1129 bool = l >= 0
+	for yyj1129 := 0; ; yyj1129++ {
+		if yyhl1129 {
+			if yyj1129 >= l {
 				break
 			}
 		} else {
@@ -11919,9 +12540,9 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 				break
 			}
 		}
-		yys1068Slc = r.DecodeBytes(yys1068Slc, true, true)
-		yys1068 := string(yys1068Slc)
-		switch yys1068 {
+		yys1129Slc = r.DecodeBytes(yys1129Slc, true, true)
+		yys1129 := string(yys1129Slc)
+		switch yys1129 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -11938,32 +12559,32 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv1071 := &x.ListMeta
-				yym1072 := z.DecBinary()
-				_ = yym1072
+				yyv1132 := &x.ListMeta
+				yym1133 := z.DecBinary()
+				_ = yym1133
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv1071) {
+				} else if z.HasExtensions() && z.DecExt(yyv1132) {
 				} else {
-					z.DecFallback(yyv1071, false)
+					z.DecFallback(yyv1132, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv1073 := &x.Items
-				yym1074 := z.DecBinary()
-				_ = yym1074
+				yyv1134 := &x.Items
+				yym1135 := z.DecBinary()
+				_ = yym1135
 				if false {
 				} else {
-					h.decSliceClusterAutoscaler((*[]ClusterAutoscaler)(yyv1073), d)
+					h.decSliceClusterAutoscaler((*[]ClusterAutoscaler)(yyv1134), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1068)
-		} // end switch yys1068
-	} // end for yyj1068
-	if !yyhl1068 {
+			z.DecStructFieldNotFound(-1, yys1129)
+		} // end switch yys1129
+	} // end for yyj1129
+	if !yyhl1129 {
 		r.ReadEnd()
 	}
 }
@@ -11972,16 +12593,16 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1075 int
-	var yyb1075 bool
-	var yyhl1075 bool = l >= 0
-	yyj1075++
-	if yyhl1075 {
-		yyb1075 = yyj1075 > l
+	var yyj1136 int
+	var yyb1136 bool
+	var yyhl1136 bool = l >= 0
+	yyj1136++
+	if yyhl1136 {
+		yyb1136 = yyj1136 > l
 	} else {
-		yyb1075 = r.CheckBreak()
+		yyb1136 = r.CheckBreak()
 	}
-	if yyb1075 {
+	if yyb1136 {
 		r.ReadEnd()
 		return
 	}
@@ -11990,13 +12611,13 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj1075++
-	if yyhl1075 {
-		yyb1075 = yyj1075 > l
+	yyj1136++
+	if yyhl1136 {
+		yyb1136 = yyj1136 > l
 	} else {
-		yyb1075 = r.CheckBreak()
+		yyb1136 = r.CheckBreak()
 	}
-	if yyb1075 {
+	if yyb1136 {
 		r.ReadEnd()
 		return
 	}
@@ -12005,60 +12626,60 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj1075++
-	if yyhl1075 {
-		yyb1075 = yyj1075 > l
+	yyj1136++
+	if yyhl1136 {
+		yyb1136 = yyj1136 > l
 	} else {
-		yyb1075 = r.CheckBreak()
+		yyb1136 = r.CheckBreak()
 	}
-	if yyb1075 {
+	if yyb1136 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv1078 := &x.ListMeta
-		yym1079 := z.DecBinary()
-		_ = yym1079
+		yyv1139 := &x.ListMeta
+		yym1140 := z.DecBinary()
+		_ = yym1140
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv1078) {
+		} else if z.HasExtensions() && z.DecExt(yyv1139) {
 		} else {
-			z.DecFallback(yyv1078, false)
+			z.DecFallback(yyv1139, false)
 		}
 	}
-	yyj1075++
-	if yyhl1075 {
-		yyb1075 = yyj1075 > l
+	yyj1136++
+	if yyhl1136 {
+		yyb1136 = yyj1136 > l
 	} else {
-		yyb1075 = r.CheckBreak()
+		yyb1136 = r.CheckBreak()
 	}
-	if yyb1075 {
+	if yyb1136 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv1080 := &x.Items
-		yym1081 := z.DecBinary()
-		_ = yym1081
+		yyv1141 := &x.Items
+		yym1142 := z.DecBinary()
+		_ = yym1142
 		if false {
 		} else {
-			h.decSliceClusterAutoscaler((*[]ClusterAutoscaler)(yyv1080), d)
+			h.decSliceClusterAutoscaler((*[]ClusterAutoscaler)(yyv1141), d)
 		}
 	}
 	for {
-		yyj1075++
-		if yyhl1075 {
-			yyb1075 = yyj1075 > l
+		yyj1136++
+		if yyhl1136 {
+			yyb1136 = yyj1136 > l
 		} else {
-			yyb1075 = r.CheckBreak()
+			yyb1136 = r.CheckBreak()
 		}
-		if yyb1075 {
+		if yyb1136 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1075-1, "")
+		z.DecStructFieldNotFound(yyj1136-1, "")
 	}
 	r.ReadEnd()
 }
@@ -12070,36 +12691,36 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1082 := z.EncBinary()
-		_ = yym1082
+		yym1143 := z.EncBinary()
+		_ = yym1143
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1083 := !z.EncBinary()
-			yy2arr1083 := z.EncBasicHandle().StructToArray
-			var yyq1083 [2]bool
-			_, _, _ = yysep1083, yyq1083, yy2arr1083
-			const yyr1083 bool = false
-			yyq1083[0] = len(x.MatchLabels) != 0
-			yyq1083[1] = len(x.MatchExpressions) != 0
-			if yyr1083 || yy2arr1083 {
+			yysep1144 := !z.EncBinary()
+			yy2arr1144 := z.EncBasicHandle().StructToArray
+			var yyq1144 [2]bool
+			_, _, _ = yysep1144, yyq1144, yy2arr1144
+			const yyr1144 bool = false
+			yyq1144[0] = len(x.MatchLabels) != 0
+			yyq1144[1] = len(x.MatchExpressions) != 0
+			if yyr1144 || yy2arr1144 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn1083 int = 0
-				for _, b := range yyq1083 {
+				var yynn1144 int = 0
+				for _, b := range yyq1144 {
 					if b {
-						yynn1083++
+						yynn1144++
 					}
 				}
-				r.EncodeMapStart(yynn1083)
+				r.EncodeMapStart(yynn1144)
 			}
-			if yyr1083 || yy2arr1083 {
-				if yyq1083[0] {
+			if yyr1144 || yy2arr1144 {
+				if yyq1144[0] {
 					if x.MatchLabels == nil {
 						r.EncodeNil()
 					} else {
-						yym1085 := z.EncBinary()
-						_ = yym1085
+						yym1146 := z.EncBinary()
+						_ = yym1146
 						if false {
 						} else {
 							z.F.EncMapStringStringV(x.MatchLabels, false, e)
@@ -12109,13 +12730,13 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1083[0] {
+				if yyq1144[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("matchLabels"))
 					if x.MatchLabels == nil {
 						r.EncodeNil()
 					} else {
-						yym1086 := z.EncBinary()
-						_ = yym1086
+						yym1147 := z.EncBinary()
+						_ = yym1147
 						if false {
 						} else {
 							z.F.EncMapStringStringV(x.MatchLabels, false, e)
@@ -12123,13 +12744,13 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr1083 || yy2arr1083 {
-				if yyq1083[1] {
+			if yyr1144 || yy2arr1144 {
+				if yyq1144[1] {
 					if x.MatchExpressions == nil {
 						r.EncodeNil()
 					} else {
-						yym1088 := z.EncBinary()
-						_ = yym1088
+						yym1149 := z.EncBinary()
+						_ = yym1149
 						if false {
 						} else {
 							h.encSlicePodSelectorRequirement(([]PodSelectorRequirement)(x.MatchExpressions), e)
@@ -12139,13 +12760,13 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1083[1] {
+				if yyq1144[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("matchExpressions"))
 					if x.MatchExpressions == nil {
 						r.EncodeNil()
 					} else {
-						yym1089 := z.EncBinary()
-						_ = yym1089
+						yym1150 := z.EncBinary()
+						_ = yym1150
 						if false {
 						} else {
 							h.encSlicePodSelectorRequirement(([]PodSelectorRequirement)(x.MatchExpressions), e)
@@ -12153,7 +12774,7 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep1083 {
+			if yysep1144 {
 				r.EncodeEnd()
 			}
 		}
@@ -12164,24 +12785,24 @@ func (x *PodSelector) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1090 := z.DecBinary()
-	_ = yym1090
+	yym1151 := z.DecBinary()
+	_ = yym1151
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1091 := r.ReadMapStart()
-			if yyl1091 == 0 {
+			yyl1152 := r.ReadMapStart()
+			if yyl1152 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1091, d)
+				x.codecDecodeSelfFromMap(yyl1152, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1091 := r.ReadArrayStart()
-			if yyl1091 == 0 {
+			yyl1152 := r.ReadArrayStart()
+			if yyl1152 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1091, d)
+				x.codecDecodeSelfFromArray(yyl1152, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -12193,12 +12814,12 @@ func (x *PodSelector) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1092Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1092Slc
-	var yyhl1092 bool = l >= 0
-	for yyj1092 := 0; ; yyj1092++ {
-		if yyhl1092 {
-			if yyj1092 >= l {
+	var yys1153Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1153Slc
+	var yyhl1153 bool = l >= 0
+	for yyj1153 := 0; ; yyj1153++ {
+		if yyhl1153 {
+			if yyj1153 >= l {
 				break
 			}
 		} else {
@@ -12206,38 +12827,38 @@ func (x *PodSelector) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys1092Slc = r.DecodeBytes(yys1092Slc, true, true)
-		yys1092 := string(yys1092Slc)
-		switch yys1092 {
+		yys1153Slc = r.DecodeBytes(yys1153Slc, true, true)
+		yys1153 := string(yys1153Slc)
+		switch yys1153 {
 		case "matchLabels":
 			if r.TryDecodeAsNil() {
 				x.MatchLabels = nil
 			} else {
-				yyv1093 := &x.MatchLabels
-				yym1094 := z.DecBinary()
-				_ = yym1094
+				yyv1154 := &x.MatchLabels
+				yym1155 := z.DecBinary()
+				_ = yym1155
 				if false {
 				} else {
-					z.F.DecMapStringStringX(yyv1093, false, d)
+					z.F.DecMapStringStringX(yyv1154, false, d)
 				}
 			}
 		case "matchExpressions":
 			if r.TryDecodeAsNil() {
 				x.MatchExpressions = nil
 			} else {
-				yyv1095 := &x.MatchExpressions
-				yym1096 := z.DecBinary()
-				_ = yym1096
+				yyv1156 := &x.MatchExpressions
+				yym1157 := z.DecBinary()
+				_ = yym1157
 				if false {
 				} else {
-					h.decSlicePodSelectorRequirement((*[]PodSelectorRequirement)(yyv1095), d)
+					h.decSlicePodSelectorRequirement((*[]PodSelectorRequirement)(yyv1156), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1092)
-		} // end switch yys1092
-	} // end for yyj1092
-	if !yyhl1092 {
+			z.DecStructFieldNotFound(-1, yys1153)
+		} // end switch yys1153
+	} // end for yyj1153
+	if !yyhl1153 {
 		r.ReadEnd()
 	}
 }
@@ -12246,62 +12867,62 @@ func (x *PodSelector) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1097 int
-	var yyb1097 bool
-	var yyhl1097 bool = l >= 0
-	yyj1097++
-	if yyhl1097 {
-		yyb1097 = yyj1097 > l
+	var yyj1158 int
+	var yyb1158 bool
+	var yyhl1158 bool = l >= 0
+	yyj1158++
+	if yyhl1158 {
+		yyb1158 = yyj1158 > l
 	} else {
-		yyb1097 = r.CheckBreak()
+		yyb1158 = r.CheckBreak()
 	}
-	if yyb1097 {
+	if yyb1158 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.MatchLabels = nil
 	} else {
-		yyv1098 := &x.MatchLabels
-		yym1099 := z.DecBinary()
-		_ = yym1099
+		yyv1159 := &x.MatchLabels
+		yym1160 := z.DecBinary()
+		_ = yym1160
 		if false {
 		} else {
-			z.F.DecMapStringStringX(yyv1098, false, d)
+			z.F.DecMapStringStringX(yyv1159, false, d)
 		}
 	}
-	yyj1097++
-	if yyhl1097 {
-		yyb1097 = yyj1097 > l
+	yyj1158++
+	if yyhl1158 {
+		yyb1158 = yyj1158 > l
 	} else {
-		yyb1097 = r.CheckBreak()
+		yyb1158 = r.CheckBreak()
 	}
-	if yyb1097 {
+	if yyb1158 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.MatchExpressions = nil
 	} else {
-		yyv1100 := &x.MatchExpressions
-		yym1101 := z.DecBinary()
-		_ = yym1101
+		yyv1161 := &x.MatchExpressions
+		yym1162 := z.DecBinary()
+		_ = yym1162
 		if false {
 		} else {
-			h.decSlicePodSelectorRequirement((*[]PodSelectorRequirement)(yyv1100), d)
+			h.decSlicePodSelectorRequirement((*[]PodSelectorRequirement)(yyv1161), d)
 		}
 	}
 	for {
-		yyj1097++
-		if yyhl1097 {
-			yyb1097 = yyj1097 > l
+		yyj1158++
+		if yyhl1158 {
+			yyb1158 = yyj1158 > l
 		} else {
-			yyb1097 = r.CheckBreak()
+			yyb1158 = r.CheckBreak()
 		}
-		if yyb1097 {
+		if yyb1158 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1097-1, "")
+		z.DecStructFieldNotFound(yyj1158-1, "")
 	}
 	r.ReadEnd()
 }
@@ -12313,57 +12934,57 @@ func (x *PodSelectorRequirement) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1102 := z.EncBinary()
-		_ = yym1102
+		yym1163 := z.EncBinary()
+		_ = yym1163
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1103 := !z.EncBinary()
-			yy2arr1103 := z.EncBasicHandle().StructToArray
-			var yyq1103 [3]bool
-			_, _, _ = yysep1103, yyq1103, yy2arr1103
-			const yyr1103 bool = false
-			yyq1103[2] = len(x.Values) != 0
-			if yyr1103 || yy2arr1103 {
+			yysep1164 := !z.EncBinary()
+			yy2arr1164 := z.EncBasicHandle().StructToArray
+			var yyq1164 [3]bool
+			_, _, _ = yysep1164, yyq1164, yy2arr1164
+			const yyr1164 bool = false
+			yyq1164[2] = len(x.Values) != 0
+			if yyr1164 || yy2arr1164 {
 				r.EncodeArrayStart(3)
 			} else {
-				var yynn1103 int = 2
-				for _, b := range yyq1103 {
+				var yynn1164 int = 2
+				for _, b := range yyq1164 {
 					if b {
-						yynn1103++
+						yynn1164++
 					}
 				}
-				r.EncodeMapStart(yynn1103)
+				r.EncodeMapStart(yynn1164)
 			}
-			if yyr1103 || yy2arr1103 {
-				yym1105 := z.EncBinary()
-				_ = yym1105
+			if yyr1164 || yy2arr1164 {
+				yym1166 := z.EncBinary()
+				_ = yym1166
 				if false {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.Key))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("key"))
-				yym1106 := z.EncBinary()
-				_ = yym1106
+				yym1167 := z.EncBinary()
+				_ = yym1167
 				if false {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.Key))
 				}
 			}
-			if yyr1103 || yy2arr1103 {
+			if yyr1164 || yy2arr1164 {
 				x.Operator.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("operator"))
 				x.Operator.CodecEncodeSelf(e)
 			}
-			if yyr1103 || yy2arr1103 {
-				if yyq1103[2] {
+			if yyr1164 || yy2arr1164 {
+				if yyq1164[2] {
 					if x.Values == nil {
 						r.EncodeNil()
 					} else {
-						yym1109 := z.EncBinary()
-						_ = yym1109
+						yym1170 := z.EncBinary()
+						_ = yym1170
 						if false {
 						} else {
 							z.F.EncSliceStringV(x.Values, false, e)
@@ -12373,13 +12994,13 @@ func (x *PodSelectorRequirement) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1103[2] {
+				if yyq1164[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("values"))
 					if x.Values == nil {
 						r.EncodeNil()
 					} else {
-						yym1110 := z.EncBinary()
-						_ = yym1110
+						yym1171 := z.EncBinary()
+						_ = yym1171
 						if false {
 						} else {
 							z.F.EncSliceStringV(x.Values, false, e)
@@ -12387,7 +13008,7 @@ func (x *PodSelectorRequirement) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep1103 {
+			if yysep1164 {
 				r.EncodeEnd()
 			}
 		}
@@ -12398,24 +13019,24 @@ func (x *PodSelectorRequirement) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1111 := z.DecBinary()
-	_ = yym1111
+	yym1172 := z.DecBinary()
+	_ = yym1172
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1112 := r.ReadMapStart()
-			if yyl1112 == 0 {
+			yyl1173 := r.ReadMapStart()
+			if yyl1173 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1112, d)
+				x.codecDecodeSelfFromMap(yyl1173, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1112 := r.ReadArrayStart()
-			if yyl1112 == 0 {
+			yyl1173 := r.ReadArrayStart()
+			if yyl1173 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1112, d)
+				x.codecDecodeSelfFromArray(yyl1173, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -12427,12 +13048,12 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromMap(l int, d *codec1978.Deco
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1113Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1113Slc
-	var yyhl1113 bool = l >= 0
-	for yyj1113 := 0; ; yyj1113++ {
-		if yyhl1113 {
-			if yyj1113 >= l {
+	var yys1174Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1174Slc
+	var yyhl1174 bool = l >= 0
+	for yyj1174 := 0; ; yyj1174++ {
+		if yyhl1174 {
+			if yyj1174 >= l {
 				break
 			}
 		} else {
@@ -12440,9 +13061,9 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromMap(l int, d *codec1978.Deco
 				break
 			}
 		}
-		yys1113Slc = r.DecodeBytes(yys1113Slc, true, true)
-		yys1113 := string(yys1113Slc)
-		switch yys1113 {
+		yys1174Slc = r.DecodeBytes(yys1174Slc, true, true)
+		yys1174 := string(yys1174Slc)
+		switch yys1174 {
 		case "key":
 			if r.TryDecodeAsNil() {
 				x.Key = ""
@@ -12459,19 +13080,19 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromMap(l int, d *codec1978.Deco
 			if r.TryDecodeAsNil() {
 				x.Values = nil
 			} else {
-				yyv1116 := &x.Values
-				yym1117 := z.DecBinary()
-				_ = yym1117
+				yyv1177 := &x.Values
+				yym1178 := z.DecBinary()
+				_ = yym1178
 				if false {
 				} else {
-					z.F.DecSliceStringX(yyv1116, false, d)
+					z.F.DecSliceStringX(yyv1177, false, d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1113)
-		} // end switch yys1113
-	} // end for yyj1113
-	if !yyhl1113 {
+			z.DecStructFieldNotFound(-1, yys1174)
+		} // end switch yys1174
+	} // end for yyj1174
+	if !yyhl1174 {
 		r.ReadEnd()
 	}
 }
@@ -12480,16 +13101,16 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromArray(l int, d *codec1978.De
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1118 int
-	var yyb1118 bool
-	var yyhl1118 bool = l >= 0
-	yyj1118++
-	if yyhl1118 {
-		yyb1118 = yyj1118 > l
+	var yyj1179 int
+	var yyb1179 bool
+	var yyhl1179 bool = l >= 0
+	yyj1179++
+	if yyhl1179 {
+		yyb1179 = yyj1179 > l
 	} else {
-		yyb1118 = r.CheckBreak()
+		yyb1179 = r.CheckBreak()
 	}
-	if yyb1118 {
+	if yyb1179 {
 		r.ReadEnd()
 		return
 	}
@@ -12498,13 +13119,13 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromArray(l int, d *codec1978.De
 	} else {
 		x.Key = string(r.DecodeString())
 	}
-	yyj1118++
-	if yyhl1118 {
-		yyb1118 = yyj1118 > l
+	yyj1179++
+	if yyhl1179 {
+		yyb1179 = yyj1179 > l
 	} else {
-		yyb1118 = r.CheckBreak()
+		yyb1179 = r.CheckBreak()
 	}
-	if yyb1118 {
+	if yyb1179 {
 		r.ReadEnd()
 		return
 	}
@@ -12513,38 +13134,38 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromArray(l int, d *codec1978.De
 	} else {
 		x.Operator = PodSelectorOperator(r.DecodeString())
 	}
-	yyj1118++
-	if yyhl1118 {
-		yyb1118 = yyj1118 > l
+	yyj1179++
+	if yyhl1179 {
+		yyb1179 = yyj1179 > l
 	} else {
-		yyb1118 = r.CheckBreak()
+		yyb1179 = r.CheckBreak()
 	}
-	if yyb1118 {
+	if yyb1179 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Values = nil
 	} else {
-		yyv1121 := &x.Values
-		yym1122 := z.DecBinary()
-		_ = yym1122
+		yyv1182 := &x.Values
+		yym1183 := z.DecBinary()
+		_ = yym1183
 		if false {
 		} else {
-			z.F.DecSliceStringX(yyv1121, false, d)
+			z.F.DecSliceStringX(yyv1182, false, d)
 		}
 	}
 	for {
-		yyj1118++
-		if yyhl1118 {
-			yyb1118 = yyj1118 > l
+		yyj1179++
+		if yyhl1179 {
+			yyb1179 = yyj1179 > l
 		} else {
-			yyb1118 = r.CheckBreak()
+			yyb1179 = r.CheckBreak()
 		}
-		if yyb1118 {
+		if yyb1179 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1118-1, "")
+		z.DecStructFieldNotFound(yyj1179-1, "")
 	}
 	r.ReadEnd()
 }
@@ -12553,8 +13174,8 @@ func (x PodSelectorOperator) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	yym1123 := z.EncBinary()
-	_ = yym1123
+	yym1184 := z.EncBinary()
+	_ = yym1184
 	if false {
 	} else if z.HasExtensions() && z.EncExt(x) {
 	} else {
@@ -12566,8 +13187,8 @@ func (x *PodSelectorOperator) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1124 := z.DecBinary()
-	_ = yym1124
+	yym1185 := z.DecBinary()
+	_ = yym1185
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
@@ -12580,9 +13201,9 @@ func (x codecSelfer1234) encSliceHorizontalPodAutoscaler(v []HorizontalPodAutosc
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1125 := range v {
-		yy1126 := &yyv1125
-		yy1126.CodecEncodeSelf(e)
+	for _, yyv1186 := range v {
+		yy1187 := &yyv1186
+		yy1187.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12592,75 +13213,75 @@ func (x codecSelfer1234) decSliceHorizontalPodAutoscaler(v *[]HorizontalPodAutos
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1127 := *v
-	yyh1127, yyl1127 := z.DecSliceHelperStart()
+	yyv1188 := *v
+	yyh1188, yyl1188 := z.DecSliceHelperStart()
 
-	var yyrr1127, yyrl1127 int
-	var yyc1127, yyrt1127 bool
-	_, _, _ = yyc1127, yyrt1127, yyrl1127
-	yyrr1127 = yyl1127
+	var yyrr1188, yyrl1188 int
+	var yyc1188, yyrt1188 bool
+	_, _, _ = yyc1188, yyrt1188, yyrl1188
+	yyrr1188 = yyl1188
 
-	if yyv1127 == nil {
-		if yyrl1127, yyrt1127 = z.DecInferLen(yyl1127, z.DecBasicHandle().MaxInitLen, 320); yyrt1127 {
-			yyrr1127 = yyrl1127
+	if yyv1188 == nil {
+		if yyrl1188, yyrt1188 = z.DecInferLen(yyl1188, z.DecBasicHandle().MaxInitLen, 320); yyrt1188 {
+			yyrr1188 = yyrl1188
 		}
-		yyv1127 = make([]HorizontalPodAutoscaler, yyrl1127)
-		yyc1127 = true
+		yyv1188 = make([]HorizontalPodAutoscaler, yyrl1188)
+		yyc1188 = true
 	}
 
-	if yyl1127 == 0 {
-		if len(yyv1127) != 0 {
-			yyv1127 = yyv1127[:0]
-			yyc1127 = true
+	if yyl1188 == 0 {
+		if len(yyv1188) != 0 {
+			yyv1188 = yyv1188[:0]
+			yyc1188 = true
 		}
-	} else if yyl1127 > 0 {
+	} else if yyl1188 > 0 {
 
-		if yyl1127 > cap(yyv1127) {
-			yyrl1127, yyrt1127 = z.DecInferLen(yyl1127, z.DecBasicHandle().MaxInitLen, 320)
-			yyv1127 = make([]HorizontalPodAutoscaler, yyrl1127)
-			yyc1127 = true
+		if yyl1188 > cap(yyv1188) {
+			yyrl1188, yyrt1188 = z.DecInferLen(yyl1188, z.DecBasicHandle().MaxInitLen, 320)
+			yyv1188 = make([]HorizontalPodAutoscaler, yyrl1188)
+			yyc1188 = true
 
-			yyrr1127 = len(yyv1127)
-		} else if yyl1127 != len(yyv1127) {
-			yyv1127 = yyv1127[:yyl1127]
-			yyc1127 = true
+			yyrr1188 = len(yyv1188)
+		} else if yyl1188 != len(yyv1188) {
+			yyv1188 = yyv1188[:yyl1188]
+			yyc1188 = true
 		}
-		yyj1127 := 0
-		for ; yyj1127 < yyrr1127; yyj1127++ {
+		yyj1188 := 0
+		for ; yyj1188 < yyrr1188; yyj1188++ {
 			if r.TryDecodeAsNil() {
-				yyv1127[yyj1127] = HorizontalPodAutoscaler{}
+				yyv1188[yyj1188] = HorizontalPodAutoscaler{}
 			} else {
-				yyv1128 := &yyv1127[yyj1127]
-				yyv1128.CodecDecodeSelf(d)
+				yyv1189 := &yyv1188[yyj1188]
+				yyv1189.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1127 {
-			for ; yyj1127 < yyl1127; yyj1127++ {
-				yyv1127 = append(yyv1127, HorizontalPodAutoscaler{})
+		if yyrt1188 {
+			for ; yyj1188 < yyl1188; yyj1188++ {
+				yyv1188 = append(yyv1188, HorizontalPodAutoscaler{})
 				if r.TryDecodeAsNil() {
-					yyv1127[yyj1127] = HorizontalPodAutoscaler{}
+					yyv1188[yyj1188] = HorizontalPodAutoscaler{}
 				} else {
-					yyv1129 := &yyv1127[yyj1127]
-					yyv1129.CodecDecodeSelf(d)
+					yyv1190 := &yyv1188[yyj1188]
+					yyv1190.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1127 := 0; !r.CheckBreak(); yyj1127++ {
-			if yyj1127 >= len(yyv1127) {
-				yyv1127 = append(yyv1127, HorizontalPodAutoscaler{}) // var yyz1127 HorizontalPodAutoscaler
-				yyc1127 = true
+		for yyj1188 := 0; !r.CheckBreak(); yyj1188++ {
+			if yyj1188 >= len(yyv1188) {
+				yyv1188 = append(yyv1188, HorizontalPodAutoscaler{}) // var yyz1188 HorizontalPodAutoscaler
+				yyc1188 = true
 			}
 
-			if yyj1127 < len(yyv1127) {
+			if yyj1188 < len(yyv1188) {
 				if r.TryDecodeAsNil() {
-					yyv1127[yyj1127] = HorizontalPodAutoscaler{}
+					yyv1188[yyj1188] = HorizontalPodAutoscaler{}
 				} else {
-					yyv1130 := &yyv1127[yyj1127]
-					yyv1130.CodecDecodeSelf(d)
+					yyv1191 := &yyv1188[yyj1188]
+					yyv1191.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -12668,10 +13289,10 @@ func (x codecSelfer1234) decSliceHorizontalPodAutoscaler(v *[]HorizontalPodAutos
 			}
 
 		}
-		yyh1127.End()
+		yyh1188.End()
 	}
-	if yyc1127 {
-		*v = yyv1127
+	if yyc1188 {
+		*v = yyv1188
 	}
 
 }
@@ -12681,9 +13302,9 @@ func (x codecSelfer1234) encSliceAPIVersion(v []APIVersion, e *codec1978.Encoder
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1131 := range v {
-		yy1132 := &yyv1131
-		yy1132.CodecEncodeSelf(e)
+	for _, yyv1192 := range v {
+		yy1193 := &yyv1192
+		yy1193.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12693,75 +13314,75 @@ func (x codecSelfer1234) decSliceAPIVersion(v *[]APIVersion, d *codec1978.Decode
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1133 := *v
-	yyh1133, yyl1133 := z.DecSliceHelperStart()
+	yyv1194 := *v
+	yyh1194, yyl1194 := z.DecSliceHelperStart()
 
-	var yyrr1133, yyrl1133 int
-	var yyc1133, yyrt1133 bool
-	_, _, _ = yyc1133, yyrt1133, yyrl1133
-	yyrr1133 = yyl1133
+	var yyrr1194, yyrl1194 int
+	var yyc1194, yyrt1194 bool
+	_, _, _ = yyc1194, yyrt1194, yyrl1194
+	yyrr1194 = yyl1194
 
-	if yyv1133 == nil {
-		if yyrl1133, yyrt1133 = z.DecInferLen(yyl1133, z.DecBasicHandle().MaxInitLen, 32); yyrt1133 {
-			yyrr1133 = yyrl1133
+	if yyv1194 == nil {
+		if yyrl1194, yyrt1194 = z.DecInferLen(yyl1194, z.DecBasicHandle().MaxInitLen, 32); yyrt1194 {
+			yyrr1194 = yyrl1194
 		}
-		yyv1133 = make([]APIVersion, yyrl1133)
-		yyc1133 = true
+		yyv1194 = make([]APIVersion, yyrl1194)
+		yyc1194 = true
 	}
 
-	if yyl1133 == 0 {
-		if len(yyv1133) != 0 {
-			yyv1133 = yyv1133[:0]
-			yyc1133 = true
+	if yyl1194 == 0 {
+		if len(yyv1194) != 0 {
+			yyv1194 = yyv1194[:0]
+			yyc1194 = true
 		}
-	} else if yyl1133 > 0 {
+	} else if yyl1194 > 0 {
 
-		if yyl1133 > cap(yyv1133) {
-			yyrl1133, yyrt1133 = z.DecInferLen(yyl1133, z.DecBasicHandle().MaxInitLen, 32)
-			yyv1133 = make([]APIVersion, yyrl1133)
-			yyc1133 = true
+		if yyl1194 > cap(yyv1194) {
+			yyrl1194, yyrt1194 = z.DecInferLen(yyl1194, z.DecBasicHandle().MaxInitLen, 32)
+			yyv1194 = make([]APIVersion, yyrl1194)
+			yyc1194 = true
 
-			yyrr1133 = len(yyv1133)
-		} else if yyl1133 != len(yyv1133) {
-			yyv1133 = yyv1133[:yyl1133]
-			yyc1133 = true
+			yyrr1194 = len(yyv1194)
+		} else if yyl1194 != len(yyv1194) {
+			yyv1194 = yyv1194[:yyl1194]
+			yyc1194 = true
 		}
-		yyj1133 := 0
-		for ; yyj1133 < yyrr1133; yyj1133++ {
+		yyj1194 := 0
+		for ; yyj1194 < yyrr1194; yyj1194++ {
 			if r.TryDecodeAsNil() {
-				yyv1133[yyj1133] = APIVersion{}
+				yyv1194[yyj1194] = APIVersion{}
 			} else {
-				yyv1134 := &yyv1133[yyj1133]
-				yyv1134.CodecDecodeSelf(d)
+				yyv1195 := &yyv1194[yyj1194]
+				yyv1195.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1133 {
-			for ; yyj1133 < yyl1133; yyj1133++ {
-				yyv1133 = append(yyv1133, APIVersion{})
+		if yyrt1194 {
+			for ; yyj1194 < yyl1194; yyj1194++ {
+				yyv1194 = append(yyv1194, APIVersion{})
 				if r.TryDecodeAsNil() {
-					yyv1133[yyj1133] = APIVersion{}
+					yyv1194[yyj1194] = APIVersion{}
 				} else {
-					yyv1135 := &yyv1133[yyj1133]
-					yyv1135.CodecDecodeSelf(d)
+					yyv1196 := &yyv1194[yyj1194]
+					yyv1196.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1133 := 0; !r.CheckBreak(); yyj1133++ {
-			if yyj1133 >= len(yyv1133) {
-				yyv1133 = append(yyv1133, APIVersion{}) // var yyz1133 APIVersion
-				yyc1133 = true
+		for yyj1194 := 0; !r.CheckBreak(); yyj1194++ {
+			if yyj1194 >= len(yyv1194) {
+				yyv1194 = append(yyv1194, APIVersion{}) // var yyz1194 APIVersion
+				yyc1194 = true
 			}
 
-			if yyj1133 < len(yyv1133) {
+			if yyj1194 < len(yyv1194) {
 				if r.TryDecodeAsNil() {
-					yyv1133[yyj1133] = APIVersion{}
+					yyv1194[yyj1194] = APIVersion{}
 				} else {
-					yyv1136 := &yyv1133[yyj1133]
-					yyv1136.CodecDecodeSelf(d)
+					yyv1197 := &yyv1194[yyj1194]
+					yyv1197.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -12769,10 +13390,10 @@ func (x codecSelfer1234) decSliceAPIVersion(v *[]APIVersion, d *codec1978.Decode
 			}
 
 		}
-		yyh1133.End()
+		yyh1194.End()
 	}
-	if yyc1133 {
-		*v = yyv1133
+	if yyc1194 {
+		*v = yyv1194
 	}
 
 }
@@ -12782,9 +13403,9 @@ func (x codecSelfer1234) encSliceThirdPartyResource(v []ThirdPartyResource, e *c
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1137 := range v {
-		yy1138 := &yyv1137
-		yy1138.CodecEncodeSelf(e)
+	for _, yyv1198 := range v {
+		yy1199 := &yyv1198
+		yy1199.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12794,75 +13415,176 @@ func (x codecSelfer1234) decSliceThirdPartyResource(v *[]ThirdPartyResource, d *
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1139 := *v
-	yyh1139, yyl1139 := z.DecSliceHelperStart()
+	yyv1200 := *v
+	yyh1200, yyl1200 := z.DecSliceHelperStart()
+
+	var yyrr1200, yyrl1200 int
+	var yyc1200, yyrt1200 bool
+	_, _, _ = yyc1200, yyrt1200, yyrl1200
+	yyrr1200 = yyl1200
+
+	if yyv1200 == nil {
+		if yyrl1200, yyrt1200 = z.DecInferLen(yyl1200, z.DecBasicHandle().MaxInitLen, 232); yyrt1200 {
+			yyrr1200 = yyrl1200
+		}
+		yyv1200 = make([]ThirdPartyResource, yyrl1200)
+		yyc1200 = true
+	}
+
+	if yyl1200 == 0 {
+		if len(yyv1200) != 0 {
+			yyv1200 = yyv1200[:0]
+			yyc1200 = true
+		}
+	} else if yyl1200 > 0 {
+
+		if yyl1200 > cap(yyv1200) {
+			yyrl1200, yyrt1200 = z.DecInferLen(yyl1200, z.DecBasicHandle().MaxInitLen, 232)
+			yyv1200 = make([]ThirdPartyResource, yyrl1200)
+			yyc1200 = true
+
+			yyrr1200 = len(yyv1200)
+		} else if yyl1200 != len(yyv1200) {
+			yyv1200 = yyv1200[:yyl1200]
+			yyc1200 = true
+		}
+		yyj1200 := 0
+		for ; yyj1200 < yyrr1200; yyj1200++ {
+			if r.TryDecodeAsNil() {
+				yyv1200[yyj1200] = ThirdPartyResource{}
+			} else {
+				yyv1201 := &yyv1200[yyj1200]
+				yyv1201.CodecDecodeSelf(d)
+			}
+
+		}
+		if yyrt1200 {
+			for ; yyj1200 < yyl1200; yyj1200++ {
+				yyv1200 = append(yyv1200, ThirdPartyResource{})
+				if r.TryDecodeAsNil() {
+					yyv1200[yyj1200] = ThirdPartyResource{}
+				} else {
+					yyv1202 := &yyv1200[yyj1200]
+					yyv1202.CodecDecodeSelf(d)
+				}
+
+			}
+		}
+
+	} else {
+		for yyj1200 := 0; !r.CheckBreak(); yyj1200++ {
+			if yyj1200 >= len(yyv1200) {
+				yyv1200 = append(yyv1200, ThirdPartyResource{}) // var yyz1200 ThirdPartyResource
+				yyc1200 = true
+			}
+
+			if yyj1200 < len(yyv1200) {
+				if r.TryDecodeAsNil() {
+					yyv1200[yyj1200] = ThirdPartyResource{}
+				} else {
+					yyv1203 := &yyv1200[yyj1200]
+					yyv1203.CodecDecodeSelf(d)
+				}
+
+			} else {
+				z.DecSwallow()
+			}
+
+		}
+		yyh1200.End()
+	}
+	if yyc1200 {
+		*v = yyv1200
+	}
+
+}
+
+func (x codecSelfer1234) encSliceDeploymentCondition(v []DeploymentCondition, e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeArrayStart(len(v))
+	for _, yyv1204 := range v {
+		yy1205 := &yyv1204
+		yy1205.CodecEncodeSelf(e)
+	}
+	r.EncodeEnd()
+}
+
+func (x codecSelfer1234) decSliceDeploymentCondition(v *[]DeploymentCondition, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1206 := *v
+	yyh1206, yyl1206 := z.DecSliceHelperStart()
 
-	var yyrr1139, yyrl1139 int
-	var yyc1139, yyrt1139 bool
-	_, _, _ = yyc1139, yyrt1139, yyrl1139
-	yyrr1139 = yyl1139
+	var yyrr1206, yyrl1206 int
+	var yyc1206, yyrt1206 bool
+	_, _, _ = yyc1206, yyrt1206, yyrl1206
+	yyrr1206 = yyl1206
 
-	if yyv1139 == nil {
-		if yyrl1139, yyrt1139 = z.DecInferLen(yyl1139, z.DecBasicHandle().MaxInitLen, 232); yyrt1139 {
-			yyrr1139 = yyrl1139
+	if yyv1206 == nil {
+		if yyrl1206, yyrt1206 = z.DecInferLen(yyl1206, z.DecBasicHandle().MaxInitLen, 112); yyrt1206 {
+			yyrr1206 = yyrl1206
 		}
-		yyv1139 = make([]ThirdPartyResource, yyrl1139)
-		yyc1139 = true
+		yyv1206 = make([]DeploymentCondition, yyrl1206)
+		yyc1206 = true
 	}
 
-	if yyl1139 == 0 {
-		if len(yyv1139) != 0 {
-			yyv1139 = yyv1139[:0]
-			yyc1139 = true
+	if yyl1206 == 0 {
+		if len(yyv1206) != 0 {
+			yyv1206 = yyv1206[:0]
+			yyc1206 = true
 		}
-	} else if yyl1139 > 0 {
+	} else if yyl1206 > 0 {
 
-		if yyl1139 > cap(yyv1139) {
-			yyrl1139, yyrt1139 = z.DecInferLen(yyl1139, z.DecBasicHandle().MaxInitLen, 232)
-			yyv1139 = make([]ThirdPartyResource, yyrl1139)
-			yyc1139 = true
+		if yyl1206 > cap(yyv1206) {
+			yyrl1206, yyrt1206 = z.DecInferLen(yyl1206, z.DecBasicHandle().MaxInitLen, 112)
+			yyv1206 = make([]DeploymentCondition, yyrl1206)
+			yyc1206 = true
 
-			yyrr1139 = len(yyv1139)
-		} else if yyl1139 != len(yyv1139) {
-			yyv1139 = yyv1139[:yyl1139]
-			yyc1139 = true
+			yyrr1206 = len(yyv1206)
+		} else if yyl1206 != len(yyv1206) {
+			yyv1206 = yyv1206[:yyl1206]
+			yyc1206 = true
 		}
-		yyj1139 := 0
-		for ; yyj1139 < yyrr1139; yyj1139++ {
+		yyj1206 := 0
+		for ; yyj1206 < yyrr1206; yyj1206++ {
 			if r.TryDecodeAsNil() {
-				yyv1139[yyj1139] = ThirdPartyResource{}
+				yyv1206[yyj1206] = DeploymentCondition{}
 			} else {
-				yyv1140 := &yyv1139[yyj1139]
-				yyv1140.CodecDecodeSelf(d)
+				yyv1207 := &yyv1206[yyj1206]
+				yyv1207.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1139 {
-			for ; yyj1139 < yyl1139; yyj1139++ {
-				yyv1139 = append(yyv1139, ThirdPartyResource{})
+		if yyrt1206 {
+			for ; yyj1206 < yyl1206; yyj1206++ {
+				yyv1206 = append(yyv1206, DeploymentCondition{})
 				if r.TryDecodeAsNil() {
-					yyv1139[yyj1139] = ThirdPartyResource{}
+					yyv1206[yyj1206] = DeploymentCondition{}
 				} else {
-					yyv1141 := &yyv1139[yyj1139]
-					yyv1141.CodecDecodeSelf(d)
+					yyv1208 := &yyv1206[yyj1206]
+					yyv1208.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1139 := 0; !r.CheckBreak(); yyj1139++ {
-			if yyj1139 >= len(yyv1139) {
-				yyv1139 = append(yyv1139, ThirdPartyResource{}) // var yyz1139 ThirdPartyResource
-				yyc1139 = true
+		for yyj1206 := 0; !r.CheckBreak(); yyj1206++ {
+			if yyj1206 >= len(yyv1206) {
+				yyv1206 = append(yyv1206, DeploymentCondition{}) // var yyz1206 DeploymentCondition
+				yyc1206 = true
 			}
 
-			if yyj1139 < len(yyv1139) {
+			if yyj1206 < len(yyv1206) {
 				if r.TryDecodeAsNil() {
-					yyv1139[yyj1139] = ThirdPartyResource{}
+					yyv1206[yyj1206] = DeploymentCondition{}
 				} else {
-					yyv1142 := &yyv1139[yyj1139]
-					yyv1142.CodecDecodeSelf(d)
+					yyv1209 := &yyv1206[yyj1206]
+					yyv1209.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -12870,10 +13592,10 @@ func (x codecSelfer1234) decSliceThirdPartyResource(v *[]ThirdPartyResource, d *
 			}
 
 		}
-		yyh1139.End()
+		yyh1206.End()
 	}
-	if yyc1139 {
-		*v = yyv1139
+	if yyc1206 {
+		*v = yyv1206
 	}
 
 }
@@ -12883,9 +13605,9 @@ func (x codecSelfer1234) encSliceDeployment(v []Deployment, e *codec1978.Encoder
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1143 := range v {
-		yy1144 := &yyv1143
-		yy1144.CodecEncodeSelf(e)
+	for _, yyv1210 := range v {
+		yy1211 := &yyv1210
+		yy1211.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12895,75 +13617,75 @@ func (x codecSelfer1234) decSliceDeployment(v *[]Deployment, d *codec1978.Decode
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1145 := *v
-	yyh1145, yyl1145 := z.DecSliceHelperStart()
+	yyv1212 := *v
+	yyh1212, yyl1212 := z.DecSliceHelperStart()
 
-	var yyrr1145, yyrl1145 int
-	var yyc1145, yyrt1145 bool
-	_, _, _ = yyc1145, yyrt1145, yyrl1145
-	yyrr1145 = yyl1145
+	var yyrr1212, yyrl1212 int
+	var yyc1212, yyrt1212 bool
+	_, _, _ = yyc1212, yyrt1212, yyrl1212
+	yyrr1212 = yyl1212
 
-	if yyv1145 == nil {
-		if yyrl1145, yyrt1145 = z.DecInferLen(yyl1145, z.DecBasicHandle().MaxInitLen, 592); yyrt1145 {
-			yyrr1145 = yyrl1145
+	if yyv1212 == nil {
+		if yyrl1212, yyrt1212 = z.DecInferLen(yyl1212, z.DecBasicHandle().MaxInitLen, 632); yyrt1212 {
+			yyrr1212 = yyrl1212
 		}
-		yyv1145 = make([]Deployment, yyrl1145)
-		yyc1145 = true
+		yyv1212 = make([]Deployment, yyrl1212)
+		yyc1212 = true
 	}
 
-	if yyl1145 == 0 {
-		if len(yyv1145) != 0 {
-			yyv1145 = yyv1145[:0]
-			yyc1145 = true
+	if yyl1212 == 0 {
+		if len(yyv1212) != 0 {
+			yyv1212 = yyv1212[:0]
+			yyc1212 = true
 		}
-	} else if yyl1145 > 0 {
+	} else if yyl1212 > 0 {
 
-		if yyl1145 > cap(yyv1145) {
-			yyrl1145, yyrt1145 = z.DecInferLen(yyl1145, z.DecBasicHandle().MaxInitLen, 592)
-			yyv1145 = make([]Deployment, yyrl1145)
-			yyc1145 = true
+		if yyl1212 > cap(yyv1212) {
+			yyrl1212, yyrt1212 = z.DecInferLen(yyl1212, z.DecBasicHandle().MaxInitLen, 632)
+			yyv1212 = make([]Deployment, yyrl1212)
+			yyc1212 = true
 
-			yyrr1145 = len(yyv1145)
-		} else if yyl1145 != len(yyv1145) {
-			yyv1145 = yyv1145[:yyl1145]
-			yyc1145 = true
+			yyrr1212 = len(yyv1212)
+		} else if yyl1212 != len(yyv1212) {
+			yyv1212 = yyv1212[:yyl1212]
+			yyc1212 = true
 		}
-		yyj1145 := 0
-		for ; yyj1145 < yyrr1145; yyj1145++ {
+		yyj1212 := 0
+		for ; yyj1212 < yyrr1212; yyj1212++ {
 			if r.TryDecodeAsNil() {
-				yyv1145[yyj1145] = Deployment{}
+				yyv1212[yyj1212] = Deployment{}
 			} else {
-				yyv1146 := &yyv1145[yyj1145]
-				yyv1146.CodecDecodeSelf(d)
+				yyv1213 := &yyv1212[yyj1212]
+				yyv1213.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1145 {
-			for ; yyj1145 < yyl1145; yyj1145++ {
-				yyv1145 = append(yyv1145, Deployment{})
+		if yyrt1212 {
+			for ; yyj1212 < yyl1212; yyj1212++ {
+				yyv1212 = append(yyv1212, Deployment{})
 				if r.TryDecodeAsNil() {
-					yyv1145[yyj1145] = Deployment{}
+					yyv1212[yyj1212] = Deployment{}
 				} else {
-					yyv1147 := &yyv1145[yyj1145]
-					yyv1147.CodecDecodeSelf(d)
+					yyv1214 := &yyv1212[yyj1212]
+					yyv1214.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1145 := 0; !r.CheckBreak(); yyj1145++ {
-			if yyj1145 >= len(yyv1145) {
-				yyv1145 = append(yyv1145, Deployment{}) // var yyz1145 Deployment
-				yyc1145 = true
+		for yyj1212 := 0; !r.CheckBreak(); yyj1212++ {
+			if yyj1212 >= len(yyv1212) {
+				yyv1212 = append(yyv1212, Deployment{}) // var yyz1212 Deployment
+				yyc1212 = true
 			}
 
-			if yyj1145 < len(yyv1145) {
+			if yyj1212 < len(yyv1212) {
 				if r.TryDecodeAsNil() {
-					yyv1145[yyj1145] = Deployment{}
+					yyv1212[yyj1212] = Deployment{}
 				} else {
-					yyv1148 := &yyv1145[yyj1145]
-					yyv1148.CodecDecodeSelf(d)
+					yyv1215 := &yyv1212[yyj1212]
+					yyv1215.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -12971,10 +13693,10 @@ func (x codecSelfer1234) decSliceDeployment(v *[]Deployment, d *codec1978.Decode
 			}
 
 		}
-		yyh1145.End()
+		yyh1212.End()
 	}
-	if yyc1145 {
-		*v = yyv1145
+	if yyc1212 {
+		*v = yyv1212
 	}
 
 }
@@ -12984,9 +13706,9 @@ func (x codecSelfer1234) encSliceDaemonSet(v []DaemonSet, e *codec1978.Encoder)
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1149 := range v {
-		yy1150 := &yyv1149
-		yy1150.CodecEncodeSelf(e)
+	for _, yyv1216 := range v {
+		yy1217 := &yyv1216
+		yy1217.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12996,75 +13718,75 @@ func (x codecSelfer1234) decSliceDaemonSet(v *[]DaemonSet, d *codec1978.Decoder)
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1151 := *v
-	yyh1151, yyl1151 := z.DecSliceHelperStart()
+	yyv1218 := *v
+	yyh1218, yyl1218 := z.DecSliceHelperStart()
 
-	var yyrr1151, yyrl1151 int
-	var yyc1151, yyrt1151 bool
-	_, _, _ = yyc1151, yyrt1151, yyrl1151
-	yyrr1151 = yyl1151
+	var yyrr1218, yyrl1218 int
+	var yyc1218, yyrt1218 bool
+	_, _, _ = yyc1218, yyrt1218, yyrl1218
+	yyrr1218 = yyl1218
 
-	if yyv1151 == nil {
-		if yyrl1151, yyrt1151 = z.DecInferLen(yyl1151, z.DecBasicHandle().MaxInitLen, 232); yyrt1151 {
-			yyrr1151 = yyrl1151
+	if yyv1218 == nil {
+		if yyrl1218, yyrt1218 = z.DecInferLen(yyl1218, z.DecBasicHandle().MaxInitLen, 232); yyrt1218 {
+			yyrr1218 = yyrl1218
 		}
-		yyv1151 = make([]DaemonSet, yyrl1151)
-		yyc1151 = true
+		yyv1218 = make([]DaemonSet, yyrl1218)
+		yyc1218 = true
 	}
 
-	if yyl1151 == 0 {
-		if len(yyv1151) != 0 {
-			yyv1151 = yyv1151[:0]
-			yyc1151 = true
+	if yyl1218 == 0 {
+		if len(yyv1218) != 0 {
+			yyv1218 = yyv1218[:0]
+			yyc1218 = true
 		}
-	} else if yyl1151 > 0 {
+	} else if yyl1218 > 0 {
 
-		if yyl1151 > cap(yyv1151) {
-			yyrl1151, yyrt1151 = z.DecInferLen(yyl1151, z.DecBasicHandle().MaxInitLen, 232)
-			yyv1151 = make([]DaemonSet, yyrl1151)
-			yyc1151 = true
+		if yyl1218 > cap(yyv1218) {
+			yyrl1218, yyrt1218 = z.DecInferLen(yyl1218, z.DecBasicHandle().MaxInitLen, 232)
+			yyv1218 = make([]DaemonSet, yyrl1218)
+			yyc1218 = true
 
-			yyrr1151 = len(yyv1151)
-		} else if yyl1151 != len(yyv1151) {
-			yyv1151 = yyv1151[:yyl1151]
-			yyc1151 = true
+			yyrr1218 = len(yyv1218)
+		} else if yyl1218 != len(yyv1218) {
+			yyv1218 = yyv1218[:yyl1218]
+			yyc1218 = true
 		}
-		yyj1151 := 0
-		for ; yyj1151 < yyrr1151; yyj1151++ {
+		yyj1218 := 0
+		for ; yyj1218 < yyrr1218; yyj1218++ {
 			if r.TryDecodeAsNil() {
-				yyv1151[yyj1151] = DaemonSet{}
+				yyv1218[yyj1218] = DaemonSet{}
 			} else {
-				yyv1152 := &yyv1151[yyj1151]
-				yyv1152.CodecDecodeSelf(d)
+				yyv1219 := &yyv1218[yyj1218]
+				yyv1219.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1151 {
-			for ; yyj1151 < yyl1151; yyj1151++ {
-				yyv1151 = append(yyv1151, DaemonSet{})
+		if yyrt1218 {
+			for ; yyj1218 < yyl1218; yyj1218++ {
+				yyv1218 = append(yyv1218, DaemonSet{})
 				if r.TryDecodeAsNil() {
-					yyv1151[yyj1151] = DaemonSet{}
+					yyv1218[yyj1218] = DaemonSet{}
 				} else {
-					yyv1153 := &yyv1151[yyj1151]
-					yyv1153.CodecDecodeSelf(d)
+					yyv1220 := &yyv1218[yyj1218]
+					yyv1220.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1151 := 0; !r.CheckBreak(); yyj1151++ {
-			if yyj1151 >= len(yyv1151) {
-				yyv1151 = append(yyv1151, DaemonSet{}) // var yyz1151 DaemonSet
-				yyc1151 = true
+		for yyj1218 := 0; !r.CheckBreak(); yyj1218++ {
+			if yyj1218 >= len(yyv1218) {
+				yyv1218 = append(yyv1218, DaemonSet{}) // var yyz1218 DaemonSet
+				yyc1218 = true
 			}
 
-			if yyj1151 < len(yyv1151) {
+			if yyj1218 < len(yyv1218) {
 				if r.TryDecodeAsNil() {
-					yyv1151[yyj1151] = DaemonSet{}
+					yyv1218[yyj1218] = DaemonSet{}
 				} else {
-					yyv1154 := &yyv1151[yyj1151]
-					yyv1154.CodecDecodeSelf(d)
+					yyv1221 := &yyv1218[yyj1218]
+					yyv1221.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13072,10 +13794,10 @@ func (x codecSelfer1234) decSliceDaemonSet(v *[]DaemonSet, d *codec1978.Decoder)
 			}
 
 		}
-		yyh1151.End()
+		yyh1218.End()
 	}
-	if yyc1151 {
-		*v = yyv1151
+	if yyc1218 {
+		*v = yyv1218
 	}
 
 }
@@ -13085,9 +13807,9 @@ func (x codecSelfer1234) encSliceThirdPartyResourceData(v []ThirdPartyResourceDa
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1155 := range v {
-		yy1156 := &yyv1155
-		yy1156.CodecEncodeSelf(e)
+	for _, yyv1222 := range v {
+		yy1223 := &yyv1222
+		yy1223.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13097,75 +13819,75 @@ func (x codecSelfer1234) decSliceThirdPartyResourceData(v *[]ThirdPartyResourceD
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1157 := *v
-	yyh1157, yyl1157 := z.DecSliceHelperStart()
+	yyv1224 := *v
+	yyh1224, yyl1224 := z.DecSliceHelperStart()
 
-	var yyrr1157, yyrl1157 int
-	var yyc1157, yyrt1157 bool
-	_, _, _ = yyc1157, yyrt1157, yyrl1157
-	yyrr1157 = yyl1157
+	var yyrr1224, yyrl1224 int
+	var yyc1224, yyrt1224 bool
+	_, _, _ = yyc1224, yyrt1224, yyrl1224
+	yyrr1224 = yyl1224
 
-	if yyv1157 == nil {
-		if yyrl1157, yyrt1157 = z.DecInferLen(yyl1157, z.DecBasicHandle().MaxInitLen, 216); yyrt1157 {
-			yyrr1157 = yyrl1157
+	if yyv1224 == nil {
+		if yyrl1224, yyrt1224 = z.DecInferLen(yyl1224, z.DecBasicHandle().MaxInitLen, 216); yyrt1224 {
+			yyrr1224 = yyrl1224
 		}
-		yyv1157 = make([]ThirdPartyResourceData, yyrl1157)
-		yyc1157 = true
+		yyv1224 = make([]ThirdPartyResourceData, yyrl1224)
+		yyc1224 = true
 	}
 
-	if yyl1157 == 0 {
-		if len(yyv1157) != 0 {
-			yyv1157 = yyv1157[:0]
-			yyc1157 = true
+	if yyl1224 == 0 {
+		if len(yyv1224) != 0 {
+			yyv1224 = yyv1224[:0]
+			yyc1224 = true
 		}
-	} else if yyl1157 > 0 {
+	} else if yyl1224 > 0 {
 
-		if yyl1157 > cap(yyv1157) {
-			yyrl1157, yyrt1157 = z.DecInferLen(yyl1157, z.DecBasicHandle().MaxInitLen, 216)
-			yyv1157 = make([]ThirdPartyResourceData, yyrl1157)
-			yyc1157 = true
+		if yyl1224 > cap(yyv1224) {
+			yyrl1224, yyrt1224 = z.DecInferLen(yyl1224, z.DecBasicHandle().MaxInitLen, 216)
+			yyv1224 = make([]ThirdPartyResourceData, yyrl1224)
+			yyc1224 = true
 
-			yyrr1157 = len(yyv1157)
-		} else if yyl1157 != len(yyv1157) {
-			yyv1157 = yyv1157[:yyl1157]
-			yyc1157 = true
+			yyrr1224 = len(yyv1224)
+		} else if yyl1224 != len(yyv1224) {
+			yyv1224 = yyv1224[:yyl1224]
+			yyc1224 = true
 		}
-		yyj1157 := 0
-		for ; yyj1157 < yyrr1157; yyj1157++ {
+		yyj1224 := 0
+		for ; yyj1224 < yyrr1224; yyj1224++ {
 			if r.TryDecodeAsNil() {
-				yyv1157[yyj1157] = ThirdPartyResourceData{}
+				yyv1224[yyj1224] = ThirdPartyResourceData{}
 			} else {
-				yyv1158 := &yyv1157[yyj1157]
-				yyv1158.CodecDecodeSelf(d)
+				yyv1225 := &yyv1224[yyj1224]
+				yyv1225.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1157 {
-			for ; yyj1157 < yyl1157; yyj1157++ {
-				yyv1157 = append(yyv1157, ThirdPartyResourceData{})
+		if yyrt1224 {
+			for ; yyj1224 < yyl1224; yyj1224++ {
+				yyv1224 = append(yyv1224, ThirdPartyResourceData{})
 				if r.TryDecodeAsNil() {
-					yyv1157[yyj1157] = ThirdPartyResourceData{}
+					yyv1224[yyj1224] = ThirdPartyResourceData{}
 				} else {
-					yyv1159 := &yyv1157[yyj1157]
-					yyv1159.CodecDecodeSelf(d)
+					yyv1226 := &yyv1224[yyj1224]
+					yyv1226.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1157 := 0; !r.CheckBreak(); yyj1157++ {
-			if yyj1157 >= len(yyv1157) {
-				yyv1157 = append(yyv1157, ThirdPartyResourceData{}) // var yyz1157 ThirdPartyResourceData
-				yyc1157 = true
+		for yyj1224 := 0; !r.CheckBreak(); yyj1224++ {
+			if yyj1224 >= len(yyv1224) {
+				yyv1224 = append(yyv1224, ThirdPartyResourceData{}) // var yyz1224 ThirdPartyResourceData
+				yyc1224 = true
 			}
 
-			if yyj1157 < len(yyv1157) {
+			if yyj1224 < len(yyv1224) {
 				if r.TryDecodeAsNil() {
-					yyv1157[yyj1157] = ThirdPartyResourceData{}
+					yyv1224[yyj1224] = ThirdPartyResourceData{}
 				} else {
-					yyv1160 := &yyv1157[yyj1157]
-					yyv1160.CodecDecodeSelf(d)
+					yyv1227 := &yyv1224[yyj1224]
+					yyv1227.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13173,10 +13895,10 @@ func (x codecSelfer1234) decSliceThirdPartyResourceData(v *[]ThirdPartyResourceD
 			}
 
 		}
-		yyh1157.End()
+		yyh1224.End()
 	}
-	if yyc1157 {
-		*v = yyv1157
+	if yyc1224 {
+		*v = yyv1224
 	}
 
 }
@@ -13186,9 +13908,9 @@ func (x codecSelfer1234) encSliceJob(v []Job, e *codec1978.Encoder) {
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1161 := range v {
-		yy1162 := &yyv1161
-		yy1162.CodecEncodeSelf(e)
+	for _, yyv1228 := range v {
+		yy1229 := &yyv1228
+		yy1229.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13198,75 +13920,75 @@ func (x codecSelfer1234) decSliceJob(v *[]Job, d *codec1978.Decoder) {
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1163 := *v
-	yyh1163, yyl1163 := z.DecSliceHelperStart()
+	yyv1230 := *v
+	yyh1230, yyl1230 := z.DecSliceHelperStart()
 
-	var yyrr1163, yyrl1163 int
-	var yyc1163, yyrt1163 bool
-	_, _, _ = yyc1163, yyrt1163, yyrl1163
-	yyrr1163 = yyl1163
+	var yyrr1230, yyrl1230 int
+	var yyc1230, yyrt1230 bool
+	_, _, _ = yyc1230, yyrt1230, yyrl1230
+	yyrr1230 = yyl1230
 
-	if yyv1163 == nil {
-		if yyrl1163, yyrt1163 = z.DecInferLen(yyl1163, z.DecBasicHandle().MaxInitLen, 608); yyrt1163 {
-			yyrr1163 = yyrl1163
+	if yyv1230 == nil {
+		if yyrl1230, yyrt1230 = z.DecInferLen(yyl1230, z.DecBasicHandle().MaxInitLen, 608); yyrt1230 {
+			yyrr1230 = yyrl1230
 		}
-		yyv1163 = make([]Job, yyrl1163)
-		yyc1163 = true
+		yyv1230 = make([]Job, yyrl1230)
+		yyc1230 = true
 	}
 
-	if yyl1163 == 0 {
-		if len(yyv1163) != 0 {
-			yyv1163 = yyv1163[:0]
-			yyc1163 = true
+	if yyl1230 == 0 {
+		if len(yyv1230) != 0 {
+			yyv1230 = yyv1230[:0]
+			yyc1230 = true
 		}
-	} else if yyl1163 > 0 {
+	} else if yyl1230 > 0 {
 
-		if yyl1163 > cap(yyv1163) {
-			yyrl1163, yyrt1163 = z.DecInferLen(yyl1163, z.DecBasicHandle().MaxInitLen, 608)
-			yyv1163 = make([]Job, yyrl1163)
-			yyc1163 = true
+		if yyl1230 > cap(yyv1230) {
+			yyrl1230, yyrt1230 = z.DecInferLen(yyl1230, z.DecBasicHandle().MaxInitLen, 608)
+			yyv1230 = make([]Job, yyrl1230)
+			yyc1230 = true
 
-			yyrr1163 = len(yyv1163)
-		} else if yyl1163 != len(yyv1163) {
-			yyv1163 = yyv1163[:yyl1163]
-			yyc1163 = true
+			yyrr1230 = len(yyv1230)
+		} else if yyl1230 != len(yyv1230) {
+			yyv1230 = yyv1230[:yyl1230]
+			yyc1230 = true
 		}
-		yyj1163 := 0
-		for ; yyj1163 < yyrr1163; yyj1163++ {
+		yyj1230 := 0
+		for ; yyj1230 < yyrr1230; yyj1230++ {
 			if r.TryDecodeAsNil() {
-				yyv1163[yyj1163] = Job{}
+				yyv1230[yyj1230] = Job{}
 			} else {
-				yyv1164 := &yyv1163[yyj1163]
-				yyv1164.CodecDecodeSelf(d)
+				yyv1231 := &yyv1230[yyj1230]
+				yyv1231.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1163 {
-			for ; yyj1163 < yyl1163; yyj1163++ {
-				yyv1163 = append(yyv1163, Job{})
+		if yyrt1230 {
+			for ; yyj1230 < yyl1230; yyj1230++ {
+				yyv1230 = append(yyv1230, Job{})
 				if r.TryDecodeAsNil() {
-					yyv1163[yyj1163] = Job{}
+					yyv1230[yyj1230] = Job{}
 				} else {
-					yyv1165 := &yyv1163[yyj1163]
-					yyv1165.CodecDecodeSelf(d)
+					yyv1232 := &yyv1230[yyj1230]
+					yyv1232.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1163 := 0; !r.CheckBreak(); yyj1163++ {
-			if yyj1163 >= len(yyv1163) {
-				yyv1163 = append(yyv1163, Job{}) // var yyz1163 Job
-				yyc1163 = true
+		for yyj1230 := 0; !r.CheckBreak(); yyj1230++ {
+			if yyj1230 >= len(yyv1230) {
+				yyv1230 = append(yyv1230, Job{}) // var yyz1230 Job
+				yyc1230 = true
 			}
 
-			if yyj1163 < len(yyv1163) {
+			if yyj1230 < len(yyv1230) {
 				if r.TryDecodeAsNil() {
-					yyv1163[yyj1163] = Job{}
+					yyv1230[yyj1230] = Job{}
 				} else {
-					yyv1166 := &yyv1163[yyj1163]
-					yyv1166.CodecDecodeSelf(d)
+					yyv1233 := &yyv1230[yyj1230]
+					yyv1233.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13274,10 +13996,10 @@ func (x codecSelfer1234) decSliceJob(v *[]Job, d *codec1978.Decoder) {
 			}
 
 		}
-		yyh1163.End()
+		yyh1230.End()
 	}
-	if yyc1163 {
-		*v = yyv1163
+	if yyc1230 {
+		*v = yyv1230
 	}
 
 }
@@ -13287,9 +14009,9 @@ func (x codecSelfer1234) encSliceJobCondition(v []JobCondition, e *codec1978.Enc
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1167 := range v {
-		yy1168 := &yyv1167
-		yy1168.CodecEncodeSelf(e)
+	for _, yyv1234 := range v {
+		yy1235 := &yyv1234
+		yy1235.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13299,75 +14021,75 @@ func (x codecSelfer1234) decSliceJobCondition(v *[]JobCondition, d *codec1978.De
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1169 := *v
-	yyh1169, yyl1169 := z.DecSliceHelperStart()
+	yyv1236 := *v
+	yyh1236, yyl1236 := z.DecSliceHelperStart()
 
-	var yyrr1169, yyrl1169 int
-	var yyc1169, yyrt1169 bool
-	_, _, _ = yyc1169, yyrt1169, yyrl1169
-	yyrr1169 = yyl1169
+	var yyrr1236, yyrl1236 int
+	var yyc1236, yyrt1236 bool
+	_, _, _ = yyc1236, yyrt1236, yyrl1236
+	yyrr1236 = yyl1236
 
-	if yyv1169 == nil {
-		if yyrl1169, yyrt1169 = z.DecInferLen(yyl1169, z.DecBasicHandle().MaxInitLen, 112); yyrt1169 {
-			yyrr1169 = yyrl1169
+	if yyv1236 == nil {
+		if yyrl1236, yyrt1236 = z.DecInferLen(yyl1236, z.DecBasicHandle().MaxInitLen, 112); yyrt1236 {
+			yyrr1236 = yyrl1236
 		}
-		yyv1169 = make([]JobCondition, yyrl1169)
-		yyc1169 = true
+		yyv1236 = make([]JobCondition, yyrl1236)
+		yyc1236 = true
 	}
 
-	if yyl1169 == 0 {
-		if len(yyv1169) != 0 {
-			yyv1169 = yyv1169[:0]
-			yyc1169 = true
+	if yyl1236 == 0 {
+		if len(yyv1236) != 0 {
+			yyv1236 = yyv1236[:0]
+			yyc1236 = true
 		}
-	} else if yyl1169 > 0 {
+	} else if yyl1236 > 0 {
 
-		if yyl1169 > cap(yyv1169) {
-			yyrl1169, yyrt1169 = z.DecInferLen(yyl1169, z.DecBasicHandle().MaxInitLen, 112)
-			yyv1169 = make([]JobCondition, yyrl1169)
-			yyc1169 = true
+		if yyl1236 > cap(yyv1236) {
+			yyrl1236, yyrt1236 = z.DecInferLen(yyl1236, z.DecBasicHandle().MaxInitLen, 112)
+			yyv1236 = make([]JobCondition, yyrl1236)
+			yyc1236 = true
 
-			yyrr1169 = len(yyv1169)
-		} else if yyl1169 != len(yyv1169) {
-			yyv1169 = yyv1169[:yyl1169]
-			yyc1169 = true
+			yyrr1236 = len(yyv1236)
+		} else if yyl1236 != len(yyv1236) {
+			yyv1236 = yyv1236[:yyl1236]
+			yyc1236 = true
 		}
-		yyj1169 := 0
-		for ; yyj1169 < yyrr1169; yyj1169++ {
+		yyj1236 := 0
+		for ; yyj1236 < yyrr1236; yyj1236++ {
 			if r.TryDecodeAsNil() {
-				yyv1169[yyj1169] = JobCondition{}
+				yyv1236[yyj1236] = JobCondition{}
 			} else {
-				yyv1170 := &yyv1169[yyj1169]
-				yyv1170.CodecDecodeSelf(d)
+				yyv1237 := &yyv1236[yyj1236]
+				yyv1237.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1169 {
-			for ; yyj1169 < yyl1169; yyj1169++ {
-				yyv1169 = append(yyv1169, JobCondition{})
+		if yyrt1236 {
+			for ; yyj1236 < yyl1236; yyj1236++ {
+				yyv1236 = append(yyv1236, JobCondition{})
 				if r.TryDecodeAsNil() {
-					yyv1169[yyj1169] = JobCondition{}
+					yyv1236[yyj1236] = JobCondition{}
 				} else {
-					yyv1171 := &yyv1169[yyj1169]
-					yyv1171.CodecDecodeSelf(d)
+					yyv1238 := &yyv1236[yyj1236]
+					yyv1238.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1169 := 0; !r.CheckBreak(); yyj1169++ {
-			if yyj1169 >= len(yyv1169) {
-				yyv1169 = append(yyv1169, JobCondition{}) // var yyz1169 JobCondition
-				yyc1169 = true
+		for yyj1236 := 0; !r.CheckBreak(); yyj1236++ {
+			if yyj1236 >= len(yyv1236) {
+				yyv1236 = append(yyv1236, JobCondition{}) // var yyz1236 JobCondition
+				yyc1236 = true
 			}
 
-			if yyj1169 < len(yyv1169) {
+			if yyj1236 < len(yyv1236) {
 				if r.TryDecodeAsNil() {
-					yyv1169[yyj1169] = JobCondition{}
+					yyv1236[yyj1236] = JobCondition{}
 				} else {
-					yyv1172 := &yyv1169[yyj1169]
-					yyv1172.CodecDecodeSelf(d)
+					yyv1239 := &yyv1236[yyj1236]
+					yyv1239.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13375,10 +14097,10 @@ func (x codecSelfer1234) decSliceJobCondition(v *[]JobCondition, d *codec1978.De
 			}
 
 		}
-		yyh1169.End()
+		yyh1236.End()
 	}
-	if yyc1169 {
-		*v = yyv1169
+	if yyc1236 {
+		*v = yyv1236
 	}
 
 }
@@ -13388,9 +14110,9 @@ func (x codecSelfer1234) encSliceIngress(v []Ingress, e *codec1978.Encoder) {
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1173 := range v {
-		yy1174 := &yyv1173
-		yy1174.CodecEncodeSelf(e)
+	for _, yyv1240 := range v {
+		yy1241 := &yyv1240
+		yy1241.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13400,75 +14122,75 @@ func (x codecSelfer1234) decSliceIngress(v *[]Ingress, d *codec1978.Decoder) {
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1175 := *v
-	yyh1175, yyl1175 := z.DecSliceHelperStart()
+	yyv1242 := *v
+	yyh1242, yyl1242 := z.DecSliceHelperStart()
 
-	var yyrr1175, yyrl1175 int
-	var yyc1175, yyrt1175 bool
-	_, _, _ = yyc1175, yyrt1175, yyrl1175
-	yyrr1175 = yyl1175
+	var yyrr1242, yyrl1242 int
+	var yyc1242, yyrt1242 bool
+	_, _, _ = yyc1242, yyrt1242, yyrl1242
+	yyrr1242 = yyl1242
 
-	if yyv1175 == nil {
-		if yyrl1175, yyrt1175 = z.DecInferLen(yyl1175, z.DecBasicHandle().MaxInitLen, 248); yyrt1175 {
-			yyrr1175 = yyrl1175
+	if yyv1242 == nil {
+		if yyrl1242, yyrt1242 = z.DecInferLen(yyl1242, z.DecBasicHandle().MaxInitLen, 248); yyrt1242 {
+			yyrr1242 = yyrl1242
 		}
-		yyv1175 = make([]Ingress, yyrl1175)
-		yyc1175 = true
+		yyv1242 = make([]Ingress, yyrl1242)
+		yyc1242 = true
 	}
 
-	if yyl1175 == 0 {
-		if len(yyv1175) != 0 {
-			yyv1175 = yyv1175[:0]
-			yyc1175 = true
+	if yyl1242 == 0 {
+		if len(yyv1242) != 0 {
+			yyv1242 = yyv1242[:0]
+			yyc1242 = true
 		}
-	} else if yyl1175 > 0 {
+	} else if yyl1242 > 0 {
 
-		if yyl1175 > cap(yyv1175) {
-			yyrl1175, yyrt1175 = z.DecInferLen(yyl1175, z.DecBasicHandle().MaxInitLen, 248)
-			yyv1175 = make([]Ingress, yyrl1175)
-			yyc1175 = true
+		if yyl1242 > cap(yyv1242) {
+			yyrl1242, yyrt1242 = z.DecInferLen(yyl1242, z.DecBasicHandle().MaxInitLen, 248)
+			yyv1242 = make([]Ingress, yyrl1242)
+			yyc1242 = true
 
-			yyrr1175 = len(yyv1175)
-		} else if yyl1175 != len(yyv1175) {
-			yyv1175 = yyv1175[:yyl1175]
-			yyc1175 = true
+			yyrr1242 = len(yyv1242)
+		} else if yyl1242 != len(yyv1242) {
+			yyv1242 = yyv1242[:yyl1242]
+			yyc1242 = true
 		}
-		yyj1175 := 0
-		for ; yyj1175 < yyrr1175; yyj1175++ {
+		yyj1242 := 0
+		for ; yyj1242 < yyrr1242; yyj1242++ {
 			if r.TryDecodeAsNil() {
-				yyv1175[yyj1175] = Ingress{}
+				yyv1242[yyj1242] = Ingress{}
 			} else {
-				yyv1176 := &yyv1175[yyj1175]
-				yyv1176.CodecDecodeSelf(d)
+				yyv1243 := &yyv1242[yyj1242]
+				yyv1243.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1175 {
-			for ; yyj1175 < yyl1175; yyj1175++ {
-				yyv1175 = append(yyv1175, Ingress{})
+		if yyrt1242 {
+			for ; yyj1242 < yyl1242; yyj1242++ {
+				yyv1242 = append(yyv1242, Ingress{})
 				if r.TryDecodeAsNil() {
-					yyv1175[yyj1175] = Ingress{}
+					yyv1242[yyj1242] = Ingress{}
 				} else {
-					yyv1177 := &yyv1175[yyj1175]
-					yyv1177.CodecDecodeSelf(d)
+					yyv1244 := &yyv1242[yyj1242]
+					yyv1244.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1175 := 0; !r.CheckBreak(); yyj1175++ {
-			if yyj1175 >= len(yyv1175) {
-				yyv1175 = append(yyv1175, Ingress{}) // var yyz1175 Ingress
-				yyc1175 = true
+		for yyj1242 := 0; !r.CheckBreak(); yyj1242++ {
+			if yyj1242 >= len(yyv1242) {
+				yyv1242 = append(yyv1242, Ingress{}) // var yyz1242 Ingress
+				yyc1242 = true
 			}
 
-			if yyj1175 < len(yyv1175) {
+			if yyj1242 < len(yyv1242) {
 				if r.TryDecodeAsNil() {
-					yyv1175[yyj1175] = Ingress{}
+					yyv1242[yyj1242] = Ingress{}
 				} else {
-					yyv1178 := &yyv1175[yyj1175]
-					yyv1178.CodecDecodeSelf(d)
+					yyv1245 := &yyv1242[yyj1242]
+					yyv1245.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13476,10 +14198,10 @@ func (x codecSelfer1234) decSliceIngress(v *[]Ingress, d *codec1978.Decoder) {
 			}
 
 		}
-		yyh1175.End()
+		yyh1242.End()
 	}
-	if yyc1175 {
-		*v = yyv1175
+	if yyc1242 {
+		*v = yyv1242
 	}
 
 }
@@ -13489,9 +14211,9 @@ func (x codecSelfer1234) encSliceIngressRule(v []IngressRule, e *codec1978.Encod
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1179 := range v {
-		yy1180 := &yyv1179
-		yy1180.CodecEncodeSelf(e)
+	for _, yyv1246 := range v {
+		yy1247 := &yyv1246
+		yy1247.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13501,75 +14223,75 @@ func (x codecSelfer1234) decSliceIngressRule(v *[]IngressRule, d *codec1978.Deco
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1181 := *v
-	yyh1181, yyl1181 := z.DecSliceHelperStart()
+	yyv1248 := *v
+	yyh1248, yyl1248 := z.DecSliceHelperStart()
 
-	var yyrr1181, yyrl1181 int
-	var yyc1181, yyrt1181 bool
-	_, _, _ = yyc1181, yyrt1181, yyrl1181
-	yyrr1181 = yyl1181
+	var yyrr1248, yyrl1248 int
+	var yyc1248, yyrt1248 bool
+	_, _, _ = yyc1248, yyrt1248, yyrl1248
+	yyrr1248 = yyl1248
 
-	if yyv1181 == nil {
-		if yyrl1181, yyrt1181 = z.DecInferLen(yyl1181, z.DecBasicHandle().MaxInitLen, 24); yyrt1181 {
-			yyrr1181 = yyrl1181
+	if yyv1248 == nil {
+		if yyrl1248, yyrt1248 = z.DecInferLen(yyl1248, z.DecBasicHandle().MaxInitLen, 24); yyrt1248 {
+			yyrr1248 = yyrl1248
 		}
-		yyv1181 = make([]IngressRule, yyrl1181)
-		yyc1181 = true
+		yyv1248 = make([]IngressRule, yyrl1248)
+		yyc1248 = true
 	}
 
-	if yyl1181 == 0 {
-		if len(yyv1181) != 0 {
-			yyv1181 = yyv1181[:0]
-			yyc1181 = true
+	if yyl1248 == 0 {
+		if len(yyv1248) != 0 {
+			yyv1248 = yyv1248[:0]
+			yyc1248 = true
 		}
-	} else if yyl1181 > 0 {
+	} else if yyl1248 > 0 {
 
-		if yyl1181 > cap(yyv1181) {
-			yyrl1181, yyrt1181 = z.DecInferLen(yyl1181, z.DecBasicHandle().MaxInitLen, 24)
-			yyv1181 = make([]IngressRule, yyrl1181)
-			yyc1181 = true
+		if yyl1248 > cap(yyv1248) {
+			yyrl1248, yyrt1248 = z.DecInferLen(yyl1248, z.DecBasicHandle().MaxInitLen, 24)
+			yyv1248 = make([]IngressRule, yyrl1248)
+			yyc1248 = true
 
-			yyrr1181 = len(yyv1181)
-		} else if yyl1181 != len(yyv1181) {
-			yyv1181 = yyv1181[:yyl1181]
-			yyc1181 = true
+			yyrr1248 = len(yyv1248)
+		} else if yyl1248 != len(yyv1248) {
+			yyv1248 = yyv1248[:yyl1248]
+			yyc1248 = true
 		}
-		yyj1181 := 0
-		for ; yyj1181 < yyrr1181; yyj1181++ {
+		yyj1248 := 0
+		for ; yyj1248 < yyrr1248; yyj1248++ {
 			if r.TryDecodeAsNil() {
-				yyv1181[yyj1181] = IngressRule{}
+				yyv1248[yyj1248] = IngressRule{}
 			} else {
-				yyv1182 := &yyv1181[yyj1181]
-				yyv1182.CodecDecodeSelf(d)
+				yyv1249 := &yyv1248[yyj1248]
+				yyv1249.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1181 {
-			for ; yyj1181 < yyl1181; yyj1181++ {
-				yyv1181 = append(yyv1181, IngressRule{})
+		if yyrt1248 {
+			for ; yyj1248 < yyl1248; yyj1248++ {
+				yyv1248 = append(yyv1248, IngressRule{})
 				if r.TryDecodeAsNil() {
-					yyv1181[yyj1181] = IngressRule{}
+					yyv1248[yyj1248] = IngressRule{}
 				} else {
-					yyv1183 := &yyv1181[yyj1181]
-					yyv1183.CodecDecodeSelf(d)
+					yyv1250 := &yyv1248[yyj1248]
+					yyv1250.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1181 := 0; !r.CheckBreak(); yyj1181++ {
-			if yyj1181 >= len(yyv1181) {
-				yyv1181 = append(yyv1181, IngressRule{}) // var yyz1181 IngressRule
-				yyc1181 = true
+		for yyj1248 := 0; !r.CheckBreak(); yyj1248++ {
+			if yyj1248 >= len(yyv1248) {
+				yyv1248 = append(yyv1248, IngressRule{}) // var yyz1248 IngressRule
+				yyc1248 = true
 			}
 
-			if yyj1181 < len(yyv1181) {
+			if yyj1248 < len(yyv1248) {
 				if r.TryDecodeAsNil() {
-					yyv1181[yyj1181] = IngressRule{}
+					yyv1248[yyj1248] = IngressRule{}
 				} else {
-					yyv1184 := &yyv1181[yyj1181]
-					yyv1184.CodecDecodeSelf(d)
+					yyv1251 := &yyv1248[yyj1248]
+					yyv1251.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13577,10 +14299,10 @@ func (x codecSelfer1234) decSliceIngressRule(v *[]IngressRule, d *codec1978.Deco
 			}
 
 		}
-		yyh1181.End()
+		yyh1248.End()
 	}
-	if yyc1181 {
-		*v = yyv1181
+	if yyc1248 {
+		*v = yyv1248
 	}
 
 }
@@ -13590,9 +14312,9 @@ func (x codecSelfer1234) encSliceHTTPIngressPath(v []HTTPIngressPath, e *codec19
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1185 := range v {
-		yy1186 := &yyv1185
-		yy1186.CodecEncodeSelf(e)
+	for _, yyv1252 := range v {
+		yy1253 := &yyv1252
+		yy1253.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13602,75 +14324,75 @@ func (x codecSelfer1234) decSliceHTTPIngressPath(v *[]HTTPIngressPath, d *codec1
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1187 := *v
-	yyh1187, yyl1187 := z.DecSliceHelperStart()
+	yyv1254 := *v
+	yyh1254, yyl1254 := z.DecSliceHelperStart()
 
-	var yyrr1187, yyrl1187 int
-	var yyc1187, yyrt1187 bool
-	_, _, _ = yyc1187, yyrt1187, yyrl1187
-	yyrr1187 = yyl1187
+	var yyrr1254, yyrl1254 int
+	var yyc1254, yyrt1254 bool
+	_, _, _ = yyc1254, yyrt1254, yyrl1254
+	yyrr1254 = yyl1254
 
-	if yyv1187 == nil {
-		if yyrl1187, yyrt1187 = z.DecInferLen(yyl1187, z.DecBasicHandle().MaxInitLen, 64); yyrt1187 {
-			yyrr1187 = yyrl1187
+	if yyv1254 == nil {
+		if yyrl1254, yyrt1254 = z.DecInferLen(yyl1254, z.DecBasicHandle().MaxInitLen, 64); yyrt1254 {
+			yyrr1254 = yyrl1254
 		}
-		yyv1187 = make([]HTTPIngressPath, yyrl1187)
-		yyc1187 = true
+		yyv1254 = make([]HTTPIngressPath, yyrl1254)
+		yyc1254 = true
 	}
 
-	if yyl1187 == 0 {
-		if len(yyv1187) != 0 {
-			yyv1187 = yyv1187[:0]
-			yyc1187 = true
+	if yyl1254 == 0 {
+		if len(yyv1254) != 0 {
+			yyv1254 = yyv1254[:0]
+			yyc1254 = true
 		}
-	} else if yyl1187 > 0 {
+	} else if yyl1254 > 0 {
 
-		if yyl1187 > cap(yyv1187) {
-			yyrl1187, yyrt1187 = z.DecInferLen(yyl1187, z.DecBasicHandle().MaxInitLen, 64)
-			yyv1187 = make([]HTTPIngressPath, yyrl1187)
-			yyc1187 = true
+		if yyl1254 > cap(yyv1254) {
+			yyrl1254, yyrt1254 = z.DecInferLen(yyl1254, z.DecBasicHandle().MaxInitLen, 64)
+			yyv1254 = make([]HTTPIngressPath, yyrl1254)
+			yyc1254 = true
 
-			yyrr1187 = len(yyv1187)
-		} else if yyl1187 != len(yyv1187) {
-			yyv1187 = yyv1187[:yyl1187]
-			yyc1187 = true
+			yyrr1254 = len(yyv1254)
+		} else if yyl1254 != len(yyv1254) {
+			yyv1254 = yyv1254[:yyl1254]
+			yyc1254 = true
 		}
-		yyj1187 := 0
-		for ; yyj1187 < yyrr1187; yyj1187++ {
+		yyj1254 := 0
+		for ; yyj1254 < yyrr1254; yyj1254++ {
 			if r.TryDecodeAsNil() {
-				yyv1187[yyj1187] = HTTPIngressPath{}
+				yyv1254[yyj1254] = HTTPIngressPath{}
 			} else {
-				yyv1188 := &yyv1187[yyj1187]
-				yyv1188.CodecDecodeSelf(d)
+				yyv1255 := &yyv1254[yyj1254]
+				yyv1255.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1187 {
-			for ; yyj1187 < yyl1187; yyj1187++ {
-				yyv1187 = append(yyv1187, HTTPIngressPath{})
+		if yyrt1254 {
+			for ; yyj1254 < yyl1254; yyj1254++ {
+				yyv1254 = append(yyv1254, HTTPIngressPath{})
 				if r.TryDecodeAsNil() {
-					yyv1187[yyj1187] = HTTPIngressPath{}
+					yyv1254[yyj1254] = HTTPIngressPath{}
 				} else {
-					yyv1189 := &yyv1187[yyj1187]
-					yyv1189.CodecDecodeSelf(d)
+					yyv1256 := &yyv1254[yyj1254]
+					yyv1256.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1187 := 0; !r.CheckBreak(); yyj1187++ {
-			if yyj1187 >= len(yyv1187) {
-				yyv1187 = append(yyv1187, HTTPIngressPath{}) // var yyz1187 HTTPIngressPath
-				yyc1187 = true
+		for yyj1254 := 0; !r.CheckBreak(); yyj1254++ {
+			if yyj1254 >= len(yyv1254) {
+				yyv1254 = append(yyv1254, HTTPIngressPath{}) // var yyz1254 HTTPIngressPath
+				yyc1254 = true
 			}
 
-			if yyj1187 < len(yyv1187) {
+			if yyj1254 < len(yyv1254) {
 				if r.TryDecodeAsNil() {
-					yyv1187[yyj1187] = HTTPIngressPath{}
+					yyv1254[yyj1254] = HTTPIngressPath{}
 				} else {
-					yyv1190 := &yyv1187[yyj1187]
-					yyv1190.CodecDecodeSelf(d)
+					yyv1257 := &yyv1254[yyj1254]
+					yyv1257.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13678,10 +14400,10 @@ func (x codecSelfer1234) decSliceHTTPIngressPath(v *[]HTTPIngressPath, d *codec1
 			}
 
 		}
-		yyh1187.End()
+		yyh1254.End()
 	}
-	if yyc1187 {
-		*v = yyv1187
+	if yyc1254 {
+		*v = yyv1254
 	}
 
 }
@@ -13691,9 +14413,9 @@ func (x codecSelfer1234) encSliceNodeUtilization(v []NodeUtilization, e *codec19
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1191 := range v {
-		yy1192 := &yyv1191
-		yy1192.CodecEncodeSelf(e)
+	for _, yyv1258 := range v {
+		yy1259 := &yyv1258
+		yy1259.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13703,75 +14425,75 @@ func (x codecSelfer1234) decSliceNodeUtilization(v *[]NodeUtilization, d *codec1
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1193 := *v
-	yyh1193, yyl1193 := z.DecSliceHelperStart()
+	yyv1260 := *v
+	yyh1260, yyl1260 := z.DecSliceHelperStart()
 
-	var yyrr1193, yyrl1193 int
-	var yyc1193, yyrt1193 bool
-	_, _, _ = yyc1193, yyrt1193, yyrl1193
-	yyrr1193 = yyl1193
+	var yyrr1260, yyrl1260 int
+	var yyc1260, yyrt1260 bool
+	_, _, _ = yyc1260, yyrt1260, yyrl1260
+	yyrr1260 = yyl1260
 
-	if yyv1193 == nil {
-		if yyrl1193, yyrt1193 = z.DecInferLen(yyl1193, z.DecBasicHandle().MaxInitLen, 24); yyrt1193 {
-			yyrr1193 = yyrl1193
+	if yyv1260 == nil {
+		if yyrl1260, yyrt1260 = z.DecInferLen(yyl1260, z.DecBasicHandle().MaxInitLen, 24); yyrt1260 {
+			yyrr1260 = yyrl1260
 		}
-		yyv1193 = make([]NodeUtilization, yyrl1193)
-		yyc1193 = true
+		yyv1260 = make([]NodeUtilization, yyrl1260)
+		yyc1260 = true
 	}
 
-	if yyl1193 == 0 {
-		if len(yyv1193) != 0 {
-			yyv1193 = yyv1193[:0]
-			yyc1193 = true
+	if yyl1260 == 0 {
+		if len(yyv1260) != 0 {
+			yyv1260 = yyv1260[:0]
+			yyc1260 = true
 		}
-	} else if yyl1193 > 0 {
+	} else if yyl1260 > 0 {
 
-		if yyl1193 > cap(yyv1193) {
-			yyrl1193, yyrt1193 = z.DecInferLen(yyl1193, z.DecBasicHandle().MaxInitLen, 24)
-			yyv1193 = make([]NodeUtilization, yyrl1193)
-			yyc1193 = true
+		if yyl1260 > cap(yyv1260) {
+			yyrl1260, yyrt1260 = z.DecInferLen(yyl1260, z.DecBasicHandle().MaxInitLen, 24)
+			yyv1260 = make([]NodeUtilization, yyrl1260)
+			yyc1260 = true
 
-			yyrr1193 = len(yyv1193)
-		} else if yyl1193 != len(yyv1193) {
-			yyv1193 = yyv1193[:yyl1193]
-			yyc1193 = true
+			yyrr1260 = len(yyv1260)
+		} else if yyl1260 != len(yyv1260) {
+			yyv1260 = yyv1260[:yyl1260]
+			yyc1260 = true
 		}
-		yyj1193 := 0
-		for ; yyj1193 < yyrr1193; yyj1193++ {
+		yyj1260 := 0
+		for ; yyj1260 < yyrr1260; yyj1260++ {
 			if r.TryDecodeAsNil() {
-				yyv1193[yyj1193] = NodeUtilization{}
+				yyv1260[yyj1260] = NodeUtilization{}
 			} else {
-				yyv1194 := &yyv1193[yyj1193]
-				yyv1194.CodecDecodeSelf(d)
+				yyv1261 := &yyv1260[yyj1260]
+				yyv1261.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1193 {
-			for ; yyj1193 < yyl1193; yyj1193++ {
-				yyv1193 = append(yyv1193, NodeUtilization{})
+		if yyrt1260 {
+			for ; yyj1260 < yyl1260; yyj1260++ {
+				yyv1260 = append(yyv1260, NodeUtilization{})
 				if r.TryDecodeAsNil() {
-					yyv1193[yyj1193] = NodeUtilization{}
+					yyv1260[yyj1260] = NodeUtilization{}
 				} else {
-					yyv1195 := &yyv1193[yyj1193]
-					yyv1195.CodecDecodeSelf(d)
+					yyv1262 := &yyv1260[yyj1260]
+					yyv1262.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1193 := 0; !r.CheckBreak(); yyj1193++ {
-			if yyj1193 >= len(yyv1193) {
-				yyv1193 = append(yyv1193, NodeUtilization{}) // var yyz1193 NodeUtilization
-				yyc1193 = true
+		for yyj1260 := 0; !r.CheckBreak(); yyj1260++ {
+			if yyj1260 >= len(yyv1260) {
+				yyv1260 = append(yyv1260, NodeUtilization{}) // var yyz1260 NodeUtilization
+				yyc1260 = true
 			}
 
-			if yyj1193 < len(yyv1193) {
+			if yyj1260 < len(yyv1260) {
 				if r.TryDecodeAsNil() {
-					yyv1193[yyj1193] = NodeUtilization{}
+					yyv1260[yyj1260] = NodeUtilization{}
 				} else {
-					yyv1196 := &yyv1193[yyj1193]
-					yyv1196.CodecDecodeSelf(d)
+					yyv1263 := &yyv1260[yyj1260]
+					yyv1263.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13779,10 +14501,10 @@ func (x codecSelfer1234) decSliceNodeUtilization(v *[]NodeUtilization, d *codec1
 			}
 
 		}
-		yyh1193.End()
+		yyh1260.End()
 	}
-	if yyc1193 {
-		*v = yyv1193
+	if yyc1260 {
+		*v = yyv1260
 	}
 
 }
@@ -13792,9 +14514,9 @@ func (x codecSelfer1234) encSliceClusterAutoscaler(v []ClusterAutoscaler, e *cod
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1197 := range v {
-		yy1198 := &yyv1197
-		yy1198.CodecEncodeSelf(e)
+	for _, yyv1264 := range v {
+		yy1265 := &yyv1264
+		yy1265.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13804,75 +14526,75 @@ func (x codecSelfer1234) decSliceClusterAutoscaler(v *[]ClusterAutoscaler, d *co
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1199 := *v
-	yyh1199, yyl1199 := z.DecSliceHelperStart()
+	yyv1266 := *v
+	yyh1266, yyl1266 := z.DecSliceHelperStart()
 
-	var yyrr1199, yyrl1199 int
-	var yyc1199, yyrt1199 bool
-	_, _, _ = yyc1199, yyrt1199, yyrl1199
-	yyrr1199 = yyl1199
+	var yyrr1266, yyrl1266 int
+	var yyc1266, yyrt1266 bool
+	_, _, _ = yyc1266, yyrt1266, yyrl1266
+	yyrr1266 = yyl1266
 
-	if yyv1199 == nil {
-		if yyrl1199, yyrt1199 = z.DecInferLen(yyl1199, z.DecBasicHandle().MaxInitLen, 232); yyrt1199 {
-			yyrr1199 = yyrl1199
+	if yyv1266 == nil {
+		if yyrl1266, yyrt1266 = z.DecInferLen(yyl1266, z.DecBasicHandle().MaxInitLen, 232); yyrt1266 {
+			yyrr1266 = yyrl1266
 		}
-		yyv1199 = make([]ClusterAutoscaler, yyrl1199)
-		yyc1199 = true
+		yyv1266 = make([]ClusterAutoscaler, yyrl1266)
+		yyc1266 = true
 	}
 
-	if yyl1199 == 0 {
-		if len(yyv1199) != 0 {
-			yyv1199 = yyv1199[:0]
-			yyc1199 = true
+	if yyl1266 == 0 {
+		if len(yyv1266) != 0 {
+			yyv1266 = yyv1266[:0]
+			yyc1266 = true
 		}
-	} else if yyl1199 > 0 {
+	} else if yyl1266 > 0 {
 
-		if yyl1199 > cap(yyv1199) {
-			yyrl1199, yyrt1199 = z.DecInferLen(yyl1199, z.DecBasicHandle().MaxInitLen, 232)
-			yyv1199 = make([]ClusterAutoscaler, yyrl1199)
-			yyc1199 = true
+		if yyl1266 > cap(yyv1266) {
+			yyrl1266, yyrt1266 = z.DecInferLen(yyl1266, z.DecBasicHandle().MaxInitLen, 232)
+			yyv1266 = make([]ClusterAutoscaler, yyrl1266)
+			yyc1266 = true
 
-			yyrr1199 = len(yyv1199)
-		} else if yyl1199 != len(yyv1199) {
-			yyv1199 = yyv1199[:yyl1199]
-			yyc1199 = true
+			yyrr1266 = len(yyv1266)
+		} else if yyl1266 != len(yyv1266) {
+			yyv1266 = yyv1266[:yyl1266]
+			yyc1266 = true
 		}
-		yyj1199 := 0
-		for ; yyj1199 < yyrr1199; yyj1199++ {
+		yyj1266 := 0
+		for ; yyj1266 < yyrr1266; yyj1266++ {
 			if r.TryDecodeAsNil() {
-				yyv1199[yyj1199] = ClusterAutoscaler{}
+				yyv1266[yyj1266] = ClusterAutoscaler{}
 			} else {
-				yyv1200 := &yyv1199[yyj1199]
-				yyv1200.CodecDecodeSelf(d)
+				yyv1267 := &yyv1266[yyj1266]
+				yyv1267.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1199 {
-			for ; yyj1199 < yyl1199; yyj1199++ {
-				yyv1199 = append(yyv1199, ClusterAutoscaler{})
+		if yyrt1266 {
+			for ; yyj1266 < yyl1266; yyj1266++ {
+				yyv1266 = append(yyv1266, ClusterAutoscaler{})
 				if r.TryDecodeAsNil() {
-					yyv1199[yyj1199] = ClusterAutoscaler{}
+					yyv1266[yyj1266] = ClusterAutoscaler{}
 				} else {
-					yyv1201 := &yyv1199[yyj1199]
-					yyv1201.CodecDecodeSelf(d)
+					yyv1268 := &yyv1266[yyj1266]
+					yyv1268.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1199 := 0; !r.CheckBreak(); yyj1199++ {
-			if yyj1199 >= len(yyv1199) {
-				yyv1199 = append(yyv1199, ClusterAutoscaler{}) // var yyz1199 ClusterAutoscaler
-				yyc1199 = true
+		for yyj1266 := 0; !r.CheckBreak(); yyj1266++ {
+			if yyj1266 >= len(yyv1266) {
+				yyv1266 = append(yyv1266, ClusterAutoscaler{}) // var yyz1266 ClusterAutoscaler
+				yyc1266 = true
 			}
 
-			if yyj1199 < len(yyv1199) {
+			if yyj1266 < len(yyv1266) {
 				if r.TryDecodeAsNil() {
-					yyv1199[yyj1199] = ClusterAutoscaler{}
+					yyv1266[yyj1266] = ClusterAutoscaler{}
 				} else {
-					yyv1202 := &yyv1199[yyj1199]
-					yyv1202.CodecDecodeSelf(d)
+					yyv1269 := &yyv1266[yyj1266]
+					yyv1269.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13880,10 +14602,10 @@ func (x codecSelfer1234) decSliceClusterAutoscaler(v *[]ClusterAutoscaler, d *co
 			}
 
 		}
-		yyh1199.End()
+		yyh1266.End()
 	}
-	if yyc1199 {
-		*v = yyv1199
+	if yyc1266 {
+		*v = yyv1266
 	}
 
 }
@@ -13893,9 +14615,9 @@ func (x codecSelfer1234) encSlicePodSelectorRequirement(v []PodSelectorRequireme
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1203 := range v {
-		yy1204 := &yyv1203
-		yy1204.CodecEncodeSelf(e)
+	for _, yyv1270 := range v {
+		yy1271 := &yyv1270
+		yy1271.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13905,75 +14627,75 @@ func (x codecSelfer1234) decSlicePodSelectorRequirement(v *[]PodSelectorRequirem
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1205 := *v
-	yyh1205, yyl1205 := z.DecSliceHelperStart()
+	yyv1272 := *v
+	yyh1272, yyl1272 := z.DecSliceHelperStart()
 
-	var yyrr1205, yyrl1205 int
-	var yyc1205, yyrt1205 bool
-	_, _, _ = yyc1205, yyrt1205, yyrl1205
-	yyrr1205 = yyl1205
+	var yyrr1272, yyrl1272 int
+	var yyc1272, yyrt1272 bool
+	_, _, _ = yyc1272, yyrt1272, yyrl1272
+	yyrr1272 = yyl1272
 
-	if yyv1205 == nil {
-		if yyrl1205, yyrt1205 = z.DecInferLen(yyl1205, z.DecBasicHandle().MaxInitLen, 56); yyrt1205 {
-			yyrr1205 = yyrl1205
+	if yyv1272 == nil {
+		if yyrl1272, yyrt1272 = z.DecInferLen(yyl1272, z.DecBasicHandle().MaxInitLen, 56); yyrt1272 {
+			yyrr1272 = yyrl1272
 		}
-		yyv1205 = make([]PodSelectorRequirement, yyrl1205)
-		yyc1205 = true
+		yyv1272 = make([]PodSelectorRequirement, yyrl1272)
+		yyc1272 = true
 	}
 
-	if yyl1205 == 0 {
-		if len(yyv1205) != 0 {
-			yyv1205 = yyv1205[:0]
-			yyc1205 = true
+	if yyl1272 == 0 {
+		if len(yyv1272) != 0 {
+			yyv1272 = yyv1272[:0]
+			yyc1272 = true
 		}
-	} else if yyl1205 > 0 {
+	} else if yyl1272 > 0 {
 
-		if yyl1205 > cap(yyv1205) {
-			yyrl1205, yyrt1205 = z.DecInferLen(yyl1205, z.DecBasicHandle().MaxInitLen, 56)
-			yyv1205 = make([]PodSelectorRequirement, yyrl1205)
-			yyc1205 = true
+		if yyl1272 > cap(yyv1272) {
+			yyrl1272, yyrt1272 = z.DecInferLen(yyl1272, z.DecBasicHandle().MaxInitLen, 56)
+			yyv1272 = make([]PodSelectorRequirement, yyrl1272)
+			yyc1272 = true
 
-			yyrr1205 = len(yyv1205)
-		} else if yyl1205 != len(yyv1205) {
-			yyv1205 = yyv1205[:yyl1205]
-			yyc1205 = true
+			yyrr1272 = len(yyv1272)
+		} else if yyl1272 != len(yyv1272) {
+			yyv1272 = yyv1272[:yyl1272]
+			yyc1272 = true
 		}
-		yyj1205 := 0
-		for ; yyj1205 < yyrr1205; yyj1205++ {
+		yyj1272 := 0
+		for ; yyj1272 < yyrr1272; yyj1272++ {
 			if r.TryDecodeAsNil() {
-				yyv1205[yyj1205] = PodSelectorRequirement{}
+				yyv1272[yyj1272] = PodSelectorRequirement{}
 			} else {
-				yyv1206 := &yyv1205[yyj1205]
-				yyv1206.CodecDecodeSelf(d)
+				yyv1273 := &yyv1272[yyj1272]
+				yyv1273.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1205 {
-			for ; yyj1205 < yyl1205; yyj1205++ {
-				yyv1205 = append(yyv1205, PodSelectorRequirement{})
+		if yyrt1272 {
+			for ; yyj1272 < yyl1272; yyj1272++ {
+				yyv1272 = append(yyv1272, PodSelectorRequirement{})
 				if r.TryDecodeAsNil() {
-					yyv1205[yyj1205] = PodSelectorRequirement{}
+					yyv1272[yyj1272] = PodSelectorRequirement{}
 				} else {
-					yyv1207 := &yyv1205[yyj1205]
-					yyv1207.CodecDecodeSelf(d)
+					yyv1274 := &yyv1272[yyj1272]
+					yyv1274.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1205 := 0; !r.CheckBreak(); yyj1205++ {
-			if yyj1205 >= len(yyv1205) {
-				yyv1205 = append(yyv1205, PodSelectorRequirement{}) // var yyz1205 PodSelectorRequirement
-				yyc1205 = true
+		for yyj1272 := 0; !r.CheckBreak(); yyj1272++ {
+			if yyj1272 >= len(yyv1272) {
+				yyv1272 = append(yyv1272, PodSelectorRequirement{}) // var yyz1272 PodSelectorRequirement
+				yyc1272 = true
 			}
 
-			if yyj1205 < len(yyv1205) {
+			if yyj1272 < len(yyv1272) {
 				if r.TryDecodeAsNil() {
-					yyv1205[yyj1205] = PodSelectorRequirement{}
+					yyv1272[yyj1272] = PodSelectorRequirement{}
 				} else {
-					yyv1208 := &yyv1205[yyj1205]
-					yyv1208.CodecDecodeSelf(d)
+					yyv1275 := &yyv1272[yyj1272]
+					yyv1275.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13981,10 +14703,10 @@ func (x codecSelfer1234) decSlicePodSelectorRequirement(v *[]PodSelectorRequirem
 			}
 
 		}
-		yyh1205.End()
+		yyh1272.End()
 	}
-	if yyc1205 {
-		*v = yyv1205
+	if yyc1272 {
+		*v = yyv1272
 	}
 
 }
@@ -0,0 +1,66 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1_test
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	versioned "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// TestDeploymentPodSpecConversion tests that the fields handled by hand in
+// the PodSpec conversions are applied to pod templates in this group.
+func TestDeploymentPodSpecConversion(t *testing.T) {
+	name := "foo"
+	in := &extensions.DeploymentSpec{
+		Template: api.PodTemplateSpec{
+			Spec: api.PodSpec{
+				ServiceAccountName: name,
+				SecurityContext: &api.PodSecurityContext{
+					HostNetwork: true,
+					HostPID:     true,
+					HostIPC:     true,
+				},
+			},
+		},
+	}
+	out := versioned.DeploymentSpec{}
+	if err := api.Scheme.Convert(in, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	spec := out.Template.Spec
+	if spec.DeprecatedServiceAccount != name {
+		t.Errorf("want v1.DeprecatedServiceAccount %q, got %q", name, spec.DeprecatedServiceAccount)
+	}
+	if !spec.HostNetwork || !spec.HostPID || !spec.HostIPC {
+		t.Errorf("expected host namespace fields to be set: %#v", spec)
+	}
+
+	back := extensions.DeploymentSpec{}
+	if err := api.Scheme.Convert(&out, &back); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if back.Template.Spec.ServiceAccountName != name {
+		t.Errorf("want ServiceAccountName %q, got %q", name, back.Template.Spec.ServiceAccountName)
+	}
+	if !api.Semantic.DeepEqual(in.Template.Spec.SecurityContext, back.Template.Spec.SecurityContext) {
+		t.Errorf("diff: %v", util.ObjectGoPrintDiff(in.Template.Spec.SecurityContext, back.Template.Spec.SecurityContext))
+	}
+}
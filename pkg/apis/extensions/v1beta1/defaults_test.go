@@ -202,6 +202,73 @@ func TestSetDefaultDeployment(t *testing.T) {
 	}
 }
 
+func TestSetDefaultDeploymentSelector(t *testing.T) {
+	labels := map[string]string{"name": "nginx", "track": "stable"}
+	template := v1.PodTemplateSpec{
+		ObjectMeta: v1.ObjectMeta{
+			Labels: labels,
+		},
+	}
+	expressions := []PodSelectorRequirement{
+		{
+			Key:      "name",
+			Operator: PodSelectorOpIn,
+			Values:   []string{"nginx", "apache"},
+		},
+	}
+	tests := []struct {
+		original *Deployment
+		expected *PodSelector
+	}{
+		{
+			original: &Deployment{
+				Spec: DeploymentSpec{Template: template},
+			},
+			expected: &PodSelector{MatchLabels: labels},
+		},
+		{
+			// An empty selector is left for validation to reject.
+			original: &Deployment{
+				Spec: DeploymentSpec{Selector: &PodSelector{}, Template: template},
+			},
+			expected: &PodSelector{},
+		},
+		{
+			original: &Deployment{
+				Spec: DeploymentSpec{Selector: &PodSelector{MatchExpressions: expressions}, Template: template},
+			},
+			expected: &PodSelector{MatchExpressions: expressions},
+		},
+	}
+
+	for i, test := range tests {
+		obj2 := roundTrip(t, runtime.Object(test.original))
+		got, ok := obj2.(*Deployment)
+		if !ok {
+			t.Errorf("unexpected object: %v", got)
+			t.FailNow()
+		}
+		if !reflect.DeepEqual(got.Spec.Selector, test.expected) {
+			t.Errorf("[%d] expected selector %+v, got %+v", i, test.expected, got.Spec.Selector)
+		}
+	}
+
+	// Deployments written with the old map-based selector must not have the
+	// template labels substituted for their selector.
+	legacy := []byte(`{"kind":"Deployment","apiVersion":"extensions/v1beta1","spec":{"selector":{"name":"nginx"},"template":{"metadata":{"labels":{"name":"nginx","track":"stable"}}}}}`)
+	obj, err := Codec.Decode(legacy)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := &Deployment{}
+	if err := api.Scheme.Convert(obj, got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := (&PodSelector{}), got.Spec.Selector; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected selector %+v, got %+v", e, a)
+	}
+}
+
 func TestSetDefaultJob(t *testing.T) {
 	expected := &Job{
 		Spec: JobSpec{
@@ -4294,15 +4294,17 @@ func (x *DeploymentSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 		} else {
 			yysep387 := !z.EncBinary()
 			yy2arr387 := z.EncBasicHandle().StructToArray
-			var yyq387 [5]bool
+			var yyq387 [7]bool
 			_, _, _ = yysep387, yyq387, yy2arr387
 			const yyr387 bool = false
 			yyq387[0] = x.Replicas != nil
-			yyq387[1] = len(x.Selector) != 0
+			yyq387[1] = x.Selector != nil
 			yyq387[3] = true
 			yyq387[4] = x.UniqueLabelKey != nil
+			yyq387[5] = x.Paused != false
+			yyq387[6] = x.ProgressDeadlineSeconds != nil
 			if yyr387 || yy2arr387 {
-				r.EncodeArrayStart(5)
+				r.EncodeArrayStart(7)
 			} else {
 				var yynn387 int = 1
 				for _, b := range yyq387 {
@@ -4349,12 +4351,7 @@ func (x *DeploymentSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
-						yym394 := z.EncBinary()
-						_ = yym394
-						if false {
-						} else {
-							z.F.EncMapStringStringV(x.Selector, false, e)
-						}
+						x.Selector.CodecEncodeSelf(e)
 					}
 				} else {
 					r.EncodeNil()
@@ -4365,39 +4362,51 @@ func (x *DeploymentSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
-						yym395 := z.EncBinary()
-						_ = yym395
-						if false {
-						} else {
-							z.F.EncMapStringStringV(x.Selector, false, e)
-						}
+						x.Selector.CodecEncodeSelf(e)
 					}
 				}
 			}
 			if yyr387 || yy2arr387 {
-				yy397 := &x.Template
-				yy397.CodecEncodeSelf(e)
+				yy395 := &x.Template
+				yy395.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("template"))
-				yy398 := &x.Template
-				yy398.CodecEncodeSelf(e)
+				yy396 := &x.Template
+				yy396.CodecEncodeSelf(e)
 			}
 			if yyr387 || yy2arr387 {
 				if yyq387[3] {
-					yy400 := &x.Strategy
-					yy400.CodecEncodeSelf(e)
+					yy398 := &x.Strategy
+					yy398.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
 				if yyq387[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("strategy"))
-					yy401 := &x.Strategy
-					yy401.CodecEncodeSelf(e)
+					yy399 := &x.Strategy
+					yy399.CodecEncodeSelf(e)
 				}
 			}
 			if yyr387 || yy2arr387 {
 				if yyq387[4] {
+					if x.UniqueLabelKey == nil {
+						r.EncodeNil()
+					} else {
+						yy401 := *x.UniqueLabelKey
+						yym402 := z.EncBinary()
+						_ = yym402
+						if false {
+						} else {
+							r.EncodeString(codecSelferC_UTF81234, string(yy401))
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq387[4] {
+					r.EncodeString(codecSelferC_UTF81234, string("uniqueLabelKey"))
 					if x.UniqueLabelKey == nil {
 						r.EncodeNil()
 					} else {
@@ -4409,21 +4418,58 @@ func (x *DeploymentSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 							r.EncodeString(codecSelferC_UTF81234, string(yy403))
 						}
 					}
+				}
+			}
+			if yyr387 || yy2arr387 {
+				if yyq387[5] {
+					yym406 := z.EncBinary()
+					_ = yym406
+					if false {
+					} else {
+						r.EncodeBool(bool(x.Paused))
+					}
+				} else {
+					r.EncodeBool(false)
+				}
+			} else {
+				if yyq387[5] {
+					r.EncodeString(codecSelferC_UTF81234, string("paused"))
+					yym407 := z.EncBinary()
+					_ = yym407
+					if false {
+					} else {
+						r.EncodeBool(bool(x.Paused))
+					}
+				}
+			}
+			if yyr387 || yy2arr387 {
+				if yyq387[6] {
+					if x.ProgressDeadlineSeconds == nil {
+						r.EncodeNil()
+					} else {
+						yy409 := *x.ProgressDeadlineSeconds
+						yym410 := z.EncBinary()
+						_ = yym410
+						if false {
+						} else {
+							r.EncodeInt(int64(yy409))
+						}
+					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq387[4] {
-					r.EncodeString(codecSelferC_UTF81234, string("uniqueLabelKey"))
-					if x.UniqueLabelKey == nil {
+				if yyq387[6] {
+					r.EncodeString(codecSelferC_UTF81234, string("progressDeadlineSeconds"))
+					if x.ProgressDeadlineSeconds == nil {
 						r.EncodeNil()
 					} else {
-						yy405 := *x.UniqueLabelKey
-						yym406 := z.EncBinary()
-						_ = yym406
+						yy411 := *x.ProgressDeadlineSeconds
+						yym412 := z.EncBinary()
+						_ = yym412
 						if false {
 						} else {
-							r.EncodeString(codecSelferC_UTF81234, string(yy405))
+							r.EncodeInt(int64(yy411))
 						}
 					}
 				}
@@ -4439,24 +4485,24 @@ func (x *DeploymentSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym407 := z.DecBinary()
-	_ = yym407
+	yym413 := z.DecBinary()
+	_ = yym413
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl408 := r.ReadMapStart()
-			if yyl408 == 0 {
+			yyl414 := r.ReadMapStart()
+			if yyl414 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl408, d)
+				x.codecDecodeSelfFromMap(yyl414, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl408 := r.ReadArrayStart()
-			if yyl408 == 0 {
+			yyl414 := r.ReadArrayStart()
+			if yyl414 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl408, d)
+				x.codecDecodeSelfFromArray(yyl414, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -4468,12 +4514,12 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys409Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys409Slc
-	var yyhl409 bool = l >= 0
-	for yyj409 := 0; ; yyj409++ {
-		if yyhl409 {
-			if yyj409 >= l {
+	var yys415Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys415Slc
+	var yyhl415 bool = l >= 0
+	for yyj415 := 0; ; yyj415++ {
+		if yyhl415 {
+			if yyj415 >= l {
 				break
 			}
 		} else {
@@ -4481,9 +4527,9 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys409Slc = r.DecodeBytes(yys409Slc, true, true)
-		yys409 := string(yys409Slc)
-		switch yys409 {
+		yys415Slc = r.DecodeBytes(yys415Slc, true, true)
+		yys415 := string(yys415Slc)
+		switch yys415 {
 		case "replicas":
 			if r.TryDecodeAsNil() {
 				if x.Replicas != nil {
@@ -4493,8 +4539,8 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.Replicas == nil {
 					x.Replicas = new(int)
 				}
-				yym411 := z.DecBinary()
-				_ = yym411
+				yym417 := z.DecBinary()
+				_ = yym417
 				if false {
 				} else {
 					*((*int)(x.Replicas)) = int(r.DecodeInt(codecSelferBitsize1234))
@@ -4502,29 +4548,28 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			}
 		case "selector":
 			if r.TryDecodeAsNil() {
-				x.Selector = nil
+				if x.Selector != nil {
+					x.Selector = nil
+				}
 			} else {
-				yyv412 := &x.Selector
-				yym413 := z.DecBinary()
-				_ = yym413
-				if false {
-				} else {
-					z.F.DecMapStringStringX(yyv412, false, d)
+				if x.Selector == nil {
+					x.Selector = new(PodSelector)
 				}
+				x.Selector.CodecDecodeSelf(d)
 			}
 		case "template":
 			if r.TryDecodeAsNil() {
 				x.Template = pkg2_v1.PodTemplateSpec{}
 			} else {
-				yyv414 := &x.Template
-				yyv414.CodecDecodeSelf(d)
+				yyv419 := &x.Template
+				yyv419.CodecDecodeSelf(d)
 			}
 		case "strategy":
 			if r.TryDecodeAsNil() {
 				x.Strategy = DeploymentStrategy{}
 			} else {
-				yyv415 := &x.Strategy
-				yyv415.CodecDecodeSelf(d)
+				yyv420 := &x.Strategy
+				yyv420.CodecDecodeSelf(d)
 			}
 		case "uniqueLabelKey":
 			if r.TryDecodeAsNil() {
@@ -4535,18 +4580,40 @@ func (x *DeploymentSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.UniqueLabelKey == nil {
 					x.UniqueLabelKey = new(string)
 				}
-				yym417 := z.DecBinary()
-				_ = yym417
+				yym422 := z.DecBinary()
+				_ = yym422
 				if false {
 				} else {
 					*((*string)(x.UniqueLabelKey)) = r.DecodeString()
 				}
 			}
+		case "paused":
+			if r.TryDecodeAsNil() {
+				x.Paused = false
+			} else {
+				x.Paused = bool(r.DecodeBool())
+			}
+		case "progressDeadlineSeconds":
+			if r.TryDecodeAsNil() {
+				if x.ProgressDeadlineSeconds != nil {
+					x.ProgressDeadlineSeconds = nil
+				}
+			} else {
+				if x.ProgressDeadlineSeconds == nil {
+					x.ProgressDeadlineSeconds = new(int)
+				}
+				yym425 := z.DecBinary()
+				_ = yym425
+				if false {
+				} else {
+					*((*int)(x.ProgressDeadlineSeconds)) = int(r.DecodeInt(codecSelferBitsize1234))
+				}
+			}
 		default:
-			z.DecStructFieldNotFound(-1, yys409)
-		} // end switch yys409
-	} // end for yyj409
-	if !yyhl409 {
+			z.DecStructFieldNotFound(-1, yys415)
+		} // end switch yys415
+	} // end for yyj415
+	if !yyhl415 {
 		r.ReadEnd()
 	}
 }
@@ -4555,16 +4622,16 @@ func (x *DeploymentSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj418 int
-	var yyb418 bool
-	var yyhl418 bool = l >= 0
-	yyj418++
-	if yyhl418 {
-		yyb418 = yyj418 > l
+	var yyj426 int
+	var yyb426 bool
+	var yyhl426 bool = l >= 0
+	yyj426++
+	if yyhl426 {
+		yyb426 = yyj426 > l
 	} else {
-		yyb418 = r.CheckBreak()
+		yyb426 = r.CheckBreak()
 	}
-	if yyb418 {
+	if yyb426 {
 		r.ReadEnd()
 		return
 	}
@@ -4576,73 +4643,72 @@ func (x *DeploymentSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.Replicas == nil {
 			x.Replicas = new(int)
 		}
-		yym420 := z.DecBinary()
-		_ = yym420
+		yym428 := z.DecBinary()
+		_ = yym428
 		if false {
 		} else {
 			*((*int)(x.Replicas)) = int(r.DecodeInt(codecSelferBitsize1234))
 		}
 	}
-	yyj418++
-	if yyhl418 {
-		yyb418 = yyj418 > l
+	yyj426++
+	if yyhl426 {
+		yyb426 = yyj426 > l
 	} else {
-		yyb418 = r.CheckBreak()
+		yyb426 = r.CheckBreak()
 	}
-	if yyb418 {
+	if yyb426 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
-		x.Selector = nil
+		if x.Selector != nil {
+			x.Selector = nil
+		}
 	} else {
-		yyv421 := &x.Selector
-		yym422 := z.DecBinary()
-		_ = yym422
-		if false {
-		} else {
-			z.F.DecMapStringStringX(yyv421, false, d)
+		if x.Selector == nil {
+			x.Selector = new(PodSelector)
 		}
+		x.Selector.CodecDecodeSelf(d)
 	}
-	yyj418++
-	if yyhl418 {
-		yyb418 = yyj418 > l
+	yyj426++
+	if yyhl426 {
+		yyb426 = yyj426 > l
 	} else {
-		yyb418 = r.CheckBreak()
+		yyb426 = r.CheckBreak()
 	}
-	if yyb418 {
+	if yyb426 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Template = pkg2_v1.PodTemplateSpec{}
 	} else {
-		yyv423 := &x.Template
-		yyv423.CodecDecodeSelf(d)
+		yyv430 := &x.Template
+		yyv430.CodecDecodeSelf(d)
 	}
-	yyj418++
-	if yyhl418 {
-		yyb418 = yyj418 > l
+	yyj426++
+	if yyhl426 {
+		yyb426 = yyj426 > l
 	} else {
-		yyb418 = r.CheckBreak()
+		yyb426 = r.CheckBreak()
 	}
-	if yyb418 {
+	if yyb426 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Strategy = DeploymentStrategy{}
 	} else {
-		yyv424 := &x.Strategy
-		yyv424.CodecDecodeSelf(d)
+		yyv431 := &x.Strategy
+		yyv431.CodecDecodeSelf(d)
 	}
-	yyj418++
-	if yyhl418 {
-		yyb418 = yyj418 > l
+	yyj426++
+	if yyhl426 {
+		yyb426 = yyj426 > l
 	} else {
-		yyb418 = r.CheckBreak()
+		yyb426 = r.CheckBreak()
 	}
-	if yyb418 {
+	if yyb426 {
 		r.ReadEnd()
 		return
 	}
@@ -4654,24 +4720,64 @@ func (x *DeploymentSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.UniqueLabelKey == nil {
 			x.UniqueLabelKey = new(string)
 		}
-		yym426 := z.DecBinary()
-		_ = yym426
+		yym433 := z.DecBinary()
+		_ = yym433
 		if false {
 		} else {
 			*((*string)(x.UniqueLabelKey)) = r.DecodeString()
 		}
 	}
+	yyj426++
+	if yyhl426 {
+		yyb426 = yyj426 > l
+	} else {
+		yyb426 = r.CheckBreak()
+	}
+	if yyb426 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Paused = false
+	} else {
+		x.Paused = bool(r.DecodeBool())
+	}
+	yyj426++
+	if yyhl426 {
+		yyb426 = yyj426 > l
+	} else {
+		yyb426 = r.CheckBreak()
+	}
+	if yyb426 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		if x.ProgressDeadlineSeconds != nil {
+			x.ProgressDeadlineSeconds = nil
+		}
+	} else {
+		if x.ProgressDeadlineSeconds == nil {
+			x.ProgressDeadlineSeconds = new(int)
+		}
+		yym436 := z.DecBinary()
+		_ = yym436
+		if false {
+		} else {
+			*((*int)(x.ProgressDeadlineSeconds)) = int(r.DecodeInt(codecSelferBitsize1234))
+		}
+	}
 	for {
-		yyj418++
-		if yyhl418 {
-			yyb418 = yyj418 > l
+		yyj426++
+		if yyhl426 {
+			yyb426 = yyj426 > l
 		} else {
-			yyb418 = r.CheckBreak()
+			yyb426 = r.CheckBreak()
 		}
-		if yyb418 {
+		if yyb426 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj418-1, "")
+		z.DecStructFieldNotFound(yyj426-1, "")
 	}
 	r.ReadEnd()
 }
@@ -4683,43 +4789,43 @@ func (x *DeploymentStrategy) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym427 := z.EncBinary()
-		_ = yym427
+		yym437 := z.EncBinary()
+		_ = yym437
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep428 := !z.EncBinary()
-			yy2arr428 := z.EncBasicHandle().StructToArray
-			var yyq428 [2]bool
-			_, _, _ = yysep428, yyq428, yy2arr428
-			const yyr428 bool = false
-			yyq428[0] = x.Type != ""
-			yyq428[1] = x.RollingUpdate != nil
-			if yyr428 || yy2arr428 {
+			yysep438 := !z.EncBinary()
+			yy2arr438 := z.EncBasicHandle().StructToArray
+			var yyq438 [2]bool
+			_, _, _ = yysep438, yyq438, yy2arr438
+			const yyr438 bool = false
+			yyq438[0] = x.Type != ""
+			yyq438[1] = x.RollingUpdate != nil
+			if yyr438 || yy2arr438 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn428 int = 0
-				for _, b := range yyq428 {
+				var yynn438 int = 0
+				for _, b := range yyq438 {
 					if b {
-						yynn428++
+						yynn438++
 					}
 				}
-				r.EncodeMapStart(yynn428)
+				r.EncodeMapStart(yynn438)
 			}
-			if yyr428 || yy2arr428 {
-				if yyq428[0] {
+			if yyr438 || yy2arr438 {
+				if yyq438[0] {
 					x.Type.CodecEncodeSelf(e)
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq428[0] {
+				if yyq438[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("type"))
 					x.Type.CodecEncodeSelf(e)
 				}
 			}
-			if yyr428 || yy2arr428 {
-				if yyq428[1] {
+			if yyr438 || yy2arr438 {
+				if yyq438[1] {
 					if x.RollingUpdate == nil {
 						r.EncodeNil()
 					} else {
@@ -4729,7 +4835,7 @@ func (x *DeploymentStrategy) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq428[1] {
+				if yyq438[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("rollingUpdate"))
 					if x.RollingUpdate == nil {
 						r.EncodeNil()
@@ -4738,7 +4844,7 @@ func (x *DeploymentStrategy) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep428 {
+			if yysep438 {
 				r.EncodeEnd()
 			}
 		}
@@ -4749,24 +4855,24 @@ func (x *DeploymentStrategy) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym431 := z.DecBinary()
-	_ = yym431
+	yym441 := z.DecBinary()
+	_ = yym441
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl432 := r.ReadMapStart()
-			if yyl432 == 0 {
+			yyl442 := r.ReadMapStart()
+			if yyl442 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl432, d)
+				x.codecDecodeSelfFromMap(yyl442, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl432 := r.ReadArrayStart()
-			if yyl432 == 0 {
+			yyl442 := r.ReadArrayStart()
+			if yyl442 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl432, d)
+				x.codecDecodeSelfFromArray(yyl442, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -4778,12 +4884,12 @@ func (x *DeploymentStrategy) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys433Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys433Slc
-	var yyhl433 bool = l >= 0
-	for yyj433 := 0; ; yyj433++ {
-		if yyhl433 {
-			if yyj433 >= l {
+	var yys443Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys443Slc
+	var yyhl443 bool = l >= 0
+	for yyj443 := 0; ; yyj443++ {
+		if yyhl443 {
+			if yyj443 >= l {
 				break
 			}
 		} else {
@@ -4791,9 +4897,9 @@ func (x *DeploymentStrategy) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 				break
 			}
 		}
-		yys433Slc = r.DecodeBytes(yys433Slc, true, true)
-		yys433 := string(yys433Slc)
-		switch yys433 {
+		yys443Slc = r.DecodeBytes(yys443Slc, true, true)
+		yys443 := string(yys443Slc)
+		switch yys443 {
 		case "type":
 			if r.TryDecodeAsNil() {
 				x.Type = ""
@@ -4812,10 +4918,10 @@ func (x *DeploymentStrategy) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 				x.RollingUpdate.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys433)
-		} // end switch yys433
-	} // end for yyj433
-	if !yyhl433 {
+			z.DecStructFieldNotFound(-1, yys443)
+		} // end switch yys443
+	} // end for yyj443
+	if !yyhl443 {
 		r.ReadEnd()
 	}
 }
@@ -4824,16 +4930,16 @@ func (x *DeploymentStrategy) codecDecodeSelfFromArray(l int, d *codec1978.Decode
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj436 int
-	var yyb436 bool
-	var yyhl436 bool = l >= 0
-	yyj436++
-	if yyhl436 {
-		yyb436 = yyj436 > l
+	var yyj446 int
+	var yyb446 bool
+	var yyhl446 bool = l >= 0
+	yyj446++
+	if yyhl446 {
+		yyb446 = yyj446 > l
 	} else {
-		yyb436 = r.CheckBreak()
+		yyb446 = r.CheckBreak()
 	}
-	if yyb436 {
+	if yyb446 {
 		r.ReadEnd()
 		return
 	}
@@ -4842,13 +4948,13 @@ func (x *DeploymentStrategy) codecDecodeSelfFromArray(l int, d *codec1978.Decode
 	} else {
 		x.Type = DeploymentStrategyType(r.DecodeString())
 	}
-	yyj436++
-	if yyhl436 {
-		yyb436 = yyj436 > l
+	yyj446++
+	if yyhl446 {
+		yyb446 = yyj446 > l
 	} else {
-		yyb436 = r.CheckBreak()
+		yyb446 = r.CheckBreak()
 	}
-	if yyb436 {
+	if yyb446 {
 		r.ReadEnd()
 		return
 	}
@@ -4863,16 +4969,16 @@ func (x *DeploymentStrategy) codecDecodeSelfFromArray(l int, d *codec1978.Decode
 		x.RollingUpdate.CodecDecodeSelf(d)
 	}
 	for {
-		yyj436++
-		if yyhl436 {
-			yyb436 = yyj436 > l
+		yyj446++
+		if yyhl446 {
+			yyb446 = yyj446 > l
 		} else {
-			yyb436 = r.CheckBreak()
+			yyb446 = r.CheckBreak()
 		}
-		if yyb436 {
+		if yyb446 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj436-1, "")
+		z.DecStructFieldNotFound(yyj446-1, "")
 	}
 	r.ReadEnd()
 }
@@ -4881,8 +4987,8 @@ func (x DeploymentStrategyType) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	yym439 := z.EncBinary()
-	_ = yym439
+	yym449 := z.EncBinary()
+	_ = yym449
 	if false {
 	} else if z.HasExtensions() && z.EncExt(x) {
 	} else {
@@ -4894,8 +5000,8 @@ func (x *DeploymentStrategyType) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym440 := z.DecBinary()
-	_ = yym440
+	yym450 := z.DecBinary()
+	_ = yym450
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
@@ -4910,40 +5016,40 @@ func (x *RollingUpdateDeployment) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym441 := z.EncBinary()
-		_ = yym441
+		yym451 := z.EncBinary()
+		_ = yym451
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep442 := !z.EncBinary()
-			yy2arr442 := z.EncBasicHandle().StructToArray
-			var yyq442 [3]bool
-			_, _, _ = yysep442, yyq442, yy2arr442
-			const yyr442 bool = false
-			yyq442[0] = x.MaxUnavailable != nil
-			yyq442[1] = x.MaxSurge != nil
-			yyq442[2] = x.MinReadySeconds != 0
-			if yyr442 || yy2arr442 {
+			yysep452 := !z.EncBinary()
+			yy2arr452 := z.EncBasicHandle().StructToArray
+			var yyq452 [3]bool
+			_, _, _ = yysep452, yyq452, yy2arr452
+			const yyr452 bool = false
+			yyq452[0] = x.MaxUnavailable != nil
+			yyq452[1] = x.MaxSurge != nil
+			yyq452[2] = x.MinReadySeconds != 0
+			if yyr452 || yy2arr452 {
 				r.EncodeArrayStart(3)
 			} else {
-				var yynn442 int = 0
-				for _, b := range yyq442 {
+				var yynn452 int = 0
+				for _, b := range yyq452 {
 					if b {
-						yynn442++
+						yynn452++
 					}
 				}
-				r.EncodeMapStart(yynn442)
+				r.EncodeMapStart(yynn452)
 			}
-			if yyr442 || yy2arr442 {
-				if yyq442[0] {
+			if yyr452 || yy2arr452 {
+				if yyq452[0] {
 					if x.MaxUnavailable == nil {
 						r.EncodeNil()
 					} else {
-						yym444 := z.EncBinary()
-						_ = yym444
+						yym454 := z.EncBinary()
+						_ = yym454
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.MaxUnavailable) {
-						} else if !yym444 && z.IsJSONHandle() {
+						} else if !yym454 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.MaxUnavailable)
 						} else {
 							z.EncFallback(x.MaxUnavailable)
@@ -4953,16 +5059,16 @@ func (x *RollingUpdateDeployment) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq442[0] {
+				if yyq452[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("maxUnavailable"))
 					if x.MaxUnavailable == nil {
 						r.EncodeNil()
 					} else {
-						yym445 := z.EncBinary()
-						_ = yym445
+						yym455 := z.EncBinary()
+						_ = yym455
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.MaxUnavailable) {
-						} else if !yym445 && z.IsJSONHandle() {
+						} else if !yym455 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.MaxUnavailable)
 						} else {
 							z.EncFallback(x.MaxUnavailable)
@@ -4970,16 +5076,16 @@ func (x *RollingUpdateDeployment) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr442 || yy2arr442 {
-				if yyq442[1] {
+			if yyr452 || yy2arr452 {
+				if yyq452[1] {
 					if x.MaxSurge == nil {
 						r.EncodeNil()
 					} else {
-						yym447 := z.EncBinary()
-						_ = yym447
+						yym457 := z.EncBinary()
+						_ = yym457
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.MaxSurge) {
-						} else if !yym447 && z.IsJSONHandle() {
+						} else if !yym457 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.MaxSurge)
 						} else {
 							z.EncFallback(x.MaxSurge)
@@ -4989,16 +5095,16 @@ func (x *RollingUpdateDeployment) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq442[1] {
+				if yyq452[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("maxSurge"))
 					if x.MaxSurge == nil {
 						r.EncodeNil()
 					} else {
-						yym448 := z.EncBinary()
-						_ = yym448
+						yym458 := z.EncBinary()
+						_ = yym458
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.MaxSurge) {
-						} else if !yym448 && z.IsJSONHandle() {
+						} else if !yym458 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.MaxSurge)
 						} else {
 							z.EncFallback(x.MaxSurge)
@@ -5006,10 +5112,10 @@ func (x *RollingUpdateDeployment) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr442 || yy2arr442 {
-				if yyq442[2] {
-					yym450 := z.EncBinary()
-					_ = yym450
+			if yyr452 || yy2arr452 {
+				if yyq452[2] {
+					yym460 := z.EncBinary()
+					_ = yym460
 					if false {
 					} else {
 						r.EncodeInt(int64(x.MinReadySeconds))
@@ -5018,17 +5124,17 @@ func (x *RollingUpdateDeployment) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq442[2] {
+				if yyq452[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("minReadySeconds"))
-					yym451 := z.EncBinary()
-					_ = yym451
+					yym461 := z.EncBinary()
+					_ = yym461
 					if false {
 					} else {
 						r.EncodeInt(int64(x.MinReadySeconds))
 					}
 				}
 			}
-			if yysep442 {
+			if yysep452 {
 				r.EncodeEnd()
 			}
 		}
@@ -5039,24 +5145,24 @@ func (x *RollingUpdateDeployment) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym452 := z.DecBinary()
-	_ = yym452
+	yym462 := z.DecBinary()
+	_ = yym462
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl453 := r.ReadMapStart()
-			if yyl453 == 0 {
+			yyl463 := r.ReadMapStart()
+			if yyl463 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl453, d)
+				x.codecDecodeSelfFromMap(yyl463, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl453 := r.ReadArrayStart()
-			if yyl453 == 0 {
+			yyl463 := r.ReadArrayStart()
+			if yyl463 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl453, d)
+				x.codecDecodeSelfFromArray(yyl463, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -5068,12 +5174,12 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromMap(l int, d *codec1978.Dec
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys454Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys454Slc
-	var yyhl454 bool = l >= 0
-	for yyj454 := 0; ; yyj454++ {
-		if yyhl454 {
-			if yyj454 >= l {
+	var yys464Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys464Slc
+	var yyhl464 bool = l >= 0
+	for yyj464 := 0; ; yyj464++ {
+		if yyhl464 {
+			if yyj464 >= l {
 				break
 			}
 		} else {
@@ -5081,9 +5187,9 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromMap(l int, d *codec1978.Dec
 				break
 			}
 		}
-		yys454Slc = r.DecodeBytes(yys454Slc, true, true)
-		yys454 := string(yys454Slc)
-		switch yys454 {
+		yys464Slc = r.DecodeBytes(yys464Slc, true, true)
+		yys464 := string(yys464Slc)
+		switch yys464 {
 		case "maxUnavailable":
 			if r.TryDecodeAsNil() {
 				if x.MaxUnavailable != nil {
@@ -5093,11 +5199,11 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromMap(l int, d *codec1978.Dec
 				if x.MaxUnavailable == nil {
 					x.MaxUnavailable = new(pkg6_util.IntOrString)
 				}
-				yym456 := z.DecBinary()
-				_ = yym456
+				yym466 := z.DecBinary()
+				_ = yym466
 				if false {
 				} else if z.HasExtensions() && z.DecExt(x.MaxUnavailable) {
-				} else if !yym456 && z.IsJSONHandle() {
+				} else if !yym466 && z.IsJSONHandle() {
 					z.DecJSONUnmarshal(x.MaxUnavailable)
 				} else {
 					z.DecFallback(x.MaxUnavailable, false)
@@ -5112,11 +5218,11 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromMap(l int, d *codec1978.Dec
 				if x.MaxSurge == nil {
 					x.MaxSurge = new(pkg6_util.IntOrString)
 				}
-				yym458 := z.DecBinary()
-				_ = yym458
+				yym468 := z.DecBinary()
+				_ = yym468
 				if false {
 				} else if z.HasExtensions() && z.DecExt(x.MaxSurge) {
-				} else if !yym458 && z.IsJSONHandle() {
+				} else if !yym468 && z.IsJSONHandle() {
 					z.DecJSONUnmarshal(x.MaxSurge)
 				} else {
 					z.DecFallback(x.MaxSurge, false)
@@ -5129,10 +5235,10 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromMap(l int, d *codec1978.Dec
 				x.MinReadySeconds = int(r.DecodeInt(codecSelferBitsize1234))
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys454)
-		} // end switch yys454
-	} // end for yyj454
-	if !yyhl454 {
+			z.DecStructFieldNotFound(-1, yys464)
+		} // end switch yys464
+	} // end for yyj464
+	if !yyhl464 {
 		r.ReadEnd()
 	}
 }
@@ -5141,16 +5247,16 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromArray(l int, d *codec1978.D
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj460 int
-	var yyb460 bool
-	var yyhl460 bool = l >= 0
-	yyj460++
-	if yyhl460 {
-		yyb460 = yyj460 > l
+	var yyj470 int
+	var yyb470 bool
+	var yyhl470 bool = l >= 0
+	yyj470++
+	if yyhl470 {
+		yyb470 = yyj470 > l
 	} else {
-		yyb460 = r.CheckBreak()
+		yyb470 = r.CheckBreak()
 	}
-	if yyb460 {
+	if yyb470 {
 		r.ReadEnd()
 		return
 	}
@@ -5162,23 +5268,23 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromArray(l int, d *codec1978.D
 		if x.MaxUnavailable == nil {
 			x.MaxUnavailable = new(pkg6_util.IntOrString)
 		}
-		yym462 := z.DecBinary()
-		_ = yym462
+		yym472 := z.DecBinary()
+		_ = yym472
 		if false {
 		} else if z.HasExtensions() && z.DecExt(x.MaxUnavailable) {
-		} else if !yym462 && z.IsJSONHandle() {
+		} else if !yym472 && z.IsJSONHandle() {
 			z.DecJSONUnmarshal(x.MaxUnavailable)
 		} else {
 			z.DecFallback(x.MaxUnavailable, false)
 		}
 	}
-	yyj460++
-	if yyhl460 {
-		yyb460 = yyj460 > l
+	yyj470++
+	if yyhl470 {
+		yyb470 = yyj470 > l
 	} else {
-		yyb460 = r.CheckBreak()
+		yyb470 = r.CheckBreak()
 	}
-	if yyb460 {
+	if yyb470 {
 		r.ReadEnd()
 		return
 	}
@@ -5190,23 +5296,23 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromArray(l int, d *codec1978.D
 		if x.MaxSurge == nil {
 			x.MaxSurge = new(pkg6_util.IntOrString)
 		}
-		yym464 := z.DecBinary()
-		_ = yym464
+		yym474 := z.DecBinary()
+		_ = yym474
 		if false {
 		} else if z.HasExtensions() && z.DecExt(x.MaxSurge) {
-		} else if !yym464 && z.IsJSONHandle() {
+		} else if !yym474 && z.IsJSONHandle() {
 			z.DecJSONUnmarshal(x.MaxSurge)
 		} else {
 			z.DecFallback(x.MaxSurge, false)
 		}
 	}
-	yyj460++
-	if yyhl460 {
-		yyb460 = yyj460 > l
+	yyj470++
+	if yyhl470 {
+		yyb470 = yyj470 > l
 	} else {
-		yyb460 = r.CheckBreak()
+		yyb470 = r.CheckBreak()
 	}
-	if yyb460 {
+	if yyb470 {
 		r.ReadEnd()
 		return
 	}
@@ -5216,16 +5322,16 @@ func (x *RollingUpdateDeployment) codecDecodeSelfFromArray(l int, d *codec1978.D
 		x.MinReadySeconds = int(r.DecodeInt(codecSelferBitsize1234))
 	}
 	for {
-		yyj460++
-		if yyhl460 {
-			yyb460 = yyj460 > l
+		yyj470++
+		if yyhl470 {
+			yyb470 = yyj470 > l
 		} else {
-			yyb460 = r.CheckBreak()
+			yyb470 = r.CheckBreak()
 		}
-		if yyb460 {
+		if yyb470 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj460-1, "")
+		z.DecStructFieldNotFound(yyj470-1, "")
 	}
 	r.ReadEnd()
 }
@@ -5237,33 +5343,34 @@ func (x *DeploymentStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym466 := z.EncBinary()
-		_ = yym466
+		yym476 := z.EncBinary()
+		_ = yym476
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep467 := !z.EncBinary()
-			yy2arr467 := z.EncBasicHandle().StructToArray
-			var yyq467 [2]bool
-			_, _, _ = yysep467, yyq467, yy2arr467
-			const yyr467 bool = false
-			yyq467[0] = x.Replicas != 0
-			yyq467[1] = x.UpdatedReplicas != 0
-			if yyr467 || yy2arr467 {
-				r.EncodeArrayStart(2)
+			yysep477 := !z.EncBinary()
+			yy2arr477 := z.EncBasicHandle().StructToArray
+			var yyq477 [3]bool
+			_, _, _ = yysep477, yyq477, yy2arr477
+			const yyr477 bool = false
+			yyq477[0] = x.Replicas != 0
+			yyq477[1] = x.UpdatedReplicas != 0
+			yyq477[2] = len(x.Conditions) != 0
+			if yyr477 || yy2arr477 {
+				r.EncodeArrayStart(3)
 			} else {
-				var yynn467 int = 0
-				for _, b := range yyq467 {
+				var yynn477 int = 0
+				for _, b := range yyq477 {
 					if b {
-						yynn467++
+						yynn477++
 					}
 				}
-				r.EncodeMapStart(yynn467)
+				r.EncodeMapStart(yynn477)
 			}
-			if yyr467 || yy2arr467 {
-				if yyq467[0] {
-					yym469 := z.EncBinary()
-					_ = yym469
+			if yyr477 || yy2arr477 {
+				if yyq477[0] {
+					yym479 := z.EncBinary()
+					_ = yym479
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Replicas))
@@ -5272,67 +5379,453 @@ func (x *DeploymentStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq467[0] {
+				if yyq477[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("replicas"))
-					yym470 := z.EncBinary()
-					_ = yym470
+					yym480 := z.EncBinary()
+					_ = yym480
+					if false {
+					} else {
+						r.EncodeInt(int64(x.Replicas))
+					}
+				}
+			}
+			if yyr477 || yy2arr477 {
+				if yyq477[1] {
+					yym482 := z.EncBinary()
+					_ = yym482
+					if false {
+					} else {
+						r.EncodeInt(int64(x.UpdatedReplicas))
+					}
+				} else {
+					r.EncodeInt(0)
+				}
+			} else {
+				if yyq477[1] {
+					r.EncodeString(codecSelferC_UTF81234, string("updatedReplicas"))
+					yym483 := z.EncBinary()
+					_ = yym483
+					if false {
+					} else {
+						r.EncodeInt(int64(x.UpdatedReplicas))
+					}
+				}
+			}
+			if yyr477 || yy2arr477 {
+				if yyq477[2] {
+					if x.Conditions == nil {
+						r.EncodeNil()
+					} else {
+						yym485 := z.EncBinary()
+						_ = yym485
+						if false {
+						} else {
+							h.encSliceDeploymentCondition(([]DeploymentCondition)(x.Conditions), e)
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq477[2] {
+					r.EncodeString(codecSelferC_UTF81234, string("conditions"))
+					if x.Conditions == nil {
+						r.EncodeNil()
+					} else {
+						yym486 := z.EncBinary()
+						_ = yym486
+						if false {
+						} else {
+							h.encSliceDeploymentCondition(([]DeploymentCondition)(x.Conditions), e)
+						}
+					}
+				}
+			}
+			if yysep477 {
+				r.EncodeEnd()
+			}
+		}
+	}
+}
+
+func (x *DeploymentStatus) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym487 := z.DecBinary()
+	_ = yym487
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		if r.IsContainerType(codecSelferValueTypeMap1234) {
+			yyl488 := r.ReadMapStart()
+			if yyl488 == 0 {
+				r.ReadEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl488, d)
+			}
+		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
+			yyl488 := r.ReadArrayStart()
+			if yyl488 == 0 {
+				r.ReadEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl488, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *DeploymentStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys489Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys489Slc
+	var yyhl489 bool = l >= 0
+	for yyj489 := 0; ; yyj489++ {
+		if yyhl489 {
+			if yyj489 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		yys489Slc = r.DecodeBytes(yys489Slc, true, true)
+		yys489 := string(yys489Slc)
+		switch yys489 {
+		case "replicas":
+			if r.TryDecodeAsNil() {
+				x.Replicas = 0
+			} else {
+				x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
+			}
+		case "updatedReplicas":
+			if r.TryDecodeAsNil() {
+				x.UpdatedReplicas = 0
+			} else {
+				x.UpdatedReplicas = int(r.DecodeInt(codecSelferBitsize1234))
+			}
+		case "conditions":
+			if r.TryDecodeAsNil() {
+				x.Conditions = nil
+			} else {
+				yyv492 := &x.Conditions
+				yym493 := z.DecBinary()
+				_ = yym493
+				if false {
+				} else {
+					h.decSliceDeploymentCondition((*[]DeploymentCondition)(yyv492), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys489)
+		} // end switch yys489
+	} // end for yyj489
+	if !yyhl489 {
+		r.ReadEnd()
+	}
+}
+
+func (x *DeploymentStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj494 int
+	var yyb494 bool
+	var yyhl494 bool = l >= 0
+	yyj494++
+	if yyhl494 {
+		yyb494 = yyj494 > l
+	} else {
+		yyb494 = r.CheckBreak()
+	}
+	if yyb494 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Replicas = 0
+	} else {
+		x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
+	}
+	yyj494++
+	if yyhl494 {
+		yyb494 = yyj494 > l
+	} else {
+		yyb494 = r.CheckBreak()
+	}
+	if yyb494 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.UpdatedReplicas = 0
+	} else {
+		x.UpdatedReplicas = int(r.DecodeInt(codecSelferBitsize1234))
+	}
+	yyj494++
+	if yyhl494 {
+		yyb494 = yyj494 > l
+	} else {
+		yyb494 = r.CheckBreak()
+	}
+	if yyb494 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Conditions = nil
+	} else {
+		yyv497 := &x.Conditions
+		yym498 := z.DecBinary()
+		_ = yym498
+		if false {
+		} else {
+			h.decSliceDeploymentCondition((*[]DeploymentCondition)(yyv497), d)
+		}
+	}
+	for {
+		yyj494++
+		if yyhl494 {
+			yyb494 = yyj494 > l
+		} else {
+			yyb494 = r.CheckBreak()
+		}
+		if yyb494 {
+			break
+		}
+		z.DecStructFieldNotFound(yyj494-1, "")
+	}
+	r.ReadEnd()
+}
+
+func (x DeploymentConditionType) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	yym499 := z.EncBinary()
+	_ = yym499
+	if false {
+	} else if z.HasExtensions() && z.EncExt(x) {
+	} else {
+		r.EncodeString(codecSelferC_UTF81234, string(x))
+	}
+}
+
+func (x *DeploymentConditionType) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym500 := z.DecBinary()
+	_ = yym500
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		*((*string)(x)) = r.DecodeString()
+	}
+}
+
+func (x *DeploymentCondition) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym501 := z.EncBinary()
+		_ = yym501
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep502 := !z.EncBinary()
+			yy2arr502 := z.EncBasicHandle().StructToArray
+			var yyq502 [6]bool
+			_, _, _ = yysep502, yyq502, yy2arr502
+			const yyr502 bool = false
+			yyq502[2] = true
+			yyq502[3] = true
+			yyq502[4] = x.Reason != ""
+			yyq502[5] = x.Message != ""
+			if yyr502 || yy2arr502 {
+				r.EncodeArrayStart(6)
+			} else {
+				var yynn502 int = 2
+				for _, b := range yyq502 {
+					if b {
+						yynn502++
+					}
+				}
+				r.EncodeMapStart(yynn502)
+			}
+			if yyr502 || yy2arr502 {
+				x.Type.CodecEncodeSelf(e)
+			} else {
+				r.EncodeString(codecSelferC_UTF81234, string("type"))
+				x.Type.CodecEncodeSelf(e)
+			}
+			if yyr502 || yy2arr502 {
+				yym505 := z.EncBinary()
+				_ = yym505
+				if false {
+				} else if z.HasExtensions() && z.EncExt(x.Status) {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Status))
+				}
+			} else {
+				r.EncodeString(codecSelferC_UTF81234, string("status"))
+				yym506 := z.EncBinary()
+				_ = yym506
+				if false {
+				} else if z.HasExtensions() && z.EncExt(x.Status) {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Status))
+				}
+			}
+			if yyr502 || yy2arr502 {
+				if yyq502[2] {
+					yy508 := &x.LastUpdateTime
+					yym509 := z.EncBinary()
+					_ = yym509
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy508) {
+					} else if yym509 {
+						z.EncBinaryMarshal(yy508)
+					} else if !yym509 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy508)
+					} else {
+						z.EncFallback(yy508)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq502[2] {
+					r.EncodeString(codecSelferC_UTF81234, string("lastUpdateTime"))
+					yy510 := &x.LastUpdateTime
+					yym511 := z.EncBinary()
+					_ = yym511
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy510) {
+					} else if yym511 {
+						z.EncBinaryMarshal(yy510)
+					} else if !yym511 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy510)
+					} else {
+						z.EncFallback(yy510)
+					}
+				}
+			}
+			if yyr502 || yy2arr502 {
+				if yyq502[3] {
+					yy513 := &x.LastTransitionTime
+					yym514 := z.EncBinary()
+					_ = yym514
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy513) {
+					} else if yym514 {
+						z.EncBinaryMarshal(yy513)
+					} else if !yym514 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy513)
+					} else {
+						z.EncFallback(yy513)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq502[3] {
+					r.EncodeString(codecSelferC_UTF81234, string("lastTransitionTime"))
+					yy515 := &x.LastTransitionTime
+					yym516 := z.EncBinary()
+					_ = yym516
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy515) {
+					} else if yym516 {
+						z.EncBinaryMarshal(yy515)
+					} else if !yym516 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy515)
+					} else {
+						z.EncFallback(yy515)
+					}
+				}
+			}
+			if yyr502 || yy2arr502 {
+				if yyq502[4] {
+					yym518 := z.EncBinary()
+					_ = yym518
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Reason))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq502[4] {
+					r.EncodeString(codecSelferC_UTF81234, string("reason"))
+					yym519 := z.EncBinary()
+					_ = yym519
 					if false {
 					} else {
-						r.EncodeInt(int64(x.Replicas))
+						r.EncodeString(codecSelferC_UTF81234, string(x.Reason))
 					}
 				}
 			}
-			if yyr467 || yy2arr467 {
-				if yyq467[1] {
-					yym472 := z.EncBinary()
-					_ = yym472
+			if yyr502 || yy2arr502 {
+				if yyq502[5] {
+					yym521 := z.EncBinary()
+					_ = yym521
 					if false {
 					} else {
-						r.EncodeInt(int64(x.UpdatedReplicas))
+						r.EncodeString(codecSelferC_UTF81234, string(x.Message))
 					}
 				} else {
-					r.EncodeInt(0)
+					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq467[1] {
-					r.EncodeString(codecSelferC_UTF81234, string("updatedReplicas"))
-					yym473 := z.EncBinary()
-					_ = yym473
+				if yyq502[5] {
+					r.EncodeString(codecSelferC_UTF81234, string("message"))
+					yym522 := z.EncBinary()
+					_ = yym522
 					if false {
 					} else {
-						r.EncodeInt(int64(x.UpdatedReplicas))
+						r.EncodeString(codecSelferC_UTF81234, string(x.Message))
 					}
 				}
 			}
-			if yysep467 {
+			if yysep502 {
 				r.EncodeEnd()
 			}
 		}
 	}
 }
 
-func (x *DeploymentStatus) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *DeploymentCondition) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym474 := z.DecBinary()
-	_ = yym474
+	yym523 := z.DecBinary()
+	_ = yym523
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl475 := r.ReadMapStart()
-			if yyl475 == 0 {
+			yyl524 := r.ReadMapStart()
+			if yyl524 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl475, d)
+				x.codecDecodeSelfFromMap(yyl524, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl475 := r.ReadArrayStart()
-			if yyl475 == 0 {
+			yyl524 := r.ReadArrayStart()
+			if yyl524 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl475, d)
+				x.codecDecodeSelfFromArray(yyl524, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -5340,16 +5833,16 @@ func (x *DeploymentStatus) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *DeploymentStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *DeploymentCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys476Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys476Slc
-	var yyhl476 bool = l >= 0
-	for yyj476 := 0; ; yyj476++ {
-		if yyhl476 {
-			if yyj476 >= l {
+	var yys525Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys525Slc
+	var yyhl525 bool = l >= 0
+	for yyj525 := 0; ; yyj525++ {
+		if yyhl525 {
+			if yyj525 >= l {
 				break
 			}
 		} else {
@@ -5357,78 +5850,206 @@ func (x *DeploymentStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys476Slc = r.DecodeBytes(yys476Slc, true, true)
-		yys476 := string(yys476Slc)
-		switch yys476 {
-		case "replicas":
+		yys525Slc = r.DecodeBytes(yys525Slc, true, true)
+		yys525 := string(yys525Slc)
+		switch yys525 {
+		case "type":
 			if r.TryDecodeAsNil() {
-				x.Replicas = 0
+				x.Type = ""
 			} else {
-				x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
+				x.Type = DeploymentConditionType(r.DecodeString())
 			}
-		case "updatedReplicas":
+		case "status":
 			if r.TryDecodeAsNil() {
-				x.UpdatedReplicas = 0
+				x.Status = ""
 			} else {
-				x.UpdatedReplicas = int(r.DecodeInt(codecSelferBitsize1234))
+				x.Status = pkg2_v1.ConditionStatus(r.DecodeString())
+			}
+		case "lastUpdateTime":
+			if r.TryDecodeAsNil() {
+				x.LastUpdateTime = pkg1_unversioned.Time{}
+			} else {
+				yyv528 := &x.LastUpdateTime
+				yym529 := z.DecBinary()
+				_ = yym529
+				if false {
+				} else if z.HasExtensions() && z.DecExt(yyv528) {
+				} else if yym529 {
+					z.DecBinaryUnmarshal(yyv528)
+				} else if !yym529 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv528)
+				} else {
+					z.DecFallback(yyv528, false)
+				}
+			}
+		case "lastTransitionTime":
+			if r.TryDecodeAsNil() {
+				x.LastTransitionTime = pkg1_unversioned.Time{}
+			} else {
+				yyv530 := &x.LastTransitionTime
+				yym531 := z.DecBinary()
+				_ = yym531
+				if false {
+				} else if z.HasExtensions() && z.DecExt(yyv530) {
+				} else if yym531 {
+					z.DecBinaryUnmarshal(yyv530)
+				} else if !yym531 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv530)
+				} else {
+					z.DecFallback(yyv530, false)
+				}
+			}
+		case "reason":
+			if r.TryDecodeAsNil() {
+				x.Reason = ""
+			} else {
+				x.Reason = string(r.DecodeString())
+			}
+		case "message":
+			if r.TryDecodeAsNil() {
+				x.Message = ""
+			} else {
+				x.Message = string(r.DecodeString())
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys476)
-		} // end switch yys476
-	} // end for yyj476
-	if !yyhl476 {
+			z.DecStructFieldNotFound(-1, yys525)
+		} // end switch yys525
+	} // end for yyj525
+	if !yyhl525 {
 		r.ReadEnd()
 	}
 }
 
-func (x *DeploymentStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *DeploymentCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj479 int
-	var yyb479 bool
-	var yyhl479 bool = l >= 0
-	yyj479++
-	if yyhl479 {
-		yyb479 = yyj479 > l
+	var yyj534 int
+	var yyb534 bool
+	var yyhl534 bool = l >= 0
+	yyj534++
+	if yyhl534 {
+		yyb534 = yyj534 > l
 	} else {
-		yyb479 = r.CheckBreak()
+		yyb534 = r.CheckBreak()
 	}
-	if yyb479 {
+	if yyb534 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
-		x.Replicas = 0
+		x.Type = ""
 	} else {
-		x.Replicas = int(r.DecodeInt(codecSelferBitsize1234))
+		x.Type = DeploymentConditionType(r.DecodeString())
 	}
-	yyj479++
-	if yyhl479 {
-		yyb479 = yyj479 > l
+	yyj534++
+	if yyhl534 {
+		yyb534 = yyj534 > l
 	} else {
-		yyb479 = r.CheckBreak()
+		yyb534 = r.CheckBreak()
 	}
-	if yyb479 {
+	if yyb534 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
-		x.UpdatedReplicas = 0
+		x.Status = ""
 	} else {
-		x.UpdatedReplicas = int(r.DecodeInt(codecSelferBitsize1234))
+		x.Status = pkg2_v1.ConditionStatus(r.DecodeString())
+	}
+	yyj534++
+	if yyhl534 {
+		yyb534 = yyj534 > l
+	} else {
+		yyb534 = r.CheckBreak()
+	}
+	if yyb534 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.LastUpdateTime = pkg1_unversioned.Time{}
+	} else {
+		yyv537 := &x.LastUpdateTime
+		yym538 := z.DecBinary()
+		_ = yym538
+		if false {
+		} else if z.HasExtensions() && z.DecExt(yyv537) {
+		} else if yym538 {
+			z.DecBinaryUnmarshal(yyv537)
+		} else if !yym538 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv537)
+		} else {
+			z.DecFallback(yyv537, false)
+		}
+	}
+	yyj534++
+	if yyhl534 {
+		yyb534 = yyj534 > l
+	} else {
+		yyb534 = r.CheckBreak()
+	}
+	if yyb534 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.LastTransitionTime = pkg1_unversioned.Time{}
+	} else {
+		yyv539 := &x.LastTransitionTime
+		yym540 := z.DecBinary()
+		_ = yym540
+		if false {
+		} else if z.HasExtensions() && z.DecExt(yyv539) {
+		} else if yym540 {
+			z.DecBinaryUnmarshal(yyv539)
+		} else if !yym540 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv539)
+		} else {
+			z.DecFallback(yyv539, false)
+		}
+	}
+	yyj534++
+	if yyhl534 {
+		yyb534 = yyj534 > l
+	} else {
+		yyb534 = r.CheckBreak()
+	}
+	if yyb534 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Reason = ""
+	} else {
+		x.Reason = string(r.DecodeString())
+	}
+	yyj534++
+	if yyhl534 {
+		yyb534 = yyj534 > l
+	} else {
+		yyb534 = r.CheckBreak()
+	}
+	if yyb534 {
+		r.ReadEnd()
+		return
+	}
+	if r.TryDecodeAsNil() {
+		x.Message = ""
+	} else {
+		x.Message = string(r.DecodeString())
 	}
 	for {
-		yyj479++
-		if yyhl479 {
-			yyb479 = yyj479 > l
+		yyj534++
+		if yyhl534 {
+			yyb534 = yyj534 > l
 		} else {
-			yyb479 = r.CheckBreak()
+			yyb534 = r.CheckBreak()
 		}
-		if yyb479 {
+		if yyb534 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj479-1, "")
+		z.DecStructFieldNotFound(yyj534-1, "")
 	}
 	r.ReadEnd()
 }
@@ -5440,34 +6061,34 @@ func (x *DeploymentList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym482 := z.EncBinary()
-		_ = yym482
+		yym543 := z.EncBinary()
+		_ = yym543
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep483 := !z.EncBinary()
-			yy2arr483 := z.EncBasicHandle().StructToArray
-			var yyq483 [4]bool
-			_, _, _ = yysep483, yyq483, yy2arr483
-			const yyr483 bool = false
-			yyq483[0] = x.Kind != ""
-			yyq483[1] = x.APIVersion != ""
-			yyq483[2] = true
-			if yyr483 || yy2arr483 {
+			yysep544 := !z.EncBinary()
+			yy2arr544 := z.EncBasicHandle().StructToArray
+			var yyq544 [4]bool
+			_, _, _ = yysep544, yyq544, yy2arr544
+			const yyr544 bool = false
+			yyq544[0] = x.Kind != ""
+			yyq544[1] = x.APIVersion != ""
+			yyq544[2] = true
+			if yyr544 || yy2arr544 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn483 int = 1
-				for _, b := range yyq483 {
+				var yynn544 int = 1
+				for _, b := range yyq544 {
 					if b {
-						yynn483++
+						yynn544++
 					}
 				}
-				r.EncodeMapStart(yynn483)
+				r.EncodeMapStart(yynn544)
 			}
-			if yyr483 || yy2arr483 {
-				if yyq483[0] {
-					yym485 := z.EncBinary()
-					_ = yym485
+			if yyr544 || yy2arr544 {
+				if yyq544[0] {
+					yym546 := z.EncBinary()
+					_ = yym546
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -5476,20 +6097,20 @@ func (x *DeploymentList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq483[0] {
+				if yyq544[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym486 := z.EncBinary()
-					_ = yym486
+					yym547 := z.EncBinary()
+					_ = yym547
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr483 || yy2arr483 {
-				if yyq483[1] {
-					yym488 := z.EncBinary()
-					_ = yym488
+			if yyr544 || yy2arr544 {
+				if yyq544[1] {
+					yym549 := z.EncBinary()
+					_ = yym549
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -5498,48 +6119,48 @@ func (x *DeploymentList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq483[1] {
+				if yyq544[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym489 := z.EncBinary()
-					_ = yym489
+					yym550 := z.EncBinary()
+					_ = yym550
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr483 || yy2arr483 {
-				if yyq483[2] {
-					yy491 := &x.ListMeta
-					yym492 := z.EncBinary()
-					_ = yym492
+			if yyr544 || yy2arr544 {
+				if yyq544[2] {
+					yy552 := &x.ListMeta
+					yym553 := z.EncBinary()
+					_ = yym553
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy491) {
+					} else if z.HasExtensions() && z.EncExt(yy552) {
 					} else {
-						z.EncFallback(yy491)
+						z.EncFallback(yy552)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq483[2] {
+				if yyq544[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy493 := &x.ListMeta
-					yym494 := z.EncBinary()
-					_ = yym494
+					yy554 := &x.ListMeta
+					yym555 := z.EncBinary()
+					_ = yym555
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy493) {
+					} else if z.HasExtensions() && z.EncExt(yy554) {
 					} else {
-						z.EncFallback(yy493)
+						z.EncFallback(yy554)
 					}
 				}
 			}
-			if yyr483 || yy2arr483 {
+			if yyr544 || yy2arr544 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym496 := z.EncBinary()
-					_ = yym496
+					yym557 := z.EncBinary()
+					_ = yym557
 					if false {
 					} else {
 						h.encSliceDeployment(([]Deployment)(x.Items), e)
@@ -5550,15 +6171,15 @@ func (x *DeploymentList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym497 := z.EncBinary()
-					_ = yym497
+					yym558 := z.EncBinary()
+					_ = yym558
 					if false {
 					} else {
 						h.encSliceDeployment(([]Deployment)(x.Items), e)
 					}
 				}
 			}
-			if yysep483 {
+			if yysep544 {
 				r.EncodeEnd()
 			}
 		}
@@ -5569,24 +6190,24 @@ func (x *DeploymentList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym498 := z.DecBinary()
-	_ = yym498
+	yym559 := z.DecBinary()
+	_ = yym559
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl499 := r.ReadMapStart()
-			if yyl499 == 0 {
+			yyl560 := r.ReadMapStart()
+			if yyl560 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl499, d)
+				x.codecDecodeSelfFromMap(yyl560, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl499 := r.ReadArrayStart()
-			if yyl499 == 0 {
+			yyl560 := r.ReadArrayStart()
+			if yyl560 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl499, d)
+				x.codecDecodeSelfFromArray(yyl560, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -5598,12 +6219,12 @@ func (x *DeploymentList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys500Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys500Slc
-	var yyhl500 bool = l >= 0
-	for yyj500 := 0; ; yyj500++ {
-		if yyhl500 {
-			if yyj500 >= l {
+	var yys561Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys561Slc
+	var yyhl561 bool = l >= 0
+	for yyj561 := 0; ; yyj561++ {
+		if yyhl561 {
+			if yyj561 >= l {
 				break
 			}
 		} else {
@@ -5611,9 +6232,9 @@ func (x *DeploymentList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys500Slc = r.DecodeBytes(yys500Slc, true, true)
-		yys500 := string(yys500Slc)
-		switch yys500 {
+		yys561Slc = r.DecodeBytes(yys561Slc, true, true)
+		yys561 := string(yys561Slc)
+		switch yys561 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -5630,32 +6251,32 @@ func (x *DeploymentList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv503 := &x.ListMeta
-				yym504 := z.DecBinary()
-				_ = yym504
+				yyv564 := &x.ListMeta
+				yym565 := z.DecBinary()
+				_ = yym565
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv503) {
+				} else if z.HasExtensions() && z.DecExt(yyv564) {
 				} else {
-					z.DecFallback(yyv503, false)
+					z.DecFallback(yyv564, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv505 := &x.Items
-				yym506 := z.DecBinary()
-				_ = yym506
+				yyv566 := &x.Items
+				yym567 := z.DecBinary()
+				_ = yym567
 				if false {
 				} else {
-					h.decSliceDeployment((*[]Deployment)(yyv505), d)
+					h.decSliceDeployment((*[]Deployment)(yyv566), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys500)
-		} // end switch yys500
-	} // end for yyj500
-	if !yyhl500 {
+			z.DecStructFieldNotFound(-1, yys561)
+		} // end switch yys561
+	} // end for yyj561
+	if !yyhl561 {
 		r.ReadEnd()
 	}
 }
@@ -5664,16 +6285,16 @@ func (x *DeploymentList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj507 int
-	var yyb507 bool
-	var yyhl507 bool = l >= 0
-	yyj507++
-	if yyhl507 {
-		yyb507 = yyj507 > l
+	var yyj568 int
+	var yyb568 bool
+	var yyhl568 bool = l >= 0
+	yyj568++
+	if yyhl568 {
+		yyb568 = yyj568 > l
 	} else {
-		yyb507 = r.CheckBreak()
+		yyb568 = r.CheckBreak()
 	}
-	if yyb507 {
+	if yyb568 {
 		r.ReadEnd()
 		return
 	}
@@ -5682,13 +6303,13 @@ func (x *DeploymentList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj507++
-	if yyhl507 {
-		yyb507 = yyj507 > l
+	yyj568++
+	if yyhl568 {
+		yyb568 = yyj568 > l
 	} else {
-		yyb507 = r.CheckBreak()
+		yyb568 = r.CheckBreak()
 	}
-	if yyb507 {
+	if yyb568 {
 		r.ReadEnd()
 		return
 	}
@@ -5697,60 +6318,60 @@ func (x *DeploymentList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj507++
-	if yyhl507 {
-		yyb507 = yyj507 > l
+	yyj568++
+	if yyhl568 {
+		yyb568 = yyj568 > l
 	} else {
-		yyb507 = r.CheckBreak()
+		yyb568 = r.CheckBreak()
 	}
-	if yyb507 {
+	if yyb568 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv510 := &x.ListMeta
-		yym511 := z.DecBinary()
-		_ = yym511
+		yyv571 := &x.ListMeta
+		yym572 := z.DecBinary()
+		_ = yym572
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv510) {
+		} else if z.HasExtensions() && z.DecExt(yyv571) {
 		} else {
-			z.DecFallback(yyv510, false)
+			z.DecFallback(yyv571, false)
 		}
 	}
-	yyj507++
-	if yyhl507 {
-		yyb507 = yyj507 > l
+	yyj568++
+	if yyhl568 {
+		yyb568 = yyj568 > l
 	} else {
-		yyb507 = r.CheckBreak()
+		yyb568 = r.CheckBreak()
 	}
-	if yyb507 {
+	if yyb568 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv512 := &x.Items
-		yym513 := z.DecBinary()
-		_ = yym513
+		yyv573 := &x.Items
+		yym574 := z.DecBinary()
+		_ = yym574
 		if false {
 		} else {
-			h.decSliceDeployment((*[]Deployment)(yyv512), d)
+			h.decSliceDeployment((*[]Deployment)(yyv573), d)
 		}
 	}
 	for {
-		yyj507++
-		if yyhl507 {
-			yyb507 = yyj507 > l
+		yyj568++
+		if yyhl568 {
+			yyb568 = yyj568 > l
 		} else {
-			yyb507 = r.CheckBreak()
+			yyb568 = r.CheckBreak()
 		}
-		if yyb507 {
+		if yyb568 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj507-1, "")
+		z.DecStructFieldNotFound(yyj568-1, "")
 	}
 	r.ReadEnd()
 }
@@ -5762,36 +6383,36 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym514 := z.EncBinary()
-		_ = yym514
+		yym575 := z.EncBinary()
+		_ = yym575
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep515 := !z.EncBinary()
-			yy2arr515 := z.EncBasicHandle().StructToArray
-			var yyq515 [2]bool
-			_, _, _ = yysep515, yyq515, yy2arr515
-			const yyr515 bool = false
-			yyq515[0] = len(x.Selector) != 0
-			yyq515[1] = x.Template != nil
-			if yyr515 || yy2arr515 {
+			yysep576 := !z.EncBinary()
+			yy2arr576 := z.EncBasicHandle().StructToArray
+			var yyq576 [2]bool
+			_, _, _ = yysep576, yyq576, yy2arr576
+			const yyr576 bool = false
+			yyq576[0] = len(x.Selector) != 0
+			yyq576[1] = x.Template != nil
+			if yyr576 || yy2arr576 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn515 int = 0
-				for _, b := range yyq515 {
+				var yynn576 int = 0
+				for _, b := range yyq576 {
 					if b {
-						yynn515++
+						yynn576++
 					}
 				}
-				r.EncodeMapStart(yynn515)
+				r.EncodeMapStart(yynn576)
 			}
-			if yyr515 || yy2arr515 {
-				if yyq515[0] {
+			if yyr576 || yy2arr576 {
+				if yyq576[0] {
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
-						yym517 := z.EncBinary()
-						_ = yym517
+						yym578 := z.EncBinary()
+						_ = yym578
 						if false {
 						} else {
 							z.F.EncMapStringStringV(x.Selector, false, e)
@@ -5801,13 +6422,13 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq515[0] {
+				if yyq576[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("selector"))
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
-						yym518 := z.EncBinary()
-						_ = yym518
+						yym579 := z.EncBinary()
+						_ = yym579
 						if false {
 						} else {
 							z.F.EncMapStringStringV(x.Selector, false, e)
@@ -5815,8 +6436,8 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr515 || yy2arr515 {
-				if yyq515[1] {
+			if yyr576 || yy2arr576 {
+				if yyq576[1] {
 					if x.Template == nil {
 						r.EncodeNil()
 					} else {
@@ -5826,7 +6447,7 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq515[1] {
+				if yyq576[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("template"))
 					if x.Template == nil {
 						r.EncodeNil()
@@ -5835,7 +6456,7 @@ func (x *DaemonSetSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep515 {
+			if yysep576 {
 				r.EncodeEnd()
 			}
 		}
@@ -5846,24 +6467,24 @@ func (x *DaemonSetSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym520 := z.DecBinary()
-	_ = yym520
+	yym581 := z.DecBinary()
+	_ = yym581
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl521 := r.ReadMapStart()
-			if yyl521 == 0 {
+			yyl582 := r.ReadMapStart()
+			if yyl582 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl521, d)
+				x.codecDecodeSelfFromMap(yyl582, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl521 := r.ReadArrayStart()
-			if yyl521 == 0 {
+			yyl582 := r.ReadArrayStart()
+			if yyl582 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl521, d)
+				x.codecDecodeSelfFromArray(yyl582, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -5875,12 +6496,12 @@ func (x *DaemonSetSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys522Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys522Slc
-	var yyhl522 bool = l >= 0
-	for yyj522 := 0; ; yyj522++ {
-		if yyhl522 {
-			if yyj522 >= l {
+	var yys583Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys583Slc
+	var yyhl583 bool = l >= 0
+	for yyj583 := 0; ; yyj583++ {
+		if yyhl583 {
+			if yyj583 >= l {
 				break
 			}
 		} else {
@@ -5888,19 +6509,19 @@ func (x *DaemonSetSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys522Slc = r.DecodeBytes(yys522Slc, true, true)
-		yys522 := string(yys522Slc)
-		switch yys522 {
+		yys583Slc = r.DecodeBytes(yys583Slc, true, true)
+		yys583 := string(yys583Slc)
+		switch yys583 {
 		case "selector":
 			if r.TryDecodeAsNil() {
 				x.Selector = nil
 			} else {
-				yyv523 := &x.Selector
-				yym524 := z.DecBinary()
-				_ = yym524
+				yyv584 := &x.Selector
+				yym585 := z.DecBinary()
+				_ = yym585
 				if false {
 				} else {
-					z.F.DecMapStringStringX(yyv523, false, d)
+					z.F.DecMapStringStringX(yyv584, false, d)
 				}
 			}
 		case "template":
@@ -5915,10 +6536,10 @@ func (x *DaemonSetSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.Template.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys522)
-		} // end switch yys522
-	} // end for yyj522
-	if !yyhl522 {
+			z.DecStructFieldNotFound(-1, yys583)
+		} // end switch yys583
+	} // end for yyj583
+	if !yyhl583 {
 		r.ReadEnd()
 	}
 }
@@ -5927,37 +6548,37 @@ func (x *DaemonSetSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj526 int
-	var yyb526 bool
-	var yyhl526 bool = l >= 0
-	yyj526++
-	if yyhl526 {
-		yyb526 = yyj526 > l
+	var yyj587 int
+	var yyb587 bool
+	var yyhl587 bool = l >= 0
+	yyj587++
+	if yyhl587 {
+		yyb587 = yyj587 > l
 	} else {
-		yyb526 = r.CheckBreak()
+		yyb587 = r.CheckBreak()
 	}
-	if yyb526 {
+	if yyb587 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Selector = nil
 	} else {
-		yyv527 := &x.Selector
-		yym528 := z.DecBinary()
-		_ = yym528
+		yyv588 := &x.Selector
+		yym589 := z.DecBinary()
+		_ = yym589
 		if false {
 		} else {
-			z.F.DecMapStringStringX(yyv527, false, d)
+			z.F.DecMapStringStringX(yyv588, false, d)
 		}
 	}
-	yyj526++
-	if yyhl526 {
-		yyb526 = yyj526 > l
+	yyj587++
+	if yyhl587 {
+		yyb587 = yyj587 > l
 	} else {
-		yyb526 = r.CheckBreak()
+		yyb587 = r.CheckBreak()
 	}
-	if yyb526 {
+	if yyb587 {
 		r.ReadEnd()
 		return
 	}
@@ -5972,16 +6593,16 @@ func (x *DaemonSetSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		x.Template.CodecDecodeSelf(d)
 	}
 	for {
-		yyj526++
-		if yyhl526 {
-			yyb526 = yyj526 > l
+		yyj587++
+		if yyhl587 {
+			yyb587 = yyj587 > l
 		} else {
-			yyb526 = r.CheckBreak()
+			yyb587 = r.CheckBreak()
 		}
-		if yyb526 {
+		if yyb587 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj526-1, "")
+		z.DecStructFieldNotFound(yyj587-1, "")
 	}
 	r.ReadEnd()
 }
@@ -5993,76 +6614,76 @@ func (x *DaemonSetStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym530 := z.EncBinary()
-		_ = yym530
+		yym591 := z.EncBinary()
+		_ = yym591
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep531 := !z.EncBinary()
-			yy2arr531 := z.EncBasicHandle().StructToArray
-			var yyq531 [3]bool
-			_, _, _ = yysep531, yyq531, yy2arr531
-			const yyr531 bool = false
-			if yyr531 || yy2arr531 {
+			yysep592 := !z.EncBinary()
+			yy2arr592 := z.EncBasicHandle().StructToArray
+			var yyq592 [3]bool
+			_, _, _ = yysep592, yyq592, yy2arr592
+			const yyr592 bool = false
+			if yyr592 || yy2arr592 {
 				r.EncodeArrayStart(3)
 			} else {
-				var yynn531 int = 3
-				for _, b := range yyq531 {
+				var yynn592 int = 3
+				for _, b := range yyq592 {
 					if b {
-						yynn531++
+						yynn592++
 					}
 				}
-				r.EncodeMapStart(yynn531)
+				r.EncodeMapStart(yynn592)
 			}
-			if yyr531 || yy2arr531 {
-				yym533 := z.EncBinary()
-				_ = yym533
+			if yyr592 || yy2arr592 {
+				yym594 := z.EncBinary()
+				_ = yym594
 				if false {
 				} else {
 					r.EncodeInt(int64(x.CurrentNumberScheduled))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("currentNumberScheduled"))
-				yym534 := z.EncBinary()
-				_ = yym534
+				yym595 := z.EncBinary()
+				_ = yym595
 				if false {
 				} else {
 					r.EncodeInt(int64(x.CurrentNumberScheduled))
 				}
 			}
-			if yyr531 || yy2arr531 {
-				yym536 := z.EncBinary()
-				_ = yym536
+			if yyr592 || yy2arr592 {
+				yym597 := z.EncBinary()
+				_ = yym597
 				if false {
 				} else {
 					r.EncodeInt(int64(x.NumberMisscheduled))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("numberMisscheduled"))
-				yym537 := z.EncBinary()
-				_ = yym537
+				yym598 := z.EncBinary()
+				_ = yym598
 				if false {
 				} else {
 					r.EncodeInt(int64(x.NumberMisscheduled))
 				}
 			}
-			if yyr531 || yy2arr531 {
-				yym539 := z.EncBinary()
-				_ = yym539
+			if yyr592 || yy2arr592 {
+				yym600 := z.EncBinary()
+				_ = yym600
 				if false {
 				} else {
 					r.EncodeInt(int64(x.DesiredNumberScheduled))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("desiredNumberScheduled"))
-				yym540 := z.EncBinary()
-				_ = yym540
+				yym601 := z.EncBinary()
+				_ = yym601
 				if false {
 				} else {
 					r.EncodeInt(int64(x.DesiredNumberScheduled))
 				}
 			}
-			if yysep531 {
+			if yysep592 {
 				r.EncodeEnd()
 			}
 		}
@@ -6073,24 +6694,24 @@ func (x *DaemonSetStatus) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym541 := z.DecBinary()
-	_ = yym541
+	yym602 := z.DecBinary()
+	_ = yym602
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl542 := r.ReadMapStart()
-			if yyl542 == 0 {
+			yyl603 := r.ReadMapStart()
+			if yyl603 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl542, d)
+				x.codecDecodeSelfFromMap(yyl603, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl542 := r.ReadArrayStart()
-			if yyl542 == 0 {
+			yyl603 := r.ReadArrayStart()
+			if yyl603 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl542, d)
+				x.codecDecodeSelfFromArray(yyl603, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -6102,12 +6723,12 @@ func (x *DaemonSetStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys543Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys543Slc
-	var yyhl543 bool = l >= 0
-	for yyj543 := 0; ; yyj543++ {
-		if yyhl543 {
-			if yyj543 >= l {
+	var yys604Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys604Slc
+	var yyhl604 bool = l >= 0
+	for yyj604 := 0; ; yyj604++ {
+		if yyhl604 {
+			if yyj604 >= l {
 				break
 			}
 		} else {
@@ -6115,9 +6736,9 @@ func (x *DaemonSetStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys543Slc = r.DecodeBytes(yys543Slc, true, true)
-		yys543 := string(yys543Slc)
-		switch yys543 {
+		yys604Slc = r.DecodeBytes(yys604Slc, true, true)
+		yys604 := string(yys604Slc)
+		switch yys604 {
 		case "currentNumberScheduled":
 			if r.TryDecodeAsNil() {
 				x.CurrentNumberScheduled = 0
@@ -6137,10 +6758,10 @@ func (x *DaemonSetStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.DesiredNumberScheduled = int(r.DecodeInt(codecSelferBitsize1234))
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys543)
-		} // end switch yys543
-	} // end for yyj543
-	if !yyhl543 {
+			z.DecStructFieldNotFound(-1, yys604)
+		} // end switch yys604
+	} // end for yyj604
+	if !yyhl604 {
 		r.ReadEnd()
 	}
 }
@@ -6149,16 +6770,16 @@ func (x *DaemonSetStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj547 int
-	var yyb547 bool
-	var yyhl547 bool = l >= 0
-	yyj547++
-	if yyhl547 {
-		yyb547 = yyj547 > l
+	var yyj608 int
+	var yyb608 bool
+	var yyhl608 bool = l >= 0
+	yyj608++
+	if yyhl608 {
+		yyb608 = yyj608 > l
 	} else {
-		yyb547 = r.CheckBreak()
+		yyb608 = r.CheckBreak()
 	}
-	if yyb547 {
+	if yyb608 {
 		r.ReadEnd()
 		return
 	}
@@ -6167,13 +6788,13 @@ func (x *DaemonSetStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	} else {
 		x.CurrentNumberScheduled = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj547++
-	if yyhl547 {
-		yyb547 = yyj547 > l
+	yyj608++
+	if yyhl608 {
+		yyb608 = yyj608 > l
 	} else {
-		yyb547 = r.CheckBreak()
+		yyb608 = r.CheckBreak()
 	}
-	if yyb547 {
+	if yyb608 {
 		r.ReadEnd()
 		return
 	}
@@ -6182,13 +6803,13 @@ func (x *DaemonSetStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	} else {
 		x.NumberMisscheduled = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj547++
-	if yyhl547 {
-		yyb547 = yyj547 > l
+	yyj608++
+	if yyhl608 {
+		yyb608 = yyj608 > l
 	} else {
-		yyb547 = r.CheckBreak()
+		yyb608 = r.CheckBreak()
 	}
-	if yyb547 {
+	if yyb608 {
 		r.ReadEnd()
 		return
 	}
@@ -6198,16 +6819,16 @@ func (x *DaemonSetStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 		x.DesiredNumberScheduled = int(r.DecodeInt(codecSelferBitsize1234))
 	}
 	for {
-		yyj547++
-		if yyhl547 {
-			yyb547 = yyj547 > l
+		yyj608++
+		if yyhl608 {
+			yyb608 = yyj608 > l
 		} else {
-			yyb547 = r.CheckBreak()
+			yyb608 = r.CheckBreak()
 		}
-		if yyb547 {
+		if yyb608 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj547-1, "")
+		z.DecStructFieldNotFound(yyj608-1, "")
 	}
 	r.ReadEnd()
 }
@@ -6219,36 +6840,36 @@ func (x *DaemonSet) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym551 := z.EncBinary()
-		_ = yym551
+		yym612 := z.EncBinary()
+		_ = yym612
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep552 := !z.EncBinary()
-			yy2arr552 := z.EncBasicHandle().StructToArray
-			var yyq552 [5]bool
-			_, _, _ = yysep552, yyq552, yy2arr552
-			const yyr552 bool = false
-			yyq552[0] = x.Kind != ""
-			yyq552[1] = x.APIVersion != ""
-			yyq552[2] = true
-			yyq552[3] = true
-			yyq552[4] = true
-			if yyr552 || yy2arr552 {
+			yysep613 := !z.EncBinary()
+			yy2arr613 := z.EncBasicHandle().StructToArray
+			var yyq613 [5]bool
+			_, _, _ = yysep613, yyq613, yy2arr613
+			const yyr613 bool = false
+			yyq613[0] = x.Kind != ""
+			yyq613[1] = x.APIVersion != ""
+			yyq613[2] = true
+			yyq613[3] = true
+			yyq613[4] = true
+			if yyr613 || yy2arr613 {
 				r.EncodeArrayStart(5)
 			} else {
-				var yynn552 int = 0
-				for _, b := range yyq552 {
+				var yynn613 int = 0
+				for _, b := range yyq613 {
 					if b {
-						yynn552++
+						yynn613++
 					}
 				}
-				r.EncodeMapStart(yynn552)
+				r.EncodeMapStart(yynn613)
 			}
-			if yyr552 || yy2arr552 {
-				if yyq552[0] {
-					yym554 := z.EncBinary()
-					_ = yym554
+			if yyr613 || yy2arr613 {
+				if yyq613[0] {
+					yym615 := z.EncBinary()
+					_ = yym615
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -6257,20 +6878,20 @@ func (x *DaemonSet) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq552[0] {
+				if yyq613[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym555 := z.EncBinary()
-					_ = yym555
+					yym616 := z.EncBinary()
+					_ = yym616
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr552 || yy2arr552 {
-				if yyq552[1] {
-					yym557 := z.EncBinary()
-					_ = yym557
+			if yyr613 || yy2arr613 {
+				if yyq613[1] {
+					yym618 := z.EncBinary()
+					_ = yym618
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -6279,59 +6900,59 @@ func (x *DaemonSet) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq552[1] {
+				if yyq613[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym558 := z.EncBinary()
-					_ = yym558
+					yym619 := z.EncBinary()
+					_ = yym619
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr552 || yy2arr552 {
-				if yyq552[2] {
-					yy560 := &x.ObjectMeta
-					yy560.CodecEncodeSelf(e)
+			if yyr613 || yy2arr613 {
+				if yyq613[2] {
+					yy621 := &x.ObjectMeta
+					yy621.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq552[2] {
+				if yyq613[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy561 := &x.ObjectMeta
-					yy561.CodecEncodeSelf(e)
+					yy622 := &x.ObjectMeta
+					yy622.CodecEncodeSelf(e)
 				}
 			}
-			if yyr552 || yy2arr552 {
-				if yyq552[3] {
-					yy563 := &x.Spec
-					yy563.CodecEncodeSelf(e)
+			if yyr613 || yy2arr613 {
+				if yyq613[3] {
+					yy624 := &x.Spec
+					yy624.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq552[3] {
+				if yyq613[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("spec"))
-					yy564 := &x.Spec
-					yy564.CodecEncodeSelf(e)
+					yy625 := &x.Spec
+					yy625.CodecEncodeSelf(e)
 				}
 			}
-			if yyr552 || yy2arr552 {
-				if yyq552[4] {
-					yy566 := &x.Status
-					yy566.CodecEncodeSelf(e)
+			if yyr613 || yy2arr613 {
+				if yyq613[4] {
+					yy627 := &x.Status
+					yy627.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq552[4] {
+				if yyq613[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("status"))
-					yy567 := &x.Status
-					yy567.CodecEncodeSelf(e)
+					yy628 := &x.Status
+					yy628.CodecEncodeSelf(e)
 				}
 			}
-			if yysep552 {
+			if yysep613 {
 				r.EncodeEnd()
 			}
 		}
@@ -6342,24 +6963,24 @@ func (x *DaemonSet) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym568 := z.DecBinary()
-	_ = yym568
+	yym629 := z.DecBinary()
+	_ = yym629
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl569 := r.ReadMapStart()
-			if yyl569 == 0 {
+			yyl630 := r.ReadMapStart()
+			if yyl630 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl569, d)
+				x.codecDecodeSelfFromMap(yyl630, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl569 := r.ReadArrayStart()
-			if yyl569 == 0 {
+			yyl630 := r.ReadArrayStart()
+			if yyl630 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl569, d)
+				x.codecDecodeSelfFromArray(yyl630, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -6371,12 +6992,12 @@ func (x *DaemonSet) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys570Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys570Slc
-	var yyhl570 bool = l >= 0
-	for yyj570 := 0; ; yyj570++ {
-		if yyhl570 {
-			if yyj570 >= l {
+	var yys631Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys631Slc
+	var yyhl631 bool = l >= 0
+	for yyj631 := 0; ; yyj631++ {
+		if yyhl631 {
+			if yyj631 >= l {
 				break
 			}
 		} else {
@@ -6384,9 +7005,9 @@ func (x *DaemonSet) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys570Slc = r.DecodeBytes(yys570Slc, true, true)
-		yys570 := string(yys570Slc)
-		switch yys570 {
+		yys631Slc = r.DecodeBytes(yys631Slc, true, true)
+		yys631 := string(yys631Slc)
+		switch yys631 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -6403,28 +7024,28 @@ func (x *DaemonSet) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ObjectMeta = pkg2_v1.ObjectMeta{}
 			} else {
-				yyv573 := &x.ObjectMeta
-				yyv573.CodecDecodeSelf(d)
+				yyv634 := &x.ObjectMeta
+				yyv634.CodecDecodeSelf(d)
 			}
 		case "spec":
 			if r.TryDecodeAsNil() {
 				x.Spec = DaemonSetSpec{}
 			} else {
-				yyv574 := &x.Spec
-				yyv574.CodecDecodeSelf(d)
+				yyv635 := &x.Spec
+				yyv635.CodecDecodeSelf(d)
 			}
 		case "status":
 			if r.TryDecodeAsNil() {
 				x.Status = DaemonSetStatus{}
 			} else {
-				yyv575 := &x.Status
-				yyv575.CodecDecodeSelf(d)
+				yyv636 := &x.Status
+				yyv636.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys570)
-		} // end switch yys570
-	} // end for yyj570
-	if !yyhl570 {
+			z.DecStructFieldNotFound(-1, yys631)
+		} // end switch yys631
+	} // end for yyj631
+	if !yyhl631 {
 		r.ReadEnd()
 	}
 }
@@ -6433,16 +7054,16 @@ func (x *DaemonSet) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj576 int
-	var yyb576 bool
-	var yyhl576 bool = l >= 0
-	yyj576++
-	if yyhl576 {
-		yyb576 = yyj576 > l
+	var yyj637 int
+	var yyb637 bool
+	var yyhl637 bool = l >= 0
+	yyj637++
+	if yyhl637 {
+		yyb637 = yyj637 > l
 	} else {
-		yyb576 = r.CheckBreak()
+		yyb637 = r.CheckBreak()
 	}
-	if yyb576 {
+	if yyb637 {
 		r.ReadEnd()
 		return
 	}
@@ -6451,13 +7072,13 @@ func (x *DaemonSet) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj576++
-	if yyhl576 {
-		yyb576 = yyj576 > l
+	yyj637++
+	if yyhl637 {
+		yyb637 = yyj637 > l
 	} else {
-		yyb576 = r.CheckBreak()
+		yyb637 = r.CheckBreak()
 	}
-	if yyb576 {
+	if yyb637 {
 		r.ReadEnd()
 		return
 	}
@@ -6466,65 +7087,65 @@ func (x *DaemonSet) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj576++
-	if yyhl576 {
-		yyb576 = yyj576 > l
+	yyj637++
+	if yyhl637 {
+		yyb637 = yyj637 > l
 	} else {
-		yyb576 = r.CheckBreak()
+		yyb637 = r.CheckBreak()
 	}
-	if yyb576 {
+	if yyb637 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ObjectMeta = pkg2_v1.ObjectMeta{}
 	} else {
-		yyv579 := &x.ObjectMeta
-		yyv579.CodecDecodeSelf(d)
+		yyv640 := &x.ObjectMeta
+		yyv640.CodecDecodeSelf(d)
 	}
-	yyj576++
-	if yyhl576 {
-		yyb576 = yyj576 > l
+	yyj637++
+	if yyhl637 {
+		yyb637 = yyj637 > l
 	} else {
-		yyb576 = r.CheckBreak()
+		yyb637 = r.CheckBreak()
 	}
-	if yyb576 {
+	if yyb637 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Spec = DaemonSetSpec{}
 	} else {
-		yyv580 := &x.Spec
-		yyv580.CodecDecodeSelf(d)
+		yyv641 := &x.Spec
+		yyv641.CodecDecodeSelf(d)
 	}
-	yyj576++
-	if yyhl576 {
-		yyb576 = yyj576 > l
+	yyj637++
+	if yyhl637 {
+		yyb637 = yyj637 > l
 	} else {
-		yyb576 = r.CheckBreak()
+		yyb637 = r.CheckBreak()
 	}
-	if yyb576 {
+	if yyb637 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Status = DaemonSetStatus{}
 	} else {
-		yyv581 := &x.Status
-		yyv581.CodecDecodeSelf(d)
+		yyv642 := &x.Status
+		yyv642.CodecDecodeSelf(d)
 	}
 	for {
-		yyj576++
-		if yyhl576 {
-			yyb576 = yyj576 > l
+		yyj637++
+		if yyhl637 {
+			yyb637 = yyj637 > l
 		} else {
-			yyb576 = r.CheckBreak()
+			yyb637 = r.CheckBreak()
 		}
-		if yyb576 {
+		if yyb637 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj576-1, "")
+		z.DecStructFieldNotFound(yyj637-1, "")
 	}
 	r.ReadEnd()
 }
@@ -6536,34 +7157,34 @@ func (x *DaemonSetList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym582 := z.EncBinary()
-		_ = yym582
+		yym643 := z.EncBinary()
+		_ = yym643
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep583 := !z.EncBinary()
-			yy2arr583 := z.EncBasicHandle().StructToArray
-			var yyq583 [4]bool
-			_, _, _ = yysep583, yyq583, yy2arr583
-			const yyr583 bool = false
-			yyq583[0] = x.Kind != ""
-			yyq583[1] = x.APIVersion != ""
-			yyq583[2] = true
-			if yyr583 || yy2arr583 {
+			yysep644 := !z.EncBinary()
+			yy2arr644 := z.EncBasicHandle().StructToArray
+			var yyq644 [4]bool
+			_, _, _ = yysep644, yyq644, yy2arr644
+			const yyr644 bool = false
+			yyq644[0] = x.Kind != ""
+			yyq644[1] = x.APIVersion != ""
+			yyq644[2] = true
+			if yyr644 || yy2arr644 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn583 int = 1
-				for _, b := range yyq583 {
+				var yynn644 int = 1
+				for _, b := range yyq644 {
 					if b {
-						yynn583++
+						yynn644++
 					}
 				}
-				r.EncodeMapStart(yynn583)
+				r.EncodeMapStart(yynn644)
 			}
-			if yyr583 || yy2arr583 {
-				if yyq583[0] {
-					yym585 := z.EncBinary()
-					_ = yym585
+			if yyr644 || yy2arr644 {
+				if yyq644[0] {
+					yym646 := z.EncBinary()
+					_ = yym646
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -6572,20 +7193,20 @@ func (x *DaemonSetList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq583[0] {
+				if yyq644[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym586 := z.EncBinary()
-					_ = yym586
+					yym647 := z.EncBinary()
+					_ = yym647
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr583 || yy2arr583 {
-				if yyq583[1] {
-					yym588 := z.EncBinary()
-					_ = yym588
+			if yyr644 || yy2arr644 {
+				if yyq644[1] {
+					yym649 := z.EncBinary()
+					_ = yym649
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -6594,48 +7215,48 @@ func (x *DaemonSetList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq583[1] {
+				if yyq644[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym589 := z.EncBinary()
-					_ = yym589
+					yym650 := z.EncBinary()
+					_ = yym650
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr583 || yy2arr583 {
-				if yyq583[2] {
-					yy591 := &x.ListMeta
-					yym592 := z.EncBinary()
-					_ = yym592
+			if yyr644 || yy2arr644 {
+				if yyq644[2] {
+					yy652 := &x.ListMeta
+					yym653 := z.EncBinary()
+					_ = yym653
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy591) {
+					} else if z.HasExtensions() && z.EncExt(yy652) {
 					} else {
-						z.EncFallback(yy591)
+						z.EncFallback(yy652)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq583[2] {
+				if yyq644[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy593 := &x.ListMeta
-					yym594 := z.EncBinary()
-					_ = yym594
+					yy654 := &x.ListMeta
+					yym655 := z.EncBinary()
+					_ = yym655
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy593) {
+					} else if z.HasExtensions() && z.EncExt(yy654) {
 					} else {
-						z.EncFallback(yy593)
+						z.EncFallback(yy654)
 					}
 				}
 			}
-			if yyr583 || yy2arr583 {
+			if yyr644 || yy2arr644 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym596 := z.EncBinary()
-					_ = yym596
+					yym657 := z.EncBinary()
+					_ = yym657
 					if false {
 					} else {
 						h.encSliceDaemonSet(([]DaemonSet)(x.Items), e)
@@ -6646,15 +7267,15 @@ func (x *DaemonSetList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym597 := z.EncBinary()
-					_ = yym597
+					yym658 := z.EncBinary()
+					_ = yym658
 					if false {
 					} else {
 						h.encSliceDaemonSet(([]DaemonSet)(x.Items), e)
 					}
 				}
 			}
-			if yysep583 {
+			if yysep644 {
 				r.EncodeEnd()
 			}
 		}
@@ -6665,24 +7286,24 @@ func (x *DaemonSetList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym598 := z.DecBinary()
-	_ = yym598
+	yym659 := z.DecBinary()
+	_ = yym659
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl599 := r.ReadMapStart()
-			if yyl599 == 0 {
+			yyl660 := r.ReadMapStart()
+			if yyl660 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl599, d)
+				x.codecDecodeSelfFromMap(yyl660, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl599 := r.ReadArrayStart()
-			if yyl599 == 0 {
+			yyl660 := r.ReadArrayStart()
+			if yyl660 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl599, d)
+				x.codecDecodeSelfFromArray(yyl660, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -6694,12 +7315,12 @@ func (x *DaemonSetList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys600Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys600Slc
-	var yyhl600 bool = l >= 0
-	for yyj600 := 0; ; yyj600++ {
-		if yyhl600 {
-			if yyj600 >= l {
+	var yys661Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys661Slc
+	var yyhl661 bool = l >= 0
+	for yyj661 := 0; ; yyj661++ {
+		if yyhl661 {
+			if yyj661 >= l {
 				break
 			}
 		} else {
@@ -6707,9 +7328,9 @@ func (x *DaemonSetList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys600Slc = r.DecodeBytes(yys600Slc, true, true)
-		yys600 := string(yys600Slc)
-		switch yys600 {
+		yys661Slc = r.DecodeBytes(yys661Slc, true, true)
+		yys661 := string(yys661Slc)
+		switch yys661 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -6726,32 +7347,32 @@ func (x *DaemonSetList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv603 := &x.ListMeta
-				yym604 := z.DecBinary()
-				_ = yym604
+				yyv664 := &x.ListMeta
+				yym665 := z.DecBinary()
+				_ = yym665
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv603) {
+				} else if z.HasExtensions() && z.DecExt(yyv664) {
 				} else {
-					z.DecFallback(yyv603, false)
+					z.DecFallback(yyv664, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv605 := &x.Items
-				yym606 := z.DecBinary()
-				_ = yym606
+				yyv666 := &x.Items
+				yym667 := z.DecBinary()
+				_ = yym667
 				if false {
 				} else {
-					h.decSliceDaemonSet((*[]DaemonSet)(yyv605), d)
+					h.decSliceDaemonSet((*[]DaemonSet)(yyv666), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys600)
-		} // end switch yys600
-	} // end for yyj600
-	if !yyhl600 {
+			z.DecStructFieldNotFound(-1, yys661)
+		} // end switch yys661
+	} // end for yyj661
+	if !yyhl661 {
 		r.ReadEnd()
 	}
 }
@@ -6760,16 +7381,16 @@ func (x *DaemonSetList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj607 int
-	var yyb607 bool
-	var yyhl607 bool = l >= 0
-	yyj607++
-	if yyhl607 {
-		yyb607 = yyj607 > l
+	var yyj668 int
+	var yyb668 bool
+	var yyhl668 bool = l >= 0
+	yyj668++
+	if yyhl668 {
+		yyb668 = yyj668 > l
 	} else {
-		yyb607 = r.CheckBreak()
+		yyb668 = r.CheckBreak()
 	}
-	if yyb607 {
+	if yyb668 {
 		r.ReadEnd()
 		return
 	}
@@ -6778,13 +7399,13 @@ func (x *DaemonSetList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj607++
-	if yyhl607 {
-		yyb607 = yyj607 > l
+	yyj668++
+	if yyhl668 {
+		yyb668 = yyj668 > l
 	} else {
-		yyb607 = r.CheckBreak()
+		yyb668 = r.CheckBreak()
 	}
-	if yyb607 {
+	if yyb668 {
 		r.ReadEnd()
 		return
 	}
@@ -6793,60 +7414,60 @@ func (x *DaemonSetList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj607++
-	if yyhl607 {
-		yyb607 = yyj607 > l
+	yyj668++
+	if yyhl668 {
+		yyb668 = yyj668 > l
 	} else {
-		yyb607 = r.CheckBreak()
+		yyb668 = r.CheckBreak()
 	}
-	if yyb607 {
+	if yyb668 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv610 := &x.ListMeta
-		yym611 := z.DecBinary()
-		_ = yym611
+		yyv671 := &x.ListMeta
+		yym672 := z.DecBinary()
+		_ = yym672
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv610) {
+		} else if z.HasExtensions() && z.DecExt(yyv671) {
 		} else {
-			z.DecFallback(yyv610, false)
+			z.DecFallback(yyv671, false)
 		}
 	}
-	yyj607++
-	if yyhl607 {
-		yyb607 = yyj607 > l
+	yyj668++
+	if yyhl668 {
+		yyb668 = yyj668 > l
 	} else {
-		yyb607 = r.CheckBreak()
+		yyb668 = r.CheckBreak()
 	}
-	if yyb607 {
+	if yyb668 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv612 := &x.Items
-		yym613 := z.DecBinary()
-		_ = yym613
+		yyv673 := &x.Items
+		yym674 := z.DecBinary()
+		_ = yym674
 		if false {
 		} else {
-			h.decSliceDaemonSet((*[]DaemonSet)(yyv612), d)
+			h.decSliceDaemonSet((*[]DaemonSet)(yyv673), d)
 		}
 	}
 	for {
-		yyj607++
-		if yyhl607 {
-			yyb607 = yyj607 > l
+		yyj668++
+		if yyhl668 {
+			yyb668 = yyj668 > l
 		} else {
-			yyb607 = r.CheckBreak()
+			yyb668 = r.CheckBreak()
 		}
-		if yyb607 {
+		if yyb668 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj607-1, "")
+		z.DecStructFieldNotFound(yyj668-1, "")
 	}
 	r.ReadEnd()
 }
@@ -6858,34 +7479,34 @@ func (x *ThirdPartyResourceDataList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym614 := z.EncBinary()
-		_ = yym614
+		yym675 := z.EncBinary()
+		_ = yym675
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep615 := !z.EncBinary()
-			yy2arr615 := z.EncBasicHandle().StructToArray
-			var yyq615 [4]bool
-			_, _, _ = yysep615, yyq615, yy2arr615
-			const yyr615 bool = false
-			yyq615[0] = x.Kind != ""
-			yyq615[1] = x.APIVersion != ""
-			yyq615[2] = true
-			if yyr615 || yy2arr615 {
+			yysep676 := !z.EncBinary()
+			yy2arr676 := z.EncBasicHandle().StructToArray
+			var yyq676 [4]bool
+			_, _, _ = yysep676, yyq676, yy2arr676
+			const yyr676 bool = false
+			yyq676[0] = x.Kind != ""
+			yyq676[1] = x.APIVersion != ""
+			yyq676[2] = true
+			if yyr676 || yy2arr676 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn615 int = 1
-				for _, b := range yyq615 {
+				var yynn676 int = 1
+				for _, b := range yyq676 {
 					if b {
-						yynn615++
+						yynn676++
 					}
 				}
-				r.EncodeMapStart(yynn615)
+				r.EncodeMapStart(yynn676)
 			}
-			if yyr615 || yy2arr615 {
-				if yyq615[0] {
-					yym617 := z.EncBinary()
-					_ = yym617
+			if yyr676 || yy2arr676 {
+				if yyq676[0] {
+					yym678 := z.EncBinary()
+					_ = yym678
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -6894,20 +7515,20 @@ func (x *ThirdPartyResourceDataList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq615[0] {
+				if yyq676[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym618 := z.EncBinary()
-					_ = yym618
+					yym679 := z.EncBinary()
+					_ = yym679
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr615 || yy2arr615 {
-				if yyq615[1] {
-					yym620 := z.EncBinary()
-					_ = yym620
+			if yyr676 || yy2arr676 {
+				if yyq676[1] {
+					yym681 := z.EncBinary()
+					_ = yym681
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -6916,48 +7537,48 @@ func (x *ThirdPartyResourceDataList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq615[1] {
+				if yyq676[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym621 := z.EncBinary()
-					_ = yym621
+					yym682 := z.EncBinary()
+					_ = yym682
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr615 || yy2arr615 {
-				if yyq615[2] {
-					yy623 := &x.ListMeta
-					yym624 := z.EncBinary()
-					_ = yym624
+			if yyr676 || yy2arr676 {
+				if yyq676[2] {
+					yy684 := &x.ListMeta
+					yym685 := z.EncBinary()
+					_ = yym685
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy623) {
+					} else if z.HasExtensions() && z.EncExt(yy684) {
 					} else {
-						z.EncFallback(yy623)
+						z.EncFallback(yy684)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq615[2] {
+				if yyq676[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy625 := &x.ListMeta
-					yym626 := z.EncBinary()
-					_ = yym626
+					yy686 := &x.ListMeta
+					yym687 := z.EncBinary()
+					_ = yym687
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy625) {
+					} else if z.HasExtensions() && z.EncExt(yy686) {
 					} else {
-						z.EncFallback(yy625)
+						z.EncFallback(yy686)
 					}
 				}
 			}
-			if yyr615 || yy2arr615 {
+			if yyr676 || yy2arr676 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym628 := z.EncBinary()
-					_ = yym628
+					yym689 := z.EncBinary()
+					_ = yym689
 					if false {
 					} else {
 						h.encSliceThirdPartyResourceData(([]ThirdPartyResourceData)(x.Items), e)
@@ -6968,15 +7589,15 @@ func (x *ThirdPartyResourceDataList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym629 := z.EncBinary()
-					_ = yym629
+					yym690 := z.EncBinary()
+					_ = yym690
 					if false {
 					} else {
 						h.encSliceThirdPartyResourceData(([]ThirdPartyResourceData)(x.Items), e)
 					}
 				}
 			}
-			if yysep615 {
+			if yysep676 {
 				r.EncodeEnd()
 			}
 		}
@@ -6987,24 +7608,24 @@ func (x *ThirdPartyResourceDataList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym630 := z.DecBinary()
-	_ = yym630
+	yym691 := z.DecBinary()
+	_ = yym691
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl631 := r.ReadMapStart()
-			if yyl631 == 0 {
+			yyl692 := r.ReadMapStart()
+			if yyl692 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl631, d)
+				x.codecDecodeSelfFromMap(yyl692, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl631 := r.ReadArrayStart()
-			if yyl631 == 0 {
+			yyl692 := r.ReadArrayStart()
+			if yyl692 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl631, d)
+				x.codecDecodeSelfFromArray(yyl692, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -7016,12 +7637,12 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromMap(l int, d *codec1978.
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys632Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys632Slc
-	var yyhl632 bool = l >= 0
-	for yyj632 := 0; ; yyj632++ {
-		if yyhl632 {
-			if yyj632 >= l {
+	var yys693Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys693Slc
+	var yyhl693 bool = l >= 0
+	for yyj693 := 0; ; yyj693++ {
+		if yyhl693 {
+			if yyj693 >= l {
 				break
 			}
 		} else {
@@ -7029,9 +7650,9 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromMap(l int, d *codec1978.
 				break
 			}
 		}
-		yys632Slc = r.DecodeBytes(yys632Slc, true, true)
-		yys632 := string(yys632Slc)
-		switch yys632 {
+		yys693Slc = r.DecodeBytes(yys693Slc, true, true)
+		yys693 := string(yys693Slc)
+		switch yys693 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -7048,32 +7669,32 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromMap(l int, d *codec1978.
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv635 := &x.ListMeta
-				yym636 := z.DecBinary()
-				_ = yym636
+				yyv696 := &x.ListMeta
+				yym697 := z.DecBinary()
+				_ = yym697
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv635) {
+				} else if z.HasExtensions() && z.DecExt(yyv696) {
 				} else {
-					z.DecFallback(yyv635, false)
+					z.DecFallback(yyv696, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv637 := &x.Items
-				yym638 := z.DecBinary()
-				_ = yym638
+				yyv698 := &x.Items
+				yym699 := z.DecBinary()
+				_ = yym699
 				if false {
 				} else {
-					h.decSliceThirdPartyResourceData((*[]ThirdPartyResourceData)(yyv637), d)
+					h.decSliceThirdPartyResourceData((*[]ThirdPartyResourceData)(yyv698), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys632)
-		} // end switch yys632
-	} // end for yyj632
-	if !yyhl632 {
+			z.DecStructFieldNotFound(-1, yys693)
+		} // end switch yys693
+	} // end for yyj693
+	if !yyhl693 {
 		r.ReadEnd()
 	}
 }
@@ -7082,16 +7703,16 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromArray(l int, d *codec197
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj639 int
-	var yyb639 bool
-	var yyhl639 bool = l >= 0
-	yyj639++
-	if yyhl639 {
-		yyb639 = yyj639 > l
+	var yyj700 int
+	var yyb700 bool
+	var yyhl700 bool = l >= 0
+	yyj700++
+	if yyhl700 {
+		yyb700 = yyj700 > l
 	} else {
-		yyb639 = r.CheckBreak()
+		yyb700 = r.CheckBreak()
 	}
-	if yyb639 {
+	if yyb700 {
 		r.ReadEnd()
 		return
 	}
@@ -7100,13 +7721,13 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromArray(l int, d *codec197
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj639++
-	if yyhl639 {
-		yyb639 = yyj639 > l
+	yyj700++
+	if yyhl700 {
+		yyb700 = yyj700 > l
 	} else {
-		yyb639 = r.CheckBreak()
+		yyb700 = r.CheckBreak()
 	}
-	if yyb639 {
+	if yyb700 {
 		r.ReadEnd()
 		return
 	}
@@ -7115,60 +7736,60 @@ func (x *ThirdPartyResourceDataList) codecDecodeSelfFromArray(l int, d *codec197
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj639++
-	if yyhl639 {
-		yyb639 = yyj639 > l
+	yyj700++
+	if yyhl700 {
+		yyb700 = yyj700 > l
 	} else {
-		yyb639 = r.CheckBreak()
+		yyb700 = r.CheckBreak()
 	}
-	if yyb639 {
+	if yyb700 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv642 := &x.ListMeta
-		yym643 := z.DecBinary()
-		_ = yym643
+		yyv703 := &x.ListMeta
+		yym704 := z.DecBinary()
+		_ = yym704
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv642) {
+		} else if z.HasExtensions() && z.DecExt(yyv703) {
 		} else {
-			z.DecFallback(yyv642, false)
+			z.DecFallback(yyv703, false)
 		}
 	}
-	yyj639++
-	if yyhl639 {
-		yyb639 = yyj639 > l
+	yyj700++
+	if yyhl700 {
+		yyb700 = yyj700 > l
 	} else {
-		yyb639 = r.CheckBreak()
+		yyb700 = r.CheckBreak()
 	}
-	if yyb639 {
+	if yyb700 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv644 := &x.Items
-		yym645 := z.DecBinary()
-		_ = yym645
+		yyv705 := &x.Items
+		yym706 := z.DecBinary()
+		_ = yym706
 		if false {
 		} else {
-			h.decSliceThirdPartyResourceData((*[]ThirdPartyResourceData)(yyv644), d)
+			h.decSliceThirdPartyResourceData((*[]ThirdPartyResourceData)(yyv705), d)
 		}
 	}
 	for {
-		yyj639++
-		if yyhl639 {
-			yyb639 = yyj639 > l
+		yyj700++
+		if yyhl700 {
+			yyb700 = yyj700 > l
 		} else {
-			yyb639 = r.CheckBreak()
+			yyb700 = r.CheckBreak()
 		}
-		if yyb639 {
+		if yyb700 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj639-1, "")
+		z.DecStructFieldNotFound(yyj700-1, "")
 	}
 	r.ReadEnd()
 }
@@ -7180,36 +7801,36 @@ func (x *Job) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym646 := z.EncBinary()
-		_ = yym646
+		yym707 := z.EncBinary()
+		_ = yym707
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep647 := !z.EncBinary()
-			yy2arr647 := z.EncBasicHandle().StructToArray
-			var yyq647 [5]bool
-			_, _, _ = yysep647, yyq647, yy2arr647
-			const yyr647 bool = false
-			yyq647[0] = x.Kind != ""
-			yyq647[1] = x.APIVersion != ""
-			yyq647[2] = true
-			yyq647[3] = true
-			yyq647[4] = true
-			if yyr647 || yy2arr647 {
+			yysep708 := !z.EncBinary()
+			yy2arr708 := z.EncBasicHandle().StructToArray
+			var yyq708 [5]bool
+			_, _, _ = yysep708, yyq708, yy2arr708
+			const yyr708 bool = false
+			yyq708[0] = x.Kind != ""
+			yyq708[1] = x.APIVersion != ""
+			yyq708[2] = true
+			yyq708[3] = true
+			yyq708[4] = true
+			if yyr708 || yy2arr708 {
 				r.EncodeArrayStart(5)
 			} else {
-				var yynn647 int = 0
-				for _, b := range yyq647 {
+				var yynn708 int = 0
+				for _, b := range yyq708 {
 					if b {
-						yynn647++
+						yynn708++
 					}
 				}
-				r.EncodeMapStart(yynn647)
+				r.EncodeMapStart(yynn708)
 			}
-			if yyr647 || yy2arr647 {
-				if yyq647[0] {
-					yym649 := z.EncBinary()
-					_ = yym649
+			if yyr708 || yy2arr708 {
+				if yyq708[0] {
+					yym710 := z.EncBinary()
+					_ = yym710
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -7218,20 +7839,20 @@ func (x *Job) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq647[0] {
+				if yyq708[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym650 := z.EncBinary()
-					_ = yym650
+					yym711 := z.EncBinary()
+					_ = yym711
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr647 || yy2arr647 {
-				if yyq647[1] {
-					yym652 := z.EncBinary()
-					_ = yym652
+			if yyr708 || yy2arr708 {
+				if yyq708[1] {
+					yym713 := z.EncBinary()
+					_ = yym713
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -7240,59 +7861,59 @@ func (x *Job) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq647[1] {
+				if yyq708[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym653 := z.EncBinary()
-					_ = yym653
+					yym714 := z.EncBinary()
+					_ = yym714
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr647 || yy2arr647 {
-				if yyq647[2] {
-					yy655 := &x.ObjectMeta
-					yy655.CodecEncodeSelf(e)
+			if yyr708 || yy2arr708 {
+				if yyq708[2] {
+					yy716 := &x.ObjectMeta
+					yy716.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq647[2] {
+				if yyq708[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy656 := &x.ObjectMeta
-					yy656.CodecEncodeSelf(e)
+					yy717 := &x.ObjectMeta
+					yy717.CodecEncodeSelf(e)
 				}
 			}
-			if yyr647 || yy2arr647 {
-				if yyq647[3] {
-					yy658 := &x.Spec
-					yy658.CodecEncodeSelf(e)
+			if yyr708 || yy2arr708 {
+				if yyq708[3] {
+					yy719 := &x.Spec
+					yy719.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq647[3] {
+				if yyq708[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("spec"))
-					yy659 := &x.Spec
-					yy659.CodecEncodeSelf(e)
+					yy720 := &x.Spec
+					yy720.CodecEncodeSelf(e)
 				}
 			}
-			if yyr647 || yy2arr647 {
-				if yyq647[4] {
-					yy661 := &x.Status
-					yy661.CodecEncodeSelf(e)
+			if yyr708 || yy2arr708 {
+				if yyq708[4] {
+					yy722 := &x.Status
+					yy722.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq647[4] {
+				if yyq708[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("status"))
-					yy662 := &x.Status
-					yy662.CodecEncodeSelf(e)
+					yy723 := &x.Status
+					yy723.CodecEncodeSelf(e)
 				}
 			}
-			if yysep647 {
+			if yysep708 {
 				r.EncodeEnd()
 			}
 		}
@@ -7303,24 +7924,24 @@ func (x *Job) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym663 := z.DecBinary()
-	_ = yym663
+	yym724 := z.DecBinary()
+	_ = yym724
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl664 := r.ReadMapStart()
-			if yyl664 == 0 {
+			yyl725 := r.ReadMapStart()
+			if yyl725 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl664, d)
+				x.codecDecodeSelfFromMap(yyl725, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl664 := r.ReadArrayStart()
-			if yyl664 == 0 {
+			yyl725 := r.ReadArrayStart()
+			if yyl725 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl664, d)
+				x.codecDecodeSelfFromArray(yyl725, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -7332,12 +7953,12 @@ func (x *Job) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys665Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys665Slc
-	var yyhl665 bool = l >= 0
-	for yyj665 := 0; ; yyj665++ {
-		if yyhl665 {
-			if yyj665 >= l {
+	var yys726Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys726Slc
+	var yyhl726 bool = l >= 0
+	for yyj726 := 0; ; yyj726++ {
+		if yyhl726 {
+			if yyj726 >= l {
 				break
 			}
 		} else {
@@ -7345,9 +7966,9 @@ func (x *Job) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys665Slc = r.DecodeBytes(yys665Slc, true, true)
-		yys665 := string(yys665Slc)
-		switch yys665 {
+		yys726Slc = r.DecodeBytes(yys726Slc, true, true)
+		yys726 := string(yys726Slc)
+		switch yys726 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -7364,28 +7985,28 @@ func (x *Job) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ObjectMeta = pkg2_v1.ObjectMeta{}
 			} else {
-				yyv668 := &x.ObjectMeta
-				yyv668.CodecDecodeSelf(d)
+				yyv729 := &x.ObjectMeta
+				yyv729.CodecDecodeSelf(d)
 			}
 		case "spec":
 			if r.TryDecodeAsNil() {
 				x.Spec = JobSpec{}
 			} else {
-				yyv669 := &x.Spec
-				yyv669.CodecDecodeSelf(d)
+				yyv730 := &x.Spec
+				yyv730.CodecDecodeSelf(d)
 			}
 		case "status":
 			if r.TryDecodeAsNil() {
 				x.Status = JobStatus{}
 			} else {
-				yyv670 := &x.Status
-				yyv670.CodecDecodeSelf(d)
+				yyv731 := &x.Status
+				yyv731.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys665)
-		} // end switch yys665
-	} // end for yyj665
-	if !yyhl665 {
+			z.DecStructFieldNotFound(-1, yys726)
+		} // end switch yys726
+	} // end for yyj726
+	if !yyhl726 {
 		r.ReadEnd()
 	}
 }
@@ -7394,16 +8015,16 @@ func (x *Job) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj671 int
-	var yyb671 bool
-	var yyhl671 bool = l >= 0
-	yyj671++
-	if yyhl671 {
-		yyb671 = yyj671 > l
+	var yyj732 int
+	var yyb732 bool
+	var yyhl732 bool = l >= 0
+	yyj732++
+	if yyhl732 {
+		yyb732 = yyj732 > l
 	} else {
-		yyb671 = r.CheckBreak()
+		yyb732 = r.CheckBreak()
 	}
-	if yyb671 {
+	if yyb732 {
 		r.ReadEnd()
 		return
 	}
@@ -7412,13 +8033,13 @@ func (x *Job) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj671++
-	if yyhl671 {
-		yyb671 = yyj671 > l
+	yyj732++
+	if yyhl732 {
+		yyb732 = yyj732 > l
 	} else {
-		yyb671 = r.CheckBreak()
+		yyb732 = r.CheckBreak()
 	}
-	if yyb671 {
+	if yyb732 {
 		r.ReadEnd()
 		return
 	}
@@ -7427,65 +8048,65 @@ func (x *Job) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj671++
-	if yyhl671 {
-		yyb671 = yyj671 > l
+	yyj732++
+	if yyhl732 {
+		yyb732 = yyj732 > l
 	} else {
-		yyb671 = r.CheckBreak()
+		yyb732 = r.CheckBreak()
 	}
-	if yyb671 {
+	if yyb732 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ObjectMeta = pkg2_v1.ObjectMeta{}
 	} else {
-		yyv674 := &x.ObjectMeta
-		yyv674.CodecDecodeSelf(d)
+		yyv735 := &x.ObjectMeta
+		yyv735.CodecDecodeSelf(d)
 	}
-	yyj671++
-	if yyhl671 {
-		yyb671 = yyj671 > l
+	yyj732++
+	if yyhl732 {
+		yyb732 = yyj732 > l
 	} else {
-		yyb671 = r.CheckBreak()
+		yyb732 = r.CheckBreak()
 	}
-	if yyb671 {
+	if yyb732 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Spec = JobSpec{}
 	} else {
-		yyv675 := &x.Spec
-		yyv675.CodecDecodeSelf(d)
+		yyv736 := &x.Spec
+		yyv736.CodecDecodeSelf(d)
 	}
-	yyj671++
-	if yyhl671 {
-		yyb671 = yyj671 > l
+	yyj732++
+	if yyhl732 {
+		yyb732 = yyj732 > l
 	} else {
-		yyb671 = r.CheckBreak()
+		yyb732 = r.CheckBreak()
 	}
-	if yyb671 {
+	if yyb732 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Status = JobStatus{}
 	} else {
-		yyv676 := &x.Status
-		yyv676.CodecDecodeSelf(d)
+		yyv737 := &x.Status
+		yyv737.CodecDecodeSelf(d)
 	}
 	for {
-		yyj671++
-		if yyhl671 {
-			yyb671 = yyj671 > l
+		yyj732++
+		if yyhl732 {
+			yyb732 = yyj732 > l
 		} else {
-			yyb671 = r.CheckBreak()
+			yyb732 = r.CheckBreak()
 		}
-		if yyb671 {
+		if yyb732 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj671-1, "")
+		z.DecStructFieldNotFound(yyj732-1, "")
 	}
 	r.ReadEnd()
 }
@@ -7497,34 +8118,34 @@ func (x *JobList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym677 := z.EncBinary()
-		_ = yym677
+		yym738 := z.EncBinary()
+		_ = yym738
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep678 := !z.EncBinary()
-			yy2arr678 := z.EncBasicHandle().StructToArray
-			var yyq678 [4]bool
-			_, _, _ = yysep678, yyq678, yy2arr678
-			const yyr678 bool = false
-			yyq678[0] = x.Kind != ""
-			yyq678[1] = x.APIVersion != ""
-			yyq678[2] = true
-			if yyr678 || yy2arr678 {
+			yysep739 := !z.EncBinary()
+			yy2arr739 := z.EncBasicHandle().StructToArray
+			var yyq739 [4]bool
+			_, _, _ = yysep739, yyq739, yy2arr739
+			const yyr739 bool = false
+			yyq739[0] = x.Kind != ""
+			yyq739[1] = x.APIVersion != ""
+			yyq739[2] = true
+			if yyr739 || yy2arr739 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn678 int = 1
-				for _, b := range yyq678 {
+				var yynn739 int = 1
+				for _, b := range yyq739 {
 					if b {
-						yynn678++
+						yynn739++
 					}
 				}
-				r.EncodeMapStart(yynn678)
+				r.EncodeMapStart(yynn739)
 			}
-			if yyr678 || yy2arr678 {
-				if yyq678[0] {
-					yym680 := z.EncBinary()
-					_ = yym680
+			if yyr739 || yy2arr739 {
+				if yyq739[0] {
+					yym741 := z.EncBinary()
+					_ = yym741
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -7533,20 +8154,20 @@ func (x *JobList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq678[0] {
+				if yyq739[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym681 := z.EncBinary()
-					_ = yym681
+					yym742 := z.EncBinary()
+					_ = yym742
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr678 || yy2arr678 {
-				if yyq678[1] {
-					yym683 := z.EncBinary()
-					_ = yym683
+			if yyr739 || yy2arr739 {
+				if yyq739[1] {
+					yym744 := z.EncBinary()
+					_ = yym744
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -7555,48 +8176,48 @@ func (x *JobList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq678[1] {
+				if yyq739[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym684 := z.EncBinary()
-					_ = yym684
+					yym745 := z.EncBinary()
+					_ = yym745
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr678 || yy2arr678 {
-				if yyq678[2] {
-					yy686 := &x.ListMeta
-					yym687 := z.EncBinary()
-					_ = yym687
+			if yyr739 || yy2arr739 {
+				if yyq739[2] {
+					yy747 := &x.ListMeta
+					yym748 := z.EncBinary()
+					_ = yym748
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy686) {
+					} else if z.HasExtensions() && z.EncExt(yy747) {
 					} else {
-						z.EncFallback(yy686)
+						z.EncFallback(yy747)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq678[2] {
+				if yyq739[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy688 := &x.ListMeta
-					yym689 := z.EncBinary()
-					_ = yym689
+					yy749 := &x.ListMeta
+					yym750 := z.EncBinary()
+					_ = yym750
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy688) {
+					} else if z.HasExtensions() && z.EncExt(yy749) {
 					} else {
-						z.EncFallback(yy688)
+						z.EncFallback(yy749)
 					}
 				}
 			}
-			if yyr678 || yy2arr678 {
+			if yyr739 || yy2arr739 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym691 := z.EncBinary()
-					_ = yym691
+					yym752 := z.EncBinary()
+					_ = yym752
 					if false {
 					} else {
 						h.encSliceJob(([]Job)(x.Items), e)
@@ -7607,15 +8228,15 @@ func (x *JobList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym692 := z.EncBinary()
-					_ = yym692
+					yym753 := z.EncBinary()
+					_ = yym753
 					if false {
 					} else {
 						h.encSliceJob(([]Job)(x.Items), e)
 					}
 				}
 			}
-			if yysep678 {
+			if yysep739 {
 				r.EncodeEnd()
 			}
 		}
@@ -7626,24 +8247,24 @@ func (x *JobList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym693 := z.DecBinary()
-	_ = yym693
+	yym754 := z.DecBinary()
+	_ = yym754
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl694 := r.ReadMapStart()
-			if yyl694 == 0 {
+			yyl755 := r.ReadMapStart()
+			if yyl755 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl694, d)
+				x.codecDecodeSelfFromMap(yyl755, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl694 := r.ReadArrayStart()
-			if yyl694 == 0 {
+			yyl755 := r.ReadArrayStart()
+			if yyl755 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl694, d)
+				x.codecDecodeSelfFromArray(yyl755, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -7655,12 +8276,12 @@ func (x *JobList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys695Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys695Slc
-	var yyhl695 bool = l >= 0
-	for yyj695 := 0; ; yyj695++ {
-		if yyhl695 {
-			if yyj695 >= l {
+	var yys756Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys756Slc
+	var yyhl756 bool = l >= 0
+	for yyj756 := 0; ; yyj756++ {
+		if yyhl756 {
+			if yyj756 >= l {
 				break
 			}
 		} else {
@@ -7668,9 +8289,9 @@ func (x *JobList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys695Slc = r.DecodeBytes(yys695Slc, true, true)
-		yys695 := string(yys695Slc)
-		switch yys695 {
+		yys756Slc = r.DecodeBytes(yys756Slc, true, true)
+		yys756 := string(yys756Slc)
+		switch yys756 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -7687,32 +8308,32 @@ func (x *JobList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv698 := &x.ListMeta
-				yym699 := z.DecBinary()
-				_ = yym699
+				yyv759 := &x.ListMeta
+				yym760 := z.DecBinary()
+				_ = yym760
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv698) {
+				} else if z.HasExtensions() && z.DecExt(yyv759) {
 				} else {
-					z.DecFallback(yyv698, false)
+					z.DecFallback(yyv759, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv700 := &x.Items
-				yym701 := z.DecBinary()
-				_ = yym701
+				yyv761 := &x.Items
+				yym762 := z.DecBinary()
+				_ = yym762
 				if false {
 				} else {
-					h.decSliceJob((*[]Job)(yyv700), d)
+					h.decSliceJob((*[]Job)(yyv761), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys695)
-		} // end switch yys695
-	} // end for yyj695
-	if !yyhl695 {
+			z.DecStructFieldNotFound(-1, yys756)
+		} // end switch yys756
+	} // end for yyj756
+	if !yyhl756 {
 		r.ReadEnd()
 	}
 }
@@ -7721,16 +8342,16 @@ func (x *JobList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj702 int
-	var yyb702 bool
-	var yyhl702 bool = l >= 0
-	yyj702++
-	if yyhl702 {
-		yyb702 = yyj702 > l
+	var yyj763 int
+	var yyb763 bool
+	var yyhl763 bool = l >= 0
+	yyj763++
+	if yyhl763 {
+		yyb763 = yyj763 > l
 	} else {
-		yyb702 = r.CheckBreak()
+		yyb763 = r.CheckBreak()
 	}
-	if yyb702 {
+	if yyb763 {
 		r.ReadEnd()
 		return
 	}
@@ -7739,13 +8360,13 @@ func (x *JobList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj702++
-	if yyhl702 {
-		yyb702 = yyj702 > l
+	yyj763++
+	if yyhl763 {
+		yyb763 = yyj763 > l
 	} else {
-		yyb702 = r.CheckBreak()
+		yyb763 = r.CheckBreak()
 	}
-	if yyb702 {
+	if yyb763 {
 		r.ReadEnd()
 		return
 	}
@@ -7754,60 +8375,60 @@ func (x *JobList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj702++
-	if yyhl702 {
-		yyb702 = yyj702 > l
+	yyj763++
+	if yyhl763 {
+		yyb763 = yyj763 > l
 	} else {
-		yyb702 = r.CheckBreak()
+		yyb763 = r.CheckBreak()
 	}
-	if yyb702 {
+	if yyb763 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv705 := &x.ListMeta
-		yym706 := z.DecBinary()
-		_ = yym706
+		yyv766 := &x.ListMeta
+		yym767 := z.DecBinary()
+		_ = yym767
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv705) {
+		} else if z.HasExtensions() && z.DecExt(yyv766) {
 		} else {
-			z.DecFallback(yyv705, false)
+			z.DecFallback(yyv766, false)
 		}
 	}
-	yyj702++
-	if yyhl702 {
-		yyb702 = yyj702 > l
+	yyj763++
+	if yyhl763 {
+		yyb763 = yyj763 > l
 	} else {
-		yyb702 = r.CheckBreak()
+		yyb763 = r.CheckBreak()
 	}
-	if yyb702 {
+	if yyb763 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv707 := &x.Items
-		yym708 := z.DecBinary()
-		_ = yym708
+		yyv768 := &x.Items
+		yym769 := z.DecBinary()
+		_ = yym769
 		if false {
 		} else {
-			h.decSliceJob((*[]Job)(yyv707), d)
+			h.decSliceJob((*[]Job)(yyv768), d)
 		}
 	}
 	for {
-		yyj702++
-		if yyhl702 {
-			yyb702 = yyj702 > l
+		yyj763++
+		if yyhl763 {
+			yyb763 = yyj763 > l
 		} else {
-			yyb702 = r.CheckBreak()
+			yyb763 = r.CheckBreak()
 		}
-		if yyb702 {
+		if yyb763 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj702-1, "")
+		z.DecStructFieldNotFound(yyj763-1, "")
 	}
 	r.ReadEnd()
 }
@@ -7819,96 +8440,96 @@ func (x *JobSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym709 := z.EncBinary()
-		_ = yym709
+		yym770 := z.EncBinary()
+		_ = yym770
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep710 := !z.EncBinary()
-			yy2arr710 := z.EncBasicHandle().StructToArray
-			var yyq710 [4]bool
-			_, _, _ = yysep710, yyq710, yy2arr710
-			const yyr710 bool = false
-			yyq710[0] = x.Parallelism != nil
-			yyq710[1] = x.Completions != nil
-			yyq710[2] = x.Selector != nil
-			if yyr710 || yy2arr710 {
+			yysep771 := !z.EncBinary()
+			yy2arr771 := z.EncBasicHandle().StructToArray
+			var yyq771 [4]bool
+			_, _, _ = yysep771, yyq771, yy2arr771
+			const yyr771 bool = false
+			yyq771[0] = x.Parallelism != nil
+			yyq771[1] = x.Completions != nil
+			yyq771[2] = x.Selector != nil
+			if yyr771 || yy2arr771 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn710 int = 1
-				for _, b := range yyq710 {
+				var yynn771 int = 1
+				for _, b := range yyq771 {
 					if b {
-						yynn710++
+						yynn771++
 					}
 				}
-				r.EncodeMapStart(yynn710)
+				r.EncodeMapStart(yynn771)
 			}
-			if yyr710 || yy2arr710 {
-				if yyq710[0] {
+			if yyr771 || yy2arr771 {
+				if yyq771[0] {
 					if x.Parallelism == nil {
 						r.EncodeNil()
 					} else {
-						yy712 := *x.Parallelism
-						yym713 := z.EncBinary()
-						_ = yym713
+						yy773 := *x.Parallelism
+						yym774 := z.EncBinary()
+						_ = yym774
 						if false {
 						} else {
-							r.EncodeInt(int64(yy712))
+							r.EncodeInt(int64(yy773))
 						}
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq710[0] {
+				if yyq771[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("parallelism"))
 					if x.Parallelism == nil {
 						r.EncodeNil()
 					} else {
-						yy714 := *x.Parallelism
-						yym715 := z.EncBinary()
-						_ = yym715
+						yy775 := *x.Parallelism
+						yym776 := z.EncBinary()
+						_ = yym776
 						if false {
 						} else {
-							r.EncodeInt(int64(yy714))
+							r.EncodeInt(int64(yy775))
 						}
 					}
 				}
 			}
-			if yyr710 || yy2arr710 {
-				if yyq710[1] {
+			if yyr771 || yy2arr771 {
+				if yyq771[1] {
 					if x.Completions == nil {
 						r.EncodeNil()
 					} else {
-						yy717 := *x.Completions
-						yym718 := z.EncBinary()
-						_ = yym718
+						yy778 := *x.Completions
+						yym779 := z.EncBinary()
+						_ = yym779
 						if false {
 						} else {
-							r.EncodeInt(int64(yy717))
+							r.EncodeInt(int64(yy778))
 						}
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq710[1] {
+				if yyq771[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("completions"))
 					if x.Completions == nil {
 						r.EncodeNil()
 					} else {
-						yy719 := *x.Completions
-						yym720 := z.EncBinary()
-						_ = yym720
+						yy780 := *x.Completions
+						yym781 := z.EncBinary()
+						_ = yym781
 						if false {
 						} else {
-							r.EncodeInt(int64(yy719))
+							r.EncodeInt(int64(yy780))
 						}
 					}
 				}
 			}
-			if yyr710 || yy2arr710 {
-				if yyq710[2] {
+			if yyr771 || yy2arr771 {
+				if yyq771[2] {
 					if x.Selector == nil {
 						r.EncodeNil()
 					} else {
@@ -7918,7 +8539,7 @@ func (x *JobSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq710[2] {
+				if yyq771[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("selector"))
 					if x.Selector == nil {
 						r.EncodeNil()
@@ -7927,15 +8548,15 @@ func (x *JobSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr710 || yy2arr710 {
-				yy723 := &x.Template
-				yy723.CodecEncodeSelf(e)
+			if yyr771 || yy2arr771 {
+				yy784 := &x.Template
+				yy784.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("template"))
-				yy724 := &x.Template
-				yy724.CodecEncodeSelf(e)
+				yy785 := &x.Template
+				yy785.CodecEncodeSelf(e)
 			}
-			if yysep710 {
+			if yysep771 {
 				r.EncodeEnd()
 			}
 		}
@@ -7946,24 +8567,24 @@ func (x *JobSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym725 := z.DecBinary()
-	_ = yym725
+	yym786 := z.DecBinary()
+	_ = yym786
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl726 := r.ReadMapStart()
-			if yyl726 == 0 {
+			yyl787 := r.ReadMapStart()
+			if yyl787 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl726, d)
+				x.codecDecodeSelfFromMap(yyl787, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl726 := r.ReadArrayStart()
-			if yyl726 == 0 {
+			yyl787 := r.ReadArrayStart()
+			if yyl787 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl726, d)
+				x.codecDecodeSelfFromArray(yyl787, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -7975,12 +8596,12 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys727Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys727Slc
-	var yyhl727 bool = l >= 0
-	for yyj727 := 0; ; yyj727++ {
-		if yyhl727 {
-			if yyj727 >= l {
+	var yys788Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys788Slc
+	var yyhl788 bool = l >= 0
+	for yyj788 := 0; ; yyj788++ {
+		if yyhl788 {
+			if yyj788 >= l {
 				break
 			}
 		} else {
@@ -7988,9 +8609,9 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys727Slc = r.DecodeBytes(yys727Slc, true, true)
-		yys727 := string(yys727Slc)
-		switch yys727 {
+		yys788Slc = r.DecodeBytes(yys788Slc, true, true)
+		yys788 := string(yys788Slc)
+		switch yys788 {
 		case "parallelism":
 			if r.TryDecodeAsNil() {
 				if x.Parallelism != nil {
@@ -8000,8 +8621,8 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.Parallelism == nil {
 					x.Parallelism = new(int)
 				}
-				yym729 := z.DecBinary()
-				_ = yym729
+				yym790 := z.DecBinary()
+				_ = yym790
 				if false {
 				} else {
 					*((*int)(x.Parallelism)) = int(r.DecodeInt(codecSelferBitsize1234))
@@ -8016,8 +8637,8 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.Completions == nil {
 					x.Completions = new(int)
 				}
-				yym731 := z.DecBinary()
-				_ = yym731
+				yym792 := z.DecBinary()
+				_ = yym792
 				if false {
 				} else {
 					*((*int)(x.Completions)) = int(r.DecodeInt(codecSelferBitsize1234))
@@ -8038,14 +8659,14 @@ func (x *JobSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.Template = pkg2_v1.PodTemplateSpec{}
 			} else {
-				yyv733 := &x.Template
-				yyv733.CodecDecodeSelf(d)
+				yyv794 := &x.Template
+				yyv794.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys727)
-		} // end switch yys727
-	} // end for yyj727
-	if !yyhl727 {
+			z.DecStructFieldNotFound(-1, yys788)
+		} // end switch yys788
+	} // end for yyj788
+	if !yyhl788 {
 		r.ReadEnd()
 	}
 }
@@ -8054,16 +8675,16 @@ func (x *JobSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj734 int
-	var yyb734 bool
-	var yyhl734 bool = l >= 0
-	yyj734++
-	if yyhl734 {
-		yyb734 = yyj734 > l
+	var yyj795 int
+	var yyb795 bool
+	var yyhl795 bool = l >= 0
+	yyj795++
+	if yyhl795 {
+		yyb795 = yyj795 > l
 	} else {
-		yyb734 = r.CheckBreak()
+		yyb795 = r.CheckBreak()
 	}
-	if yyb734 {
+	if yyb795 {
 		r.ReadEnd()
 		return
 	}
@@ -8075,20 +8696,20 @@ func (x *JobSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.Parallelism == nil {
 			x.Parallelism = new(int)
 		}
-		yym736 := z.DecBinary()
-		_ = yym736
+		yym797 := z.DecBinary()
+		_ = yym797
 		if false {
 		} else {
 			*((*int)(x.Parallelism)) = int(r.DecodeInt(codecSelferBitsize1234))
 		}
 	}
-	yyj734++
-	if yyhl734 {
-		yyb734 = yyj734 > l
+	yyj795++
+	if yyhl795 {
+		yyb795 = yyj795 > l
 	} else {
-		yyb734 = r.CheckBreak()
+		yyb795 = r.CheckBreak()
 	}
-	if yyb734 {
+	if yyb795 {
 		r.ReadEnd()
 		return
 	}
@@ -8100,20 +8721,20 @@ func (x *JobSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.Completions == nil {
 			x.Completions = new(int)
 		}
-		yym738 := z.DecBinary()
-		_ = yym738
+		yym799 := z.DecBinary()
+		_ = yym799
 		if false {
 		} else {
 			*((*int)(x.Completions)) = int(r.DecodeInt(codecSelferBitsize1234))
 		}
 	}
-	yyj734++
-	if yyhl734 {
-		yyb734 = yyj734 > l
+	yyj795++
+	if yyhl795 {
+		yyb795 = yyj795 > l
 	} else {
-		yyb734 = r.CheckBreak()
+		yyb795 = r.CheckBreak()
 	}
-	if yyb734 {
+	if yyb795 {
 		r.ReadEnd()
 		return
 	}
@@ -8127,33 +8748,33 @@ func (x *JobSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		}
 		x.Selector.CodecDecodeSelf(d)
 	}
-	yyj734++
-	if yyhl734 {
-		yyb734 = yyj734 > l
+	yyj795++
+	if yyhl795 {
+		yyb795 = yyj795 > l
 	} else {
-		yyb734 = r.CheckBreak()
+		yyb795 = r.CheckBreak()
 	}
-	if yyb734 {
+	if yyb795 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Template = pkg2_v1.PodTemplateSpec{}
 	} else {
-		yyv740 := &x.Template
-		yyv740.CodecDecodeSelf(d)
+		yyv801 := &x.Template
+		yyv801.CodecDecodeSelf(d)
 	}
 	for {
-		yyj734++
-		if yyhl734 {
-			yyb734 = yyj734 > l
+		yyj795++
+		if yyhl795 {
+			yyb795 = yyj795 > l
 		} else {
-			yyb734 = r.CheckBreak()
+			yyb795 = r.CheckBreak()
 		}
-		if yyb734 {
+		if yyb795 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj734-1, "")
+		z.DecStructFieldNotFound(yyj795-1, "")
 	}
 	r.ReadEnd()
 }
@@ -8165,40 +8786,40 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym741 := z.EncBinary()
-		_ = yym741
+		yym802 := z.EncBinary()
+		_ = yym802
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep742 := !z.EncBinary()
-			yy2arr742 := z.EncBasicHandle().StructToArray
-			var yyq742 [6]bool
-			_, _, _ = yysep742, yyq742, yy2arr742
-			const yyr742 bool = false
-			yyq742[0] = len(x.Conditions) != 0
-			yyq742[1] = x.StartTime != nil
-			yyq742[2] = x.CompletionTime != nil
-			yyq742[3] = x.Active != 0
-			yyq742[4] = x.Succeeded != 0
-			yyq742[5] = x.Failed != 0
-			if yyr742 || yy2arr742 {
+			yysep803 := !z.EncBinary()
+			yy2arr803 := z.EncBasicHandle().StructToArray
+			var yyq803 [6]bool
+			_, _, _ = yysep803, yyq803, yy2arr803
+			const yyr803 bool = false
+			yyq803[0] = len(x.Conditions) != 0
+			yyq803[1] = x.StartTime != nil
+			yyq803[2] = x.CompletionTime != nil
+			yyq803[3] = x.Active != 0
+			yyq803[4] = x.Succeeded != 0
+			yyq803[5] = x.Failed != 0
+			if yyr803 || yy2arr803 {
 				r.EncodeArrayStart(6)
 			} else {
-				var yynn742 int = 0
-				for _, b := range yyq742 {
+				var yynn803 int = 0
+				for _, b := range yyq803 {
 					if b {
-						yynn742++
+						yynn803++
 					}
 				}
-				r.EncodeMapStart(yynn742)
+				r.EncodeMapStart(yynn803)
 			}
-			if yyr742 || yy2arr742 {
-				if yyq742[0] {
+			if yyr803 || yy2arr803 {
+				if yyq803[0] {
 					if x.Conditions == nil {
 						r.EncodeNil()
 					} else {
-						yym744 := z.EncBinary()
-						_ = yym744
+						yym805 := z.EncBinary()
+						_ = yym805
 						if false {
 						} else {
 							h.encSliceJobCondition(([]JobCondition)(x.Conditions), e)
@@ -8208,13 +8829,13 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq742[0] {
+				if yyq803[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("conditions"))
 					if x.Conditions == nil {
 						r.EncodeNil()
 					} else {
-						yym745 := z.EncBinary()
-						_ = yym745
+						yym806 := z.EncBinary()
+						_ = yym806
 						if false {
 						} else {
 							h.encSliceJobCondition(([]JobCondition)(x.Conditions), e)
@@ -8222,18 +8843,18 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr742 || yy2arr742 {
-				if yyq742[1] {
+			if yyr803 || yy2arr803 {
+				if yyq803[1] {
 					if x.StartTime == nil {
 						r.EncodeNil()
 					} else {
-						yym747 := z.EncBinary()
-						_ = yym747
+						yym808 := z.EncBinary()
+						_ = yym808
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.StartTime) {
-						} else if yym747 {
+						} else if yym808 {
 							z.EncBinaryMarshal(x.StartTime)
-						} else if !yym747 && z.IsJSONHandle() {
+						} else if !yym808 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.StartTime)
 						} else {
 							z.EncFallback(x.StartTime)
@@ -8243,18 +8864,18 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq742[1] {
+				if yyq803[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("startTime"))
 					if x.StartTime == nil {
 						r.EncodeNil()
 					} else {
-						yym748 := z.EncBinary()
-						_ = yym748
+						yym809 := z.EncBinary()
+						_ = yym809
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.StartTime) {
-						} else if yym748 {
+						} else if yym809 {
 							z.EncBinaryMarshal(x.StartTime)
-						} else if !yym748 && z.IsJSONHandle() {
+						} else if !yym809 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.StartTime)
 						} else {
 							z.EncFallback(x.StartTime)
@@ -8262,18 +8883,18 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr742 || yy2arr742 {
-				if yyq742[2] {
+			if yyr803 || yy2arr803 {
+				if yyq803[2] {
 					if x.CompletionTime == nil {
 						r.EncodeNil()
 					} else {
-						yym750 := z.EncBinary()
-						_ = yym750
+						yym811 := z.EncBinary()
+						_ = yym811
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.CompletionTime) {
-						} else if yym750 {
+						} else if yym811 {
 							z.EncBinaryMarshal(x.CompletionTime)
-						} else if !yym750 && z.IsJSONHandle() {
+						} else if !yym811 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.CompletionTime)
 						} else {
 							z.EncFallback(x.CompletionTime)
@@ -8283,18 +8904,18 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq742[2] {
+				if yyq803[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("completionTime"))
 					if x.CompletionTime == nil {
 						r.EncodeNil()
 					} else {
-						yym751 := z.EncBinary()
-						_ = yym751
+						yym812 := z.EncBinary()
+						_ = yym812
 						if false {
 						} else if z.HasExtensions() && z.EncExt(x.CompletionTime) {
-						} else if yym751 {
+						} else if yym812 {
 							z.EncBinaryMarshal(x.CompletionTime)
-						} else if !yym751 && z.IsJSONHandle() {
+						} else if !yym812 && z.IsJSONHandle() {
 							z.EncJSONMarshal(x.CompletionTime)
 						} else {
 							z.EncFallback(x.CompletionTime)
@@ -8302,10 +8923,10 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr742 || yy2arr742 {
-				if yyq742[3] {
-					yym753 := z.EncBinary()
-					_ = yym753
+			if yyr803 || yy2arr803 {
+				if yyq803[3] {
+					yym814 := z.EncBinary()
+					_ = yym814
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Active))
@@ -8314,20 +8935,20 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq742[3] {
+				if yyq803[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("active"))
-					yym754 := z.EncBinary()
-					_ = yym754
+					yym815 := z.EncBinary()
+					_ = yym815
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Active))
 					}
 				}
 			}
-			if yyr742 || yy2arr742 {
-				if yyq742[4] {
-					yym756 := z.EncBinary()
-					_ = yym756
+			if yyr803 || yy2arr803 {
+				if yyq803[4] {
+					yym817 := z.EncBinary()
+					_ = yym817
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Succeeded))
@@ -8336,20 +8957,20 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq742[4] {
+				if yyq803[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("succeeded"))
-					yym757 := z.EncBinary()
-					_ = yym757
+					yym818 := z.EncBinary()
+					_ = yym818
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Succeeded))
 					}
 				}
 			}
-			if yyr742 || yy2arr742 {
-				if yyq742[5] {
-					yym759 := z.EncBinary()
-					_ = yym759
+			if yyr803 || yy2arr803 {
+				if yyq803[5] {
+					yym820 := z.EncBinary()
+					_ = yym820
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Failed))
@@ -8358,17 +8979,17 @@ func (x *JobStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeInt(0)
 				}
 			} else {
-				if yyq742[5] {
+				if yyq803[5] {
 					r.EncodeString(codecSelferC_UTF81234, string("failed"))
-					yym760 := z.EncBinary()
-					_ = yym760
+					yym821 := z.EncBinary()
+					_ = yym821
 					if false {
 					} else {
 						r.EncodeInt(int64(x.Failed))
 					}
 				}
 			}
-			if yysep742 {
+			if yysep803 {
 				r.EncodeEnd()
 			}
 		}
@@ -8379,24 +9000,24 @@ func (x *JobStatus) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym761 := z.DecBinary()
-	_ = yym761
+	yym822 := z.DecBinary()
+	_ = yym822
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl762 := r.ReadMapStart()
-			if yyl762 == 0 {
+			yyl823 := r.ReadMapStart()
+			if yyl823 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl762, d)
+				x.codecDecodeSelfFromMap(yyl823, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl762 := r.ReadArrayStart()
-			if yyl762 == 0 {
+			yyl823 := r.ReadArrayStart()
+			if yyl823 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl762, d)
+				x.codecDecodeSelfFromArray(yyl823, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -8408,12 +9029,12 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys763Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys763Slc
-	var yyhl763 bool = l >= 0
-	for yyj763 := 0; ; yyj763++ {
-		if yyhl763 {
-			if yyj763 >= l {
+	var yys824Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys824Slc
+	var yyhl824 bool = l >= 0
+	for yyj824 := 0; ; yyj824++ {
+		if yyhl824 {
+			if yyj824 >= l {
 				break
 			}
 		} else {
@@ -8421,19 +9042,19 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys763Slc = r.DecodeBytes(yys763Slc, true, true)
-		yys763 := string(yys763Slc)
-		switch yys763 {
+		yys824Slc = r.DecodeBytes(yys824Slc, true, true)
+		yys824 := string(yys824Slc)
+		switch yys824 {
 		case "conditions":
 			if r.TryDecodeAsNil() {
 				x.Conditions = nil
 			} else {
-				yyv764 := &x.Conditions
-				yym765 := z.DecBinary()
-				_ = yym765
+				yyv825 := &x.Conditions
+				yym826 := z.DecBinary()
+				_ = yym826
 				if false {
 				} else {
-					h.decSliceJobCondition((*[]JobCondition)(yyv764), d)
+					h.decSliceJobCondition((*[]JobCondition)(yyv825), d)
 				}
 			}
 		case "startTime":
@@ -8445,13 +9066,13 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.StartTime == nil {
 					x.StartTime = new(pkg1_unversioned.Time)
 				}
-				yym767 := z.DecBinary()
-				_ = yym767
+				yym828 := z.DecBinary()
+				_ = yym828
 				if false {
 				} else if z.HasExtensions() && z.DecExt(x.StartTime) {
-				} else if yym767 {
+				} else if yym828 {
 					z.DecBinaryUnmarshal(x.StartTime)
-				} else if !yym767 && z.IsJSONHandle() {
+				} else if !yym828 && z.IsJSONHandle() {
 					z.DecJSONUnmarshal(x.StartTime)
 				} else {
 					z.DecFallback(x.StartTime, false)
@@ -8466,13 +9087,13 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				if x.CompletionTime == nil {
 					x.CompletionTime = new(pkg1_unversioned.Time)
 				}
-				yym769 := z.DecBinary()
-				_ = yym769
+				yym830 := z.DecBinary()
+				_ = yym830
 				if false {
 				} else if z.HasExtensions() && z.DecExt(x.CompletionTime) {
-				} else if yym769 {
+				} else if yym830 {
 					z.DecBinaryUnmarshal(x.CompletionTime)
-				} else if !yym769 && z.IsJSONHandle() {
+				} else if !yym830 && z.IsJSONHandle() {
 					z.DecJSONUnmarshal(x.CompletionTime)
 				} else {
 					z.DecFallback(x.CompletionTime, false)
@@ -8497,10 +9118,10 @@ func (x *JobStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.Failed = int(r.DecodeInt(codecSelferBitsize1234))
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys763)
-		} // end switch yys763
-	} // end for yyj763
-	if !yyhl763 {
+			z.DecStructFieldNotFound(-1, yys824)
+		} // end switch yys824
+	} // end for yyj824
+	if !yyhl824 {
 		r.ReadEnd()
 	}
 }
@@ -8509,37 +9130,37 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj773 int
-	var yyb773 bool
-	var yyhl773 bool = l >= 0
-	yyj773++
-	if yyhl773 {
-		yyb773 = yyj773 > l
+	var yyj834 int
+	var yyb834 bool
+	var yyhl834 bool = l >= 0
+	yyj834++
+	if yyhl834 {
+		yyb834 = yyj834 > l
 	} else {
-		yyb773 = r.CheckBreak()
+		yyb834 = r.CheckBreak()
 	}
-	if yyb773 {
+	if yyb834 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Conditions = nil
 	} else {
-		yyv774 := &x.Conditions
-		yym775 := z.DecBinary()
-		_ = yym775
+		yyv835 := &x.Conditions
+		yym836 := z.DecBinary()
+		_ = yym836
 		if false {
 		} else {
-			h.decSliceJobCondition((*[]JobCondition)(yyv774), d)
+			h.decSliceJobCondition((*[]JobCondition)(yyv835), d)
 		}
 	}
-	yyj773++
-	if yyhl773 {
-		yyb773 = yyj773 > l
+	yyj834++
+	if yyhl834 {
+		yyb834 = yyj834 > l
 	} else {
-		yyb773 = r.CheckBreak()
+		yyb834 = r.CheckBreak()
 	}
-	if yyb773 {
+	if yyb834 {
 		r.ReadEnd()
 		return
 	}
@@ -8551,25 +9172,25 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.StartTime == nil {
 			x.StartTime = new(pkg1_unversioned.Time)
 		}
-		yym777 := z.DecBinary()
-		_ = yym777
+		yym838 := z.DecBinary()
+		_ = yym838
 		if false {
 		} else if z.HasExtensions() && z.DecExt(x.StartTime) {
-		} else if yym777 {
+		} else if yym838 {
 			z.DecBinaryUnmarshal(x.StartTime)
-		} else if !yym777 && z.IsJSONHandle() {
+		} else if !yym838 && z.IsJSONHandle() {
 			z.DecJSONUnmarshal(x.StartTime)
 		} else {
 			z.DecFallback(x.StartTime, false)
 		}
 	}
-	yyj773++
-	if yyhl773 {
-		yyb773 = yyj773 > l
+	yyj834++
+	if yyhl834 {
+		yyb834 = yyj834 > l
 	} else {
-		yyb773 = r.CheckBreak()
+		yyb834 = r.CheckBreak()
 	}
-	if yyb773 {
+	if yyb834 {
 		r.ReadEnd()
 		return
 	}
@@ -8581,25 +9202,25 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		if x.CompletionTime == nil {
 			x.CompletionTime = new(pkg1_unversioned.Time)
 		}
-		yym779 := z.DecBinary()
-		_ = yym779
+		yym840 := z.DecBinary()
+		_ = yym840
 		if false {
 		} else if z.HasExtensions() && z.DecExt(x.CompletionTime) {
-		} else if yym779 {
+		} else if yym840 {
 			z.DecBinaryUnmarshal(x.CompletionTime)
-		} else if !yym779 && z.IsJSONHandle() {
+		} else if !yym840 && z.IsJSONHandle() {
 			z.DecJSONUnmarshal(x.CompletionTime)
 		} else {
 			z.DecFallback(x.CompletionTime, false)
 		}
 	}
-	yyj773++
-	if yyhl773 {
-		yyb773 = yyj773 > l
+	yyj834++
+	if yyhl834 {
+		yyb834 = yyj834 > l
 	} else {
-		yyb773 = r.CheckBreak()
+		yyb834 = r.CheckBreak()
 	}
-	if yyb773 {
+	if yyb834 {
 		r.ReadEnd()
 		return
 	}
@@ -8608,13 +9229,13 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Active = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj773++
-	if yyhl773 {
-		yyb773 = yyj773 > l
+	yyj834++
+	if yyhl834 {
+		yyb834 = yyj834 > l
 	} else {
-		yyb773 = r.CheckBreak()
+		yyb834 = r.CheckBreak()
 	}
-	if yyb773 {
+	if yyb834 {
 		r.ReadEnd()
 		return
 	}
@@ -8623,13 +9244,13 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Succeeded = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj773++
-	if yyhl773 {
-		yyb773 = yyj773 > l
+	yyj834++
+	if yyhl834 {
+		yyb834 = yyj834 > l
 	} else {
-		yyb773 = r.CheckBreak()
+		yyb834 = r.CheckBreak()
 	}
-	if yyb773 {
+	if yyb834 {
 		r.ReadEnd()
 		return
 	}
@@ -8639,16 +9260,16 @@ func (x *JobStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		x.Failed = int(r.DecodeInt(codecSelferBitsize1234))
 	}
 	for {
-		yyj773++
-		if yyhl773 {
-			yyb773 = yyj773 > l
+		yyj834++
+		if yyhl834 {
+			yyb834 = yyj834 > l
 		} else {
-			yyb773 = r.CheckBreak()
+			yyb834 = r.CheckBreak()
 		}
-		if yyb773 {
+		if yyb834 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj773-1, "")
+		z.DecStructFieldNotFound(yyj834-1, "")
 	}
 	r.ReadEnd()
 }
@@ -8657,8 +9278,8 @@ func (x JobConditionType) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	yym783 := z.EncBinary()
-	_ = yym783
+	yym844 := z.EncBinary()
+	_ = yym844
 	if false {
 	} else if z.HasExtensions() && z.EncExt(x) {
 	} else {
@@ -8670,8 +9291,8 @@ func (x *JobConditionType) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym784 := z.DecBinary()
-	_ = yym784
+	yym845 := z.DecBinary()
+	_ = yym845
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
@@ -8686,40 +9307,40 @@ func (x *JobCondition) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym785 := z.EncBinary()
-		_ = yym785
+		yym846 := z.EncBinary()
+		_ = yym846
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep786 := !z.EncBinary()
-			yy2arr786 := z.EncBasicHandle().StructToArray
-			var yyq786 [6]bool
-			_, _, _ = yysep786, yyq786, yy2arr786
-			const yyr786 bool = false
-			yyq786[2] = true
-			yyq786[3] = true
-			yyq786[4] = x.Reason != ""
-			yyq786[5] = x.Message != ""
-			if yyr786 || yy2arr786 {
+			yysep847 := !z.EncBinary()
+			yy2arr847 := z.EncBasicHandle().StructToArray
+			var yyq847 [6]bool
+			_, _, _ = yysep847, yyq847, yy2arr847
+			const yyr847 bool = false
+			yyq847[2] = true
+			yyq847[3] = true
+			yyq847[4] = x.Reason != ""
+			yyq847[5] = x.Message != ""
+			if yyr847 || yy2arr847 {
 				r.EncodeArrayStart(6)
 			} else {
-				var yynn786 int = 2
-				for _, b := range yyq786 {
+				var yynn847 int = 2
+				for _, b := range yyq847 {
 					if b {
-						yynn786++
+						yynn847++
 					}
 				}
-				r.EncodeMapStart(yynn786)
+				r.EncodeMapStart(yynn847)
 			}
-			if yyr786 || yy2arr786 {
+			if yyr847 || yy2arr847 {
 				x.Type.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("type"))
 				x.Type.CodecEncodeSelf(e)
 			}
-			if yyr786 || yy2arr786 {
-				yym789 := z.EncBinary()
-				_ = yym789
+			if yyr847 || yy2arr847 {
+				yym850 := z.EncBinary()
+				_ = yym850
 				if false {
 				} else if z.HasExtensions() && z.EncExt(x.Status) {
 				} else {
@@ -8727,86 +9348,86 @@ func (x *JobCondition) CodecEncodeSelf(e *codec1978.Encoder) {
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("status"))
-				yym790 := z.EncBinary()
-				_ = yym790
+				yym851 := z.EncBinary()
+				_ = yym851
 				if false {
 				} else if z.HasExtensions() && z.EncExt(x.Status) {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.Status))
 				}
 			}
-			if yyr786 || yy2arr786 {
-				if yyq786[2] {
-					yy792 := &x.LastProbeTime
-					yym793 := z.EncBinary()
-					_ = yym793
+			if yyr847 || yy2arr847 {
+				if yyq847[2] {
+					yy853 := &x.LastProbeTime
+					yym854 := z.EncBinary()
+					_ = yym854
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy792) {
-					} else if yym793 {
-						z.EncBinaryMarshal(yy792)
-					} else if !yym793 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy792)
+					} else if z.HasExtensions() && z.EncExt(yy853) {
+					} else if yym854 {
+						z.EncBinaryMarshal(yy853)
+					} else if !yym854 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy853)
 					} else {
-						z.EncFallback(yy792)
+						z.EncFallback(yy853)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq786[2] {
+				if yyq847[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("lastProbeTime"))
-					yy794 := &x.LastProbeTime
-					yym795 := z.EncBinary()
-					_ = yym795
+					yy855 := &x.LastProbeTime
+					yym856 := z.EncBinary()
+					_ = yym856
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy794) {
-					} else if yym795 {
-						z.EncBinaryMarshal(yy794)
-					} else if !yym795 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy794)
+					} else if z.HasExtensions() && z.EncExt(yy855) {
+					} else if yym856 {
+						z.EncBinaryMarshal(yy855)
+					} else if !yym856 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy855)
 					} else {
-						z.EncFallback(yy794)
+						z.EncFallback(yy855)
 					}
 				}
 			}
-			if yyr786 || yy2arr786 {
-				if yyq786[3] {
-					yy797 := &x.LastTransitionTime
-					yym798 := z.EncBinary()
-					_ = yym798
+			if yyr847 || yy2arr847 {
+				if yyq847[3] {
+					yy858 := &x.LastTransitionTime
+					yym859 := z.EncBinary()
+					_ = yym859
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy797) {
-					} else if yym798 {
-						z.EncBinaryMarshal(yy797)
-					} else if !yym798 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy797)
+					} else if z.HasExtensions() && z.EncExt(yy858) {
+					} else if yym859 {
+						z.EncBinaryMarshal(yy858)
+					} else if !yym859 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy858)
 					} else {
-						z.EncFallback(yy797)
+						z.EncFallback(yy858)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq786[3] {
+				if yyq847[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("lastTransitionTime"))
-					yy799 := &x.LastTransitionTime
-					yym800 := z.EncBinary()
-					_ = yym800
+					yy860 := &x.LastTransitionTime
+					yym861 := z.EncBinary()
+					_ = yym861
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy799) {
-					} else if yym800 {
-						z.EncBinaryMarshal(yy799)
-					} else if !yym800 && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy799)
+					} else if z.HasExtensions() && z.EncExt(yy860) {
+					} else if yym861 {
+						z.EncBinaryMarshal(yy860)
+					} else if !yym861 && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy860)
 					} else {
-						z.EncFallback(yy799)
+						z.EncFallback(yy860)
 					}
 				}
 			}
-			if yyr786 || yy2arr786 {
-				if yyq786[4] {
-					yym802 := z.EncBinary()
-					_ = yym802
+			if yyr847 || yy2arr847 {
+				if yyq847[4] {
+					yym863 := z.EncBinary()
+					_ = yym863
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Reason))
@@ -8815,20 +9436,20 @@ func (x *JobCondition) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq786[4] {
+				if yyq847[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("reason"))
-					yym803 := z.EncBinary()
-					_ = yym803
+					yym864 := z.EncBinary()
+					_ = yym864
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Reason))
 					}
 				}
 			}
-			if yyr786 || yy2arr786 {
-				if yyq786[5] {
-					yym805 := z.EncBinary()
-					_ = yym805
+			if yyr847 || yy2arr847 {
+				if yyq847[5] {
+					yym866 := z.EncBinary()
+					_ = yym866
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Message))
@@ -8837,17 +9458,17 @@ func (x *JobCondition) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq786[5] {
+				if yyq847[5] {
 					r.EncodeString(codecSelferC_UTF81234, string("message"))
-					yym806 := z.EncBinary()
-					_ = yym806
+					yym867 := z.EncBinary()
+					_ = yym867
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Message))
 					}
 				}
 			}
-			if yysep786 {
+			if yysep847 {
 				r.EncodeEnd()
 			}
 		}
@@ -8858,24 +9479,24 @@ func (x *JobCondition) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym807 := z.DecBinary()
-	_ = yym807
+	yym868 := z.DecBinary()
+	_ = yym868
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl808 := r.ReadMapStart()
-			if yyl808 == 0 {
+			yyl869 := r.ReadMapStart()
+			if yyl869 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl808, d)
+				x.codecDecodeSelfFromMap(yyl869, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl808 := r.ReadArrayStart()
-			if yyl808 == 0 {
+			yyl869 := r.ReadArrayStart()
+			if yyl869 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl808, d)
+				x.codecDecodeSelfFromArray(yyl869, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -8887,12 +9508,12 @@ func (x *JobCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys809Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys809Slc
-	var yyhl809 bool = l >= 0
-	for yyj809 := 0; ; yyj809++ {
-		if yyhl809 {
-			if yyj809 >= l {
+	var yys870Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys870Slc
+	var yyhl870 bool = l >= 0
+	for yyj870 := 0; ; yyj870++ {
+		if yyhl870 {
+			if yyj870 >= l {
 				break
 			}
 		} else {
@@ -8900,9 +9521,9 @@ func (x *JobCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys809Slc = r.DecodeBytes(yys809Slc, true, true)
-		yys809 := string(yys809Slc)
-		switch yys809 {
+		yys870Slc = r.DecodeBytes(yys870Slc, true, true)
+		yys870 := string(yys870Slc)
+		switch yys870 {
 		case "type":
 			if r.TryDecodeAsNil() {
 				x.Type = ""
@@ -8919,34 +9540,34 @@ func (x *JobCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.LastProbeTime = pkg1_unversioned.Time{}
 			} else {
-				yyv812 := &x.LastProbeTime
-				yym813 := z.DecBinary()
-				_ = yym813
+				yyv873 := &x.LastProbeTime
+				yym874 := z.DecBinary()
+				_ = yym874
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv812) {
-				} else if yym813 {
-					z.DecBinaryUnmarshal(yyv812)
-				} else if !yym813 && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(yyv812)
+				} else if z.HasExtensions() && z.DecExt(yyv873) {
+				} else if yym874 {
+					z.DecBinaryUnmarshal(yyv873)
+				} else if !yym874 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv873)
 				} else {
-					z.DecFallback(yyv812, false)
+					z.DecFallback(yyv873, false)
 				}
 			}
 		case "lastTransitionTime":
 			if r.TryDecodeAsNil() {
 				x.LastTransitionTime = pkg1_unversioned.Time{}
 			} else {
-				yyv814 := &x.LastTransitionTime
-				yym815 := z.DecBinary()
-				_ = yym815
+				yyv875 := &x.LastTransitionTime
+				yym876 := z.DecBinary()
+				_ = yym876
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv814) {
-				} else if yym815 {
-					z.DecBinaryUnmarshal(yyv814)
-				} else if !yym815 && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(yyv814)
+				} else if z.HasExtensions() && z.DecExt(yyv875) {
+				} else if yym876 {
+					z.DecBinaryUnmarshal(yyv875)
+				} else if !yym876 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv875)
 				} else {
-					z.DecFallback(yyv814, false)
+					z.DecFallback(yyv875, false)
 				}
 			}
 		case "reason":
@@ -8962,10 +9583,10 @@ func (x *JobCondition) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.Message = string(r.DecodeString())
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys809)
-		} // end switch yys809
-	} // end for yyj809
-	if !yyhl809 {
+			z.DecStructFieldNotFound(-1, yys870)
+		} // end switch yys870
+	} // end for yyj870
+	if !yyhl870 {
 		r.ReadEnd()
 	}
 }
@@ -8974,16 +9595,16 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj818 int
-	var yyb818 bool
-	var yyhl818 bool = l >= 0
-	yyj818++
-	if yyhl818 {
-		yyb818 = yyj818 > l
+	var yyj879 int
+	var yyb879 bool
+	var yyhl879 bool = l >= 0
+	yyj879++
+	if yyhl879 {
+		yyb879 = yyj879 > l
 	} else {
-		yyb818 = r.CheckBreak()
+		yyb879 = r.CheckBreak()
 	}
-	if yyb818 {
+	if yyb879 {
 		r.ReadEnd()
 		return
 	}
@@ -8992,13 +9613,13 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Type = JobConditionType(r.DecodeString())
 	}
-	yyj818++
-	if yyhl818 {
-		yyb818 = yyj818 > l
+	yyj879++
+	if yyhl879 {
+		yyb879 = yyj879 > l
 	} else {
-		yyb818 = r.CheckBreak()
+		yyb879 = r.CheckBreak()
 	}
-	if yyb818 {
+	if yyb879 {
 		r.ReadEnd()
 		return
 	}
@@ -9007,65 +9628,65 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Status = pkg2_v1.ConditionStatus(r.DecodeString())
 	}
-	yyj818++
-	if yyhl818 {
-		yyb818 = yyj818 > l
+	yyj879++
+	if yyhl879 {
+		yyb879 = yyj879 > l
 	} else {
-		yyb818 = r.CheckBreak()
+		yyb879 = r.CheckBreak()
 	}
-	if yyb818 {
+	if yyb879 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.LastProbeTime = pkg1_unversioned.Time{}
 	} else {
-		yyv821 := &x.LastProbeTime
-		yym822 := z.DecBinary()
-		_ = yym822
+		yyv882 := &x.LastProbeTime
+		yym883 := z.DecBinary()
+		_ = yym883
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv821) {
-		} else if yym822 {
-			z.DecBinaryUnmarshal(yyv821)
-		} else if !yym822 && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(yyv821)
+		} else if z.HasExtensions() && z.DecExt(yyv882) {
+		} else if yym883 {
+			z.DecBinaryUnmarshal(yyv882)
+		} else if !yym883 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv882)
 		} else {
-			z.DecFallback(yyv821, false)
+			z.DecFallback(yyv882, false)
 		}
 	}
-	yyj818++
-	if yyhl818 {
-		yyb818 = yyj818 > l
+	yyj879++
+	if yyhl879 {
+		yyb879 = yyj879 > l
 	} else {
-		yyb818 = r.CheckBreak()
+		yyb879 = r.CheckBreak()
 	}
-	if yyb818 {
+	if yyb879 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.LastTransitionTime = pkg1_unversioned.Time{}
 	} else {
-		yyv823 := &x.LastTransitionTime
-		yym824 := z.DecBinary()
-		_ = yym824
+		yyv884 := &x.LastTransitionTime
+		yym885 := z.DecBinary()
+		_ = yym885
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv823) {
-		} else if yym824 {
-			z.DecBinaryUnmarshal(yyv823)
-		} else if !yym824 && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(yyv823)
+		} else if z.HasExtensions() && z.DecExt(yyv884) {
+		} else if yym885 {
+			z.DecBinaryUnmarshal(yyv884)
+		} else if !yym885 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv884)
 		} else {
-			z.DecFallback(yyv823, false)
+			z.DecFallback(yyv884, false)
 		}
 	}
-	yyj818++
-	if yyhl818 {
-		yyb818 = yyj818 > l
+	yyj879++
+	if yyhl879 {
+		yyb879 = yyj879 > l
 	} else {
-		yyb818 = r.CheckBreak()
+		yyb879 = r.CheckBreak()
 	}
-	if yyb818 {
+	if yyb879 {
 		r.ReadEnd()
 		return
 	}
@@ -9074,13 +9695,13 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Reason = string(r.DecodeString())
 	}
-	yyj818++
-	if yyhl818 {
-		yyb818 = yyj818 > l
+	yyj879++
+	if yyhl879 {
+		yyb879 = yyj879 > l
 	} else {
-		yyb818 = r.CheckBreak()
+		yyb879 = r.CheckBreak()
 	}
-	if yyb818 {
+	if yyb879 {
 		r.ReadEnd()
 		return
 	}
@@ -9090,16 +9711,16 @@ func (x *JobCondition) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		x.Message = string(r.DecodeString())
 	}
 	for {
-		yyj818++
-		if yyhl818 {
-			yyb818 = yyj818 > l
+		yyj879++
+		if yyhl879 {
+			yyb879 = yyj879 > l
 		} else {
-			yyb818 = r.CheckBreak()
+			yyb879 = r.CheckBreak()
 		}
-		if yyb818 {
+		if yyb879 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj818-1, "")
+		z.DecStructFieldNotFound(yyj879-1, "")
 	}
 	r.ReadEnd()
 }
@@ -9111,36 +9732,36 @@ func (x *Ingress) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym827 := z.EncBinary()
-		_ = yym827
+		yym888 := z.EncBinary()
+		_ = yym888
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep828 := !z.EncBinary()
-			yy2arr828 := z.EncBasicHandle().StructToArray
-			var yyq828 [5]bool
-			_, _, _ = yysep828, yyq828, yy2arr828
-			const yyr828 bool = false
-			yyq828[0] = x.Kind != ""
-			yyq828[1] = x.APIVersion != ""
-			yyq828[2] = true
-			yyq828[3] = true
-			yyq828[4] = true
-			if yyr828 || yy2arr828 {
+			yysep889 := !z.EncBinary()
+			yy2arr889 := z.EncBasicHandle().StructToArray
+			var yyq889 [5]bool
+			_, _, _ = yysep889, yyq889, yy2arr889
+			const yyr889 bool = false
+			yyq889[0] = x.Kind != ""
+			yyq889[1] = x.APIVersion != ""
+			yyq889[2] = true
+			yyq889[3] = true
+			yyq889[4] = true
+			if yyr889 || yy2arr889 {
 				r.EncodeArrayStart(5)
 			} else {
-				var yynn828 int = 0
-				for _, b := range yyq828 {
+				var yynn889 int = 0
+				for _, b := range yyq889 {
 					if b {
-						yynn828++
+						yynn889++
 					}
 				}
-				r.EncodeMapStart(yynn828)
+				r.EncodeMapStart(yynn889)
 			}
-			if yyr828 || yy2arr828 {
-				if yyq828[0] {
-					yym830 := z.EncBinary()
-					_ = yym830
+			if yyr889 || yy2arr889 {
+				if yyq889[0] {
+					yym891 := z.EncBinary()
+					_ = yym891
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -9149,20 +9770,20 @@ func (x *Ingress) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq828[0] {
+				if yyq889[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym831 := z.EncBinary()
-					_ = yym831
+					yym892 := z.EncBinary()
+					_ = yym892
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr828 || yy2arr828 {
-				if yyq828[1] {
-					yym833 := z.EncBinary()
-					_ = yym833
+			if yyr889 || yy2arr889 {
+				if yyq889[1] {
+					yym894 := z.EncBinary()
+					_ = yym894
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -9171,59 +9792,59 @@ func (x *Ingress) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq828[1] {
+				if yyq889[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym834 := z.EncBinary()
-					_ = yym834
+					yym895 := z.EncBinary()
+					_ = yym895
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr828 || yy2arr828 {
-				if yyq828[2] {
-					yy836 := &x.ObjectMeta
-					yy836.CodecEncodeSelf(e)
+			if yyr889 || yy2arr889 {
+				if yyq889[2] {
+					yy897 := &x.ObjectMeta
+					yy897.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq828[2] {
+				if yyq889[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy837 := &x.ObjectMeta
-					yy837.CodecEncodeSelf(e)
+					yy898 := &x.ObjectMeta
+					yy898.CodecEncodeSelf(e)
 				}
 			}
-			if yyr828 || yy2arr828 {
-				if yyq828[3] {
-					yy839 := &x.Spec
-					yy839.CodecEncodeSelf(e)
+			if yyr889 || yy2arr889 {
+				if yyq889[3] {
+					yy900 := &x.Spec
+					yy900.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq828[3] {
+				if yyq889[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("spec"))
-					yy840 := &x.Spec
-					yy840.CodecEncodeSelf(e)
+					yy901 := &x.Spec
+					yy901.CodecEncodeSelf(e)
 				}
 			}
-			if yyr828 || yy2arr828 {
-				if yyq828[4] {
-					yy842 := &x.Status
-					yy842.CodecEncodeSelf(e)
+			if yyr889 || yy2arr889 {
+				if yyq889[4] {
+					yy903 := &x.Status
+					yy903.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq828[4] {
+				if yyq889[4] {
 					r.EncodeString(codecSelferC_UTF81234, string("status"))
-					yy843 := &x.Status
-					yy843.CodecEncodeSelf(e)
+					yy904 := &x.Status
+					yy904.CodecEncodeSelf(e)
 				}
 			}
-			if yysep828 {
+			if yysep889 {
 				r.EncodeEnd()
 			}
 		}
@@ -9234,24 +9855,24 @@ func (x *Ingress) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym844 := z.DecBinary()
-	_ = yym844
+	yym905 := z.DecBinary()
+	_ = yym905
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl845 := r.ReadMapStart()
-			if yyl845 == 0 {
+			yyl906 := r.ReadMapStart()
+			if yyl906 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl845, d)
+				x.codecDecodeSelfFromMap(yyl906, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl845 := r.ReadArrayStart()
-			if yyl845 == 0 {
+			yyl906 := r.ReadArrayStart()
+			if yyl906 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl845, d)
+				x.codecDecodeSelfFromArray(yyl906, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -9263,12 +9884,12 @@ func (x *Ingress) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys846Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys846Slc
-	var yyhl846 bool = l >= 0
-	for yyj846 := 0; ; yyj846++ {
-		if yyhl846 {
-			if yyj846 >= l {
+	var yys907Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys907Slc
+	var yyhl907 bool = l >= 0
+	for yyj907 := 0; ; yyj907++ {
+		if yyhl907 {
+			if yyj907 >= l {
 				break
 			}
 		} else {
@@ -9276,9 +9897,9 @@ func (x *Ingress) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys846Slc = r.DecodeBytes(yys846Slc, true, true)
-		yys846 := string(yys846Slc)
-		switch yys846 {
+		yys907Slc = r.DecodeBytes(yys907Slc, true, true)
+		yys907 := string(yys907Slc)
+		switch yys907 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -9295,28 +9916,28 @@ func (x *Ingress) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ObjectMeta = pkg2_v1.ObjectMeta{}
 			} else {
-				yyv849 := &x.ObjectMeta
-				yyv849.CodecDecodeSelf(d)
+				yyv910 := &x.ObjectMeta
+				yyv910.CodecDecodeSelf(d)
 			}
 		case "spec":
 			if r.TryDecodeAsNil() {
 				x.Spec = IngressSpec{}
 			} else {
-				yyv850 := &x.Spec
-				yyv850.CodecDecodeSelf(d)
+				yyv911 := &x.Spec
+				yyv911.CodecDecodeSelf(d)
 			}
 		case "status":
 			if r.TryDecodeAsNil() {
 				x.Status = IngressStatus{}
 			} else {
-				yyv851 := &x.Status
-				yyv851.CodecDecodeSelf(d)
+				yyv912 := &x.Status
+				yyv912.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys846)
-		} // end switch yys846
-	} // end for yyj846
-	if !yyhl846 {
+			z.DecStructFieldNotFound(-1, yys907)
+		} // end switch yys907
+	} // end for yyj907
+	if !yyhl907 {
 		r.ReadEnd()
 	}
 }
@@ -9325,16 +9946,16 @@ func (x *Ingress) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj852 int
-	var yyb852 bool
-	var yyhl852 bool = l >= 0
-	yyj852++
-	if yyhl852 {
-		yyb852 = yyj852 > l
+	var yyj913 int
+	var yyb913 bool
+	var yyhl913 bool = l >= 0
+	yyj913++
+	if yyhl913 {
+		yyb913 = yyj913 > l
 	} else {
-		yyb852 = r.CheckBreak()
+		yyb913 = r.CheckBreak()
 	}
-	if yyb852 {
+	if yyb913 {
 		r.ReadEnd()
 		return
 	}
@@ -9343,13 +9964,13 @@ func (x *Ingress) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj852++
-	if yyhl852 {
-		yyb852 = yyj852 > l
+	yyj913++
+	if yyhl913 {
+		yyb913 = yyj913 > l
 	} else {
-		yyb852 = r.CheckBreak()
+		yyb913 = r.CheckBreak()
 	}
-	if yyb852 {
+	if yyb913 {
 		r.ReadEnd()
 		return
 	}
@@ -9358,65 +9979,65 @@ func (x *Ingress) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj852++
-	if yyhl852 {
-		yyb852 = yyj852 > l
+	yyj913++
+	if yyhl913 {
+		yyb913 = yyj913 > l
 	} else {
-		yyb852 = r.CheckBreak()
+		yyb913 = r.CheckBreak()
 	}
-	if yyb852 {
+	if yyb913 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ObjectMeta = pkg2_v1.ObjectMeta{}
 	} else {
-		yyv855 := &x.ObjectMeta
-		yyv855.CodecDecodeSelf(d)
+		yyv916 := &x.ObjectMeta
+		yyv916.CodecDecodeSelf(d)
 	}
-	yyj852++
-	if yyhl852 {
-		yyb852 = yyj852 > l
+	yyj913++
+	if yyhl913 {
+		yyb913 = yyj913 > l
 	} else {
-		yyb852 = r.CheckBreak()
+		yyb913 = r.CheckBreak()
 	}
-	if yyb852 {
+	if yyb913 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Spec = IngressSpec{}
 	} else {
-		yyv856 := &x.Spec
-		yyv856.CodecDecodeSelf(d)
+		yyv917 := &x.Spec
+		yyv917.CodecDecodeSelf(d)
 	}
-	yyj852++
-	if yyhl852 {
-		yyb852 = yyj852 > l
+	yyj913++
+	if yyhl913 {
+		yyb913 = yyj913 > l
 	} else {
-		yyb852 = r.CheckBreak()
+		yyb913 = r.CheckBreak()
 	}
-	if yyb852 {
+	if yyb913 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Status = IngressStatus{}
 	} else {
-		yyv857 := &x.Status
-		yyv857.CodecDecodeSelf(d)
+		yyv918 := &x.Status
+		yyv918.CodecDecodeSelf(d)
 	}
 	for {
-		yyj852++
-		if yyhl852 {
-			yyb852 = yyj852 > l
+		yyj913++
+		if yyhl913 {
+			yyb913 = yyj913 > l
 		} else {
-			yyb852 = r.CheckBreak()
+			yyb913 = r.CheckBreak()
 		}
-		if yyb852 {
+		if yyb913 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj852-1, "")
+		z.DecStructFieldNotFound(yyj913-1, "")
 	}
 	r.ReadEnd()
 }
@@ -9428,34 +10049,34 @@ func (x *IngressList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym858 := z.EncBinary()
-		_ = yym858
+		yym919 := z.EncBinary()
+		_ = yym919
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep859 := !z.EncBinary()
-			yy2arr859 := z.EncBasicHandle().StructToArray
-			var yyq859 [4]bool
-			_, _, _ = yysep859, yyq859, yy2arr859
-			const yyr859 bool = false
-			yyq859[0] = x.Kind != ""
-			yyq859[1] = x.APIVersion != ""
-			yyq859[2] = true
-			if yyr859 || yy2arr859 {
+			yysep920 := !z.EncBinary()
+			yy2arr920 := z.EncBasicHandle().StructToArray
+			var yyq920 [4]bool
+			_, _, _ = yysep920, yyq920, yy2arr920
+			const yyr920 bool = false
+			yyq920[0] = x.Kind != ""
+			yyq920[1] = x.APIVersion != ""
+			yyq920[2] = true
+			if yyr920 || yy2arr920 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn859 int = 1
-				for _, b := range yyq859 {
+				var yynn920 int = 1
+				for _, b := range yyq920 {
 					if b {
-						yynn859++
+						yynn920++
 					}
 				}
-				r.EncodeMapStart(yynn859)
+				r.EncodeMapStart(yynn920)
 			}
-			if yyr859 || yy2arr859 {
-				if yyq859[0] {
-					yym861 := z.EncBinary()
-					_ = yym861
+			if yyr920 || yy2arr920 {
+				if yyq920[0] {
+					yym922 := z.EncBinary()
+					_ = yym922
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -9464,20 +10085,20 @@ func (x *IngressList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq859[0] {
+				if yyq920[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym862 := z.EncBinary()
-					_ = yym862
+					yym923 := z.EncBinary()
+					_ = yym923
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr859 || yy2arr859 {
-				if yyq859[1] {
-					yym864 := z.EncBinary()
-					_ = yym864
+			if yyr920 || yy2arr920 {
+				if yyq920[1] {
+					yym925 := z.EncBinary()
+					_ = yym925
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -9486,48 +10107,48 @@ func (x *IngressList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq859[1] {
+				if yyq920[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym865 := z.EncBinary()
-					_ = yym865
+					yym926 := z.EncBinary()
+					_ = yym926
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr859 || yy2arr859 {
-				if yyq859[2] {
-					yy867 := &x.ListMeta
-					yym868 := z.EncBinary()
-					_ = yym868
+			if yyr920 || yy2arr920 {
+				if yyq920[2] {
+					yy928 := &x.ListMeta
+					yym929 := z.EncBinary()
+					_ = yym929
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy867) {
+					} else if z.HasExtensions() && z.EncExt(yy928) {
 					} else {
-						z.EncFallback(yy867)
+						z.EncFallback(yy928)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq859[2] {
+				if yyq920[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy869 := &x.ListMeta
-					yym870 := z.EncBinary()
-					_ = yym870
+					yy930 := &x.ListMeta
+					yym931 := z.EncBinary()
+					_ = yym931
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy869) {
+					} else if z.HasExtensions() && z.EncExt(yy930) {
 					} else {
-						z.EncFallback(yy869)
+						z.EncFallback(yy930)
 					}
 				}
 			}
-			if yyr859 || yy2arr859 {
+			if yyr920 || yy2arr920 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym872 := z.EncBinary()
-					_ = yym872
+					yym933 := z.EncBinary()
+					_ = yym933
 					if false {
 					} else {
 						h.encSliceIngress(([]Ingress)(x.Items), e)
@@ -9538,15 +10159,15 @@ func (x *IngressList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym873 := z.EncBinary()
-					_ = yym873
+					yym934 := z.EncBinary()
+					_ = yym934
 					if false {
 					} else {
 						h.encSliceIngress(([]Ingress)(x.Items), e)
 					}
 				}
 			}
-			if yysep859 {
+			if yysep920 {
 				r.EncodeEnd()
 			}
 		}
@@ -9557,24 +10178,24 @@ func (x *IngressList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym874 := z.DecBinary()
-	_ = yym874
+	yym935 := z.DecBinary()
+	_ = yym935
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl875 := r.ReadMapStart()
-			if yyl875 == 0 {
+			yyl936 := r.ReadMapStart()
+			if yyl936 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl875, d)
+				x.codecDecodeSelfFromMap(yyl936, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl875 := r.ReadArrayStart()
-			if yyl875 == 0 {
+			yyl936 := r.ReadArrayStart()
+			if yyl936 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl875, d)
+				x.codecDecodeSelfFromArray(yyl936, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -9586,12 +10207,12 @@ func (x *IngressList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys876Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys876Slc
-	var yyhl876 bool = l >= 0
-	for yyj876 := 0; ; yyj876++ {
-		if yyhl876 {
-			if yyj876 >= l {
+	var yys937Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys937Slc
+	var yyhl937 bool = l >= 0
+	for yyj937 := 0; ; yyj937++ {
+		if yyhl937 {
+			if yyj937 >= l {
 				break
 			}
 		} else {
@@ -9599,9 +10220,9 @@ func (x *IngressList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys876Slc = r.DecodeBytes(yys876Slc, true, true)
-		yys876 := string(yys876Slc)
-		switch yys876 {
+		yys937Slc = r.DecodeBytes(yys937Slc, true, true)
+		yys937 := string(yys937Slc)
+		switch yys937 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -9618,32 +10239,32 @@ func (x *IngressList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv879 := &x.ListMeta
-				yym880 := z.DecBinary()
-				_ = yym880
+				yyv940 := &x.ListMeta
+				yym941 := z.DecBinary()
+				_ = yym941
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv879) {
+				} else if z.HasExtensions() && z.DecExt(yyv940) {
 				} else {
-					z.DecFallback(yyv879, false)
+					z.DecFallback(yyv940, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv881 := &x.Items
-				yym882 := z.DecBinary()
-				_ = yym882
+				yyv942 := &x.Items
+				yym943 := z.DecBinary()
+				_ = yym943
 				if false {
 				} else {
-					h.decSliceIngress((*[]Ingress)(yyv881), d)
+					h.decSliceIngress((*[]Ingress)(yyv942), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys876)
-		} // end switch yys876
-	} // end for yyj876
-	if !yyhl876 {
+			z.DecStructFieldNotFound(-1, yys937)
+		} // end switch yys937
+	} // end for yyj937
+	if !yyhl937 {
 		r.ReadEnd()
 	}
 }
@@ -9652,16 +10273,16 @@ func (x *IngressList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj883 int
-	var yyb883 bool
-	var yyhl883 bool = l >= 0
-	yyj883++
-	if yyhl883 {
-		yyb883 = yyj883 > l
+	var yyj944 int
+	var yyb944 bool
+	var yyhl944 bool = l >= 0
+	yyj944++
+	if yyhl944 {
+		yyb944 = yyj944 > l
 	} else {
-		yyb883 = r.CheckBreak()
+		yyb944 = r.CheckBreak()
 	}
-	if yyb883 {
+	if yyb944 {
 		r.ReadEnd()
 		return
 	}
@@ -9670,13 +10291,13 @@ func (x *IngressList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj883++
-	if yyhl883 {
-		yyb883 = yyj883 > l
+	yyj944++
+	if yyhl944 {
+		yyb944 = yyj944 > l
 	} else {
-		yyb883 = r.CheckBreak()
+		yyb944 = r.CheckBreak()
 	}
-	if yyb883 {
+	if yyb944 {
 		r.ReadEnd()
 		return
 	}
@@ -9685,60 +10306,60 @@ func (x *IngressList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj883++
-	if yyhl883 {
-		yyb883 = yyj883 > l
+	yyj944++
+	if yyhl944 {
+		yyb944 = yyj944 > l
 	} else {
-		yyb883 = r.CheckBreak()
+		yyb944 = r.CheckBreak()
 	}
-	if yyb883 {
+	if yyb944 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv886 := &x.ListMeta
-		yym887 := z.DecBinary()
-		_ = yym887
+		yyv947 := &x.ListMeta
+		yym948 := z.DecBinary()
+		_ = yym948
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv886) {
+		} else if z.HasExtensions() && z.DecExt(yyv947) {
 		} else {
-			z.DecFallback(yyv886, false)
+			z.DecFallback(yyv947, false)
 		}
 	}
-	yyj883++
-	if yyhl883 {
-		yyb883 = yyj883 > l
+	yyj944++
+	if yyhl944 {
+		yyb944 = yyj944 > l
 	} else {
-		yyb883 = r.CheckBreak()
+		yyb944 = r.CheckBreak()
 	}
-	if yyb883 {
+	if yyb944 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv888 := &x.Items
-		yym889 := z.DecBinary()
-		_ = yym889
+		yyv949 := &x.Items
+		yym950 := z.DecBinary()
+		_ = yym950
 		if false {
 		} else {
-			h.decSliceIngress((*[]Ingress)(yyv888), d)
+			h.decSliceIngress((*[]Ingress)(yyv949), d)
 		}
 	}
 	for {
-		yyj883++
-		if yyhl883 {
-			yyb883 = yyj883 > l
+		yyj944++
+		if yyhl944 {
+			yyb944 = yyj944 > l
 		} else {
-			yyb883 = r.CheckBreak()
+			yyb944 = r.CheckBreak()
 		}
-		if yyb883 {
+		if yyb944 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj883-1, "")
+		z.DecStructFieldNotFound(yyj944-1, "")
 	}
 	r.ReadEnd()
 }
@@ -9750,31 +10371,31 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym890 := z.EncBinary()
-		_ = yym890
+		yym951 := z.EncBinary()
+		_ = yym951
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep891 := !z.EncBinary()
-			yy2arr891 := z.EncBasicHandle().StructToArray
-			var yyq891 [2]bool
-			_, _, _ = yysep891, yyq891, yy2arr891
-			const yyr891 bool = false
-			yyq891[0] = x.Backend != nil
-			yyq891[1] = len(x.Rules) != 0
-			if yyr891 || yy2arr891 {
+			yysep952 := !z.EncBinary()
+			yy2arr952 := z.EncBasicHandle().StructToArray
+			var yyq952 [2]bool
+			_, _, _ = yysep952, yyq952, yy2arr952
+			const yyr952 bool = false
+			yyq952[0] = x.Backend != nil
+			yyq952[1] = len(x.Rules) != 0
+			if yyr952 || yy2arr952 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn891 int = 0
-				for _, b := range yyq891 {
+				var yynn952 int = 0
+				for _, b := range yyq952 {
 					if b {
-						yynn891++
+						yynn952++
 					}
 				}
-				r.EncodeMapStart(yynn891)
+				r.EncodeMapStart(yynn952)
 			}
-			if yyr891 || yy2arr891 {
-				if yyq891[0] {
+			if yyr952 || yy2arr952 {
+				if yyq952[0] {
 					if x.Backend == nil {
 						r.EncodeNil()
 					} else {
@@ -9784,7 +10405,7 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq891[0] {
+				if yyq952[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("backend"))
 					if x.Backend == nil {
 						r.EncodeNil()
@@ -9793,13 +10414,13 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr891 || yy2arr891 {
-				if yyq891[1] {
+			if yyr952 || yy2arr952 {
+				if yyq952[1] {
 					if x.Rules == nil {
 						r.EncodeNil()
 					} else {
-						yym894 := z.EncBinary()
-						_ = yym894
+						yym955 := z.EncBinary()
+						_ = yym955
 						if false {
 						} else {
 							h.encSliceIngressRule(([]IngressRule)(x.Rules), e)
@@ -9809,13 +10430,13 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq891[1] {
+				if yyq952[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("rules"))
 					if x.Rules == nil {
 						r.EncodeNil()
 					} else {
-						yym895 := z.EncBinary()
-						_ = yym895
+						yym956 := z.EncBinary()
+						_ = yym956
 						if false {
 						} else {
 							h.encSliceIngressRule(([]IngressRule)(x.Rules), e)
@@ -9823,7 +10444,7 @@ func (x *IngressSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep891 {
+			if yysep952 {
 				r.EncodeEnd()
 			}
 		}
@@ -9834,24 +10455,24 @@ func (x *IngressSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym896 := z.DecBinary()
-	_ = yym896
+	yym957 := z.DecBinary()
+	_ = yym957
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl897 := r.ReadMapStart()
-			if yyl897 == 0 {
+			yyl958 := r.ReadMapStart()
+			if yyl958 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl897, d)
+				x.codecDecodeSelfFromMap(yyl958, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl897 := r.ReadArrayStart()
-			if yyl897 == 0 {
+			yyl958 := r.ReadArrayStart()
+			if yyl958 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl897, d)
+				x.codecDecodeSelfFromArray(yyl958, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -9863,12 +10484,12 @@ func (x *IngressSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys898Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys898Slc
-	var yyhl898 bool = l >= 0
-	for yyj898 := 0; ; yyj898++ {
-		if yyhl898 {
-			if yyj898 >= l {
+	var yys959Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys959Slc
+	var yyhl959 bool = l >= 0
+	for yyj959 := 0; ; yyj959++ {
+		if yyhl959 {
+			if yyj959 >= l {
 				break
 			}
 		} else {
@@ -9876,9 +10497,9 @@ func (x *IngressSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys898Slc = r.DecodeBytes(yys898Slc, true, true)
-		yys898 := string(yys898Slc)
-		switch yys898 {
+		yys959Slc = r.DecodeBytes(yys959Slc, true, true)
+		yys959 := string(yys959Slc)
+		switch yys959 {
 		case "backend":
 			if r.TryDecodeAsNil() {
 				if x.Backend != nil {
@@ -9894,19 +10515,19 @@ func (x *IngressSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.Rules = nil
 			} else {
-				yyv900 := &x.Rules
-				yym901 := z.DecBinary()
-				_ = yym901
+				yyv961 := &x.Rules
+				yym962 := z.DecBinary()
+				_ = yym962
 				if false {
 				} else {
-					h.decSliceIngressRule((*[]IngressRule)(yyv900), d)
+					h.decSliceIngressRule((*[]IngressRule)(yyv961), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys898)
-		} // end switch yys898
-	} // end for yyj898
-	if !yyhl898 {
+			z.DecStructFieldNotFound(-1, yys959)
+		} // end switch yys959
+	} // end for yyj959
+	if !yyhl959 {
 		r.ReadEnd()
 	}
 }
@@ -9915,16 +10536,16 @@ func (x *IngressSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj902 int
-	var yyb902 bool
-	var yyhl902 bool = l >= 0
-	yyj902++
-	if yyhl902 {
-		yyb902 = yyj902 > l
+	var yyj963 int
+	var yyb963 bool
+	var yyhl963 bool = l >= 0
+	yyj963++
+	if yyhl963 {
+		yyb963 = yyj963 > l
 	} else {
-		yyb902 = r.CheckBreak()
+		yyb963 = r.CheckBreak()
 	}
-	if yyb902 {
+	if yyb963 {
 		r.ReadEnd()
 		return
 	}
@@ -9938,38 +10559,38 @@ func (x *IngressSpec) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		}
 		x.Backend.CodecDecodeSelf(d)
 	}
-	yyj902++
-	if yyhl902 {
-		yyb902 = yyj902 > l
+	yyj963++
+	if yyhl963 {
+		yyb963 = yyj963 > l
 	} else {
-		yyb902 = r.CheckBreak()
+		yyb963 = r.CheckBreak()
 	}
-	if yyb902 {
+	if yyb963 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Rules = nil
 	} else {
-		yyv904 := &x.Rules
-		yym905 := z.DecBinary()
-		_ = yym905
+		yyv965 := &x.Rules
+		yym966 := z.DecBinary()
+		_ = yym966
 		if false {
 		} else {
-			h.decSliceIngressRule((*[]IngressRule)(yyv904), d)
+			h.decSliceIngressRule((*[]IngressRule)(yyv965), d)
 		}
 	}
 	for {
-		yyj902++
-		if yyhl902 {
-			yyb902 = yyj902 > l
+		yyj963++
+		if yyhl963 {
+			yyb963 = yyj963 > l
 		} else {
-			yyb902 = r.CheckBreak()
+			yyb963 = r.CheckBreak()
 		}
-		if yyb902 {
+		if yyb963 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj902-1, "")
+		z.DecStructFieldNotFound(yyj963-1, "")
 	}
 	r.ReadEnd()
 }
@@ -9981,43 +10602,43 @@ func (x *IngressStatus) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym906 := z.EncBinary()
-		_ = yym906
+		yym967 := z.EncBinary()
+		_ = yym967
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep907 := !z.EncBinary()
-			yy2arr907 := z.EncBasicHandle().StructToArray
-			var yyq907 [1]bool
-			_, _, _ = yysep907, yyq907, yy2arr907
-			const yyr907 bool = false
-			yyq907[0] = true
-			if yyr907 || yy2arr907 {
+			yysep968 := !z.EncBinary()
+			yy2arr968 := z.EncBasicHandle().StructToArray
+			var yyq968 [1]bool
+			_, _, _ = yysep968, yyq968, yy2arr968
+			const yyr968 bool = false
+			yyq968[0] = true
+			if yyr968 || yy2arr968 {
 				r.EncodeArrayStart(1)
 			} else {
-				var yynn907 int = 0
-				for _, b := range yyq907 {
+				var yynn968 int = 0
+				for _, b := range yyq968 {
 					if b {
-						yynn907++
+						yynn968++
 					}
 				}
-				r.EncodeMapStart(yynn907)
+				r.EncodeMapStart(yynn968)
 			}
-			if yyr907 || yy2arr907 {
-				if yyq907[0] {
-					yy909 := &x.LoadBalancer
-					yy909.CodecEncodeSelf(e)
+			if yyr968 || yy2arr968 {
+				if yyq968[0] {
+					yy970 := &x.LoadBalancer
+					yy970.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq907[0] {
+				if yyq968[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("loadBalancer"))
-					yy910 := &x.LoadBalancer
-					yy910.CodecEncodeSelf(e)
+					yy971 := &x.LoadBalancer
+					yy971.CodecEncodeSelf(e)
 				}
 			}
-			if yysep907 {
+			if yysep968 {
 				r.EncodeEnd()
 			}
 		}
@@ -10028,24 +10649,24 @@ func (x *IngressStatus) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym911 := z.DecBinary()
-	_ = yym911
+	yym972 := z.DecBinary()
+	_ = yym972
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl912 := r.ReadMapStart()
-			if yyl912 == 0 {
+			yyl973 := r.ReadMapStart()
+			if yyl973 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl912, d)
+				x.codecDecodeSelfFromMap(yyl973, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl912 := r.ReadArrayStart()
-			if yyl912 == 0 {
+			yyl973 := r.ReadArrayStart()
+			if yyl973 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl912, d)
+				x.codecDecodeSelfFromArray(yyl973, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10057,12 +10678,12 @@ func (x *IngressStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys913Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys913Slc
-	var yyhl913 bool = l >= 0
-	for yyj913 := 0; ; yyj913++ {
-		if yyhl913 {
-			if yyj913 >= l {
+	var yys974Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys974Slc
+	var yyhl974 bool = l >= 0
+	for yyj974 := 0; ; yyj974++ {
+		if yyhl974 {
+			if yyj974 >= l {
 				break
 			}
 		} else {
@@ -10070,21 +10691,21 @@ func (x *IngressStatus) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys913Slc = r.DecodeBytes(yys913Slc, true, true)
-		yys913 := string(yys913Slc)
-		switch yys913 {
+		yys974Slc = r.DecodeBytes(yys974Slc, true, true)
+		yys974 := string(yys974Slc)
+		switch yys974 {
 		case "loadBalancer":
 			if r.TryDecodeAsNil() {
 				x.LoadBalancer = pkg2_v1.LoadBalancerStatus{}
 			} else {
-				yyv914 := &x.LoadBalancer
-				yyv914.CodecDecodeSelf(d)
+				yyv975 := &x.LoadBalancer
+				yyv975.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys913)
-		} // end switch yys913
-	} // end for yyj913
-	if !yyhl913 {
+			z.DecStructFieldNotFound(-1, yys974)
+		} // end switch yys974
+	} // end for yyj974
+	if !yyhl974 {
 		r.ReadEnd()
 	}
 }
@@ -10093,36 +10714,36 @@ func (x *IngressStatus) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj915 int
-	var yyb915 bool
-	var yyhl915 bool = l >= 0
-	yyj915++
-	if yyhl915 {
-		yyb915 = yyj915 > l
+	var yyj976 int
+	var yyb976 bool
+	var yyhl976 bool = l >= 0
+	yyj976++
+	if yyhl976 {
+		yyb976 = yyj976 > l
 	} else {
-		yyb915 = r.CheckBreak()
+		yyb976 = r.CheckBreak()
 	}
-	if yyb915 {
+	if yyb976 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.LoadBalancer = pkg2_v1.LoadBalancerStatus{}
 	} else {
-		yyv916 := &x.LoadBalancer
-		yyv916.CodecDecodeSelf(d)
+		yyv977 := &x.LoadBalancer
+		yyv977.CodecDecodeSelf(d)
 	}
 	for {
-		yyj915++
-		if yyhl915 {
-			yyb915 = yyj915 > l
+		yyj976++
+		if yyhl976 {
+			yyb976 = yyj976 > l
 		} else {
-			yyb915 = r.CheckBreak()
+			yyb976 = r.CheckBreak()
 		}
-		if yyb915 {
+		if yyb976 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj915-1, "")
+		z.DecStructFieldNotFound(yyj976-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10134,33 +10755,33 @@ func (x *IngressRule) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym917 := z.EncBinary()
-		_ = yym917
+		yym978 := z.EncBinary()
+		_ = yym978
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep918 := !z.EncBinary()
-			yy2arr918 := z.EncBasicHandle().StructToArray
-			var yyq918 [2]bool
-			_, _, _ = yysep918, yyq918, yy2arr918
-			const yyr918 bool = false
-			yyq918[0] = x.Host != ""
-			yyq918[1] = x.IngressRuleValue.HTTP != nil && x.HTTP != nil
-			if yyr918 || yy2arr918 {
+			yysep979 := !z.EncBinary()
+			yy2arr979 := z.EncBasicHandle().StructToArray
+			var yyq979 [2]bool
+			_, _, _ = yysep979, yyq979, yy2arr979
+			const yyr979 bool = false
+			yyq979[0] = x.Host != ""
+			yyq979[1] = x.IngressRuleValue.HTTP != nil && x.HTTP != nil
+			if yyr979 || yy2arr979 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn918 int = 0
-				for _, b := range yyq918 {
+				var yynn979 int = 0
+				for _, b := range yyq979 {
 					if b {
-						yynn918++
+						yynn979++
 					}
 				}
-				r.EncodeMapStart(yynn918)
+				r.EncodeMapStart(yynn979)
 			}
-			if yyr918 || yy2arr918 {
-				if yyq918[0] {
-					yym920 := z.EncBinary()
-					_ = yym920
+			if yyr979 || yy2arr979 {
+				if yyq979[0] {
+					yym981 := z.EncBinary()
+					_ = yym981
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Host))
@@ -10169,27 +10790,27 @@ func (x *IngressRule) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq918[0] {
+				if yyq979[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("host"))
-					yym921 := z.EncBinary()
-					_ = yym921
+					yym982 := z.EncBinary()
+					_ = yym982
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Host))
 					}
 				}
 			}
-			var yyn922 bool
+			var yyn983 bool
 			if x.IngressRuleValue.HTTP == nil {
-				yyn922 = true
-				goto LABEL922
+				yyn983 = true
+				goto LABEL983
 			}
-		LABEL922:
-			if yyr918 || yy2arr918 {
-				if yyn922 {
+		LABEL983:
+			if yyr979 || yy2arr979 {
+				if yyn983 {
 					r.EncodeNil()
 				} else {
-					if yyq918[1] {
+					if yyq979[1] {
 						if x.HTTP == nil {
 							r.EncodeNil()
 						} else {
@@ -10200,9 +10821,9 @@ func (x *IngressRule) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			} else {
-				if yyq918[1] {
+				if yyq979[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("http"))
-					if yyn922 {
+					if yyn983 {
 						r.EncodeNil()
 					} else {
 						if x.HTTP == nil {
@@ -10213,7 +10834,7 @@ func (x *IngressRule) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep918 {
+			if yysep979 {
 				r.EncodeEnd()
 			}
 		}
@@ -10224,24 +10845,24 @@ func (x *IngressRule) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym923 := z.DecBinary()
-	_ = yym923
+	yym984 := z.DecBinary()
+	_ = yym984
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl924 := r.ReadMapStart()
-			if yyl924 == 0 {
+			yyl985 := r.ReadMapStart()
+			if yyl985 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl924, d)
+				x.codecDecodeSelfFromMap(yyl985, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl924 := r.ReadArrayStart()
-			if yyl924 == 0 {
+			yyl985 := r.ReadArrayStart()
+			if yyl985 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl924, d)
+				x.codecDecodeSelfFromArray(yyl985, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10253,12 +10874,12 @@ func (x *IngressRule) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys925Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys925Slc
-	var yyhl925 bool = l >= 0
-	for yyj925 := 0; ; yyj925++ {
-		if yyhl925 {
-			if yyj925 >= l {
+	var yys986Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys986Slc
+	var yyhl986 bool = l >= 0
+	for yyj986 := 0; ; yyj986++ {
+		if yyhl986 {
+			if yyj986 >= l {
 				break
 			}
 		} else {
@@ -10266,9 +10887,9 @@ func (x *IngressRule) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys925Slc = r.DecodeBytes(yys925Slc, true, true)
-		yys925 := string(yys925Slc)
-		switch yys925 {
+		yys986Slc = r.DecodeBytes(yys986Slc, true, true)
+		yys986 := string(yys986Slc)
+		switch yys986 {
 		case "host":
 			if r.TryDecodeAsNil() {
 				x.Host = ""
@@ -10290,10 +10911,10 @@ func (x *IngressRule) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.HTTP.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys925)
-		} // end switch yys925
-	} // end for yyj925
-	if !yyhl925 {
+			z.DecStructFieldNotFound(-1, yys986)
+		} // end switch yys986
+	} // end for yyj986
+	if !yyhl986 {
 		r.ReadEnd()
 	}
 }
@@ -10302,16 +10923,16 @@ func (x *IngressRule) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj928 int
-	var yyb928 bool
-	var yyhl928 bool = l >= 0
-	yyj928++
-	if yyhl928 {
-		yyb928 = yyj928 > l
+	var yyj989 int
+	var yyb989 bool
+	var yyhl989 bool = l >= 0
+	yyj989++
+	if yyhl989 {
+		yyb989 = yyj989 > l
 	} else {
-		yyb928 = r.CheckBreak()
+		yyb989 = r.CheckBreak()
 	}
-	if yyb928 {
+	if yyb989 {
 		r.ReadEnd()
 		return
 	}
@@ -10320,13 +10941,13 @@ func (x *IngressRule) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.Host = string(r.DecodeString())
 	}
-	yyj928++
-	if yyhl928 {
-		yyb928 = yyj928 > l
+	yyj989++
+	if yyhl989 {
+		yyb989 = yyj989 > l
 	} else {
-		yyb928 = r.CheckBreak()
+		yyb989 = r.CheckBreak()
 	}
-	if yyb928 {
+	if yyb989 {
 		r.ReadEnd()
 		return
 	}
@@ -10341,16 +10962,16 @@ func (x *IngressRule) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 		x.HTTP.CodecDecodeSelf(d)
 	}
 	for {
-		yyj928++
-		if yyhl928 {
-			yyb928 = yyj928 > l
+		yyj989++
+		if yyhl989 {
+			yyb989 = yyj989 > l
 		} else {
-			yyb928 = r.CheckBreak()
+			yyb989 = r.CheckBreak()
 		}
-		if yyb928 {
+		if yyb989 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj928-1, "")
+		z.DecStructFieldNotFound(yyj989-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10362,30 +10983,30 @@ func (x *IngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym931 := z.EncBinary()
-		_ = yym931
+		yym992 := z.EncBinary()
+		_ = yym992
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep932 := !z.EncBinary()
-			yy2arr932 := z.EncBasicHandle().StructToArray
-			var yyq932 [1]bool
-			_, _, _ = yysep932, yyq932, yy2arr932
-			const yyr932 bool = false
-			yyq932[0] = x.HTTP != nil
-			if yyr932 || yy2arr932 {
+			yysep993 := !z.EncBinary()
+			yy2arr993 := z.EncBasicHandle().StructToArray
+			var yyq993 [1]bool
+			_, _, _ = yysep993, yyq993, yy2arr993
+			const yyr993 bool = false
+			yyq993[0] = x.HTTP != nil
+			if yyr993 || yy2arr993 {
 				r.EncodeArrayStart(1)
 			} else {
-				var yynn932 int = 0
-				for _, b := range yyq932 {
+				var yynn993 int = 0
+				for _, b := range yyq993 {
 					if b {
-						yynn932++
+						yynn993++
 					}
 				}
-				r.EncodeMapStart(yynn932)
+				r.EncodeMapStart(yynn993)
 			}
-			if yyr932 || yy2arr932 {
-				if yyq932[0] {
+			if yyr993 || yy2arr993 {
+				if yyq993[0] {
 					if x.HTTP == nil {
 						r.EncodeNil()
 					} else {
@@ -10395,7 +11016,7 @@ func (x *IngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq932[0] {
+				if yyq993[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("http"))
 					if x.HTTP == nil {
 						r.EncodeNil()
@@ -10404,7 +11025,7 @@ func (x *IngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep932 {
+			if yysep993 {
 				r.EncodeEnd()
 			}
 		}
@@ -10415,24 +11036,24 @@ func (x *IngressRuleValue) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym934 := z.DecBinary()
-	_ = yym934
+	yym995 := z.DecBinary()
+	_ = yym995
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl935 := r.ReadMapStart()
-			if yyl935 == 0 {
+			yyl996 := r.ReadMapStart()
+			if yyl996 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl935, d)
+				x.codecDecodeSelfFromMap(yyl996, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl935 := r.ReadArrayStart()
-			if yyl935 == 0 {
+			yyl996 := r.ReadArrayStart()
+			if yyl996 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl935, d)
+				x.codecDecodeSelfFromArray(yyl996, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10444,12 +11065,12 @@ func (x *IngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys936Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys936Slc
-	var yyhl936 bool = l >= 0
-	for yyj936 := 0; ; yyj936++ {
-		if yyhl936 {
-			if yyj936 >= l {
+	var yys997Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys997Slc
+	var yyhl997 bool = l >= 0
+	for yyj997 := 0; ; yyj997++ {
+		if yyhl997 {
+			if yyj997 >= l {
 				break
 			}
 		} else {
@@ -10457,9 +11078,9 @@ func (x *IngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys936Slc = r.DecodeBytes(yys936Slc, true, true)
-		yys936 := string(yys936Slc)
-		switch yys936 {
+		yys997Slc = r.DecodeBytes(yys997Slc, true, true)
+		yys997 := string(yys997Slc)
+		switch yys997 {
 		case "http":
 			if r.TryDecodeAsNil() {
 				if x.HTTP != nil {
@@ -10472,10 +11093,10 @@ func (x *IngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.HTTP.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys936)
-		} // end switch yys936
-	} // end for yyj936
-	if !yyhl936 {
+			z.DecStructFieldNotFound(-1, yys997)
+		} // end switch yys997
+	} // end for yyj997
+	if !yyhl997 {
 		r.ReadEnd()
 	}
 }
@@ -10484,16 +11105,16 @@ func (x *IngressRuleValue) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj938 int
-	var yyb938 bool
-	var yyhl938 bool = l >= 0
-	yyj938++
-	if yyhl938 {
-		yyb938 = yyj938 > l
+	var yyj999 int
+	var yyb999 bool
+	var yyhl999 bool = l >= 0
+	yyj999++
+	if yyhl999 {
+		yyb999 = yyj999 > l
 	} else {
-		yyb938 = r.CheckBreak()
+		yyb999 = r.CheckBreak()
 	}
-	if yyb938 {
+	if yyb999 {
 		r.ReadEnd()
 		return
 	}
@@ -10508,16 +11129,16 @@ func (x *IngressRuleValue) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 		x.HTTP.CodecDecodeSelf(d)
 	}
 	for {
-		yyj938++
-		if yyhl938 {
-			yyb938 = yyj938 > l
+		yyj999++
+		if yyhl999 {
+			yyb999 = yyj999 > l
 		} else {
-			yyb938 = r.CheckBreak()
+			yyb999 = r.CheckBreak()
 		}
-		if yyb938 {
+		if yyb999 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj938-1, "")
+		z.DecStructFieldNotFound(yyj999-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10529,33 +11150,33 @@ func (x *HTTPIngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym940 := z.EncBinary()
-		_ = yym940
+		yym1001 := z.EncBinary()
+		_ = yym1001
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep941 := !z.EncBinary()
-			yy2arr941 := z.EncBasicHandle().StructToArray
-			var yyq941 [1]bool
-			_, _, _ = yysep941, yyq941, yy2arr941
-			const yyr941 bool = false
-			if yyr941 || yy2arr941 {
+			yysep1002 := !z.EncBinary()
+			yy2arr1002 := z.EncBasicHandle().StructToArray
+			var yyq1002 [1]bool
+			_, _, _ = yysep1002, yyq1002, yy2arr1002
+			const yyr1002 bool = false
+			if yyr1002 || yy2arr1002 {
 				r.EncodeArrayStart(1)
 			} else {
-				var yynn941 int = 1
-				for _, b := range yyq941 {
+				var yynn1002 int = 1
+				for _, b := range yyq1002 {
 					if b {
-						yynn941++
+						yynn1002++
 					}
 				}
-				r.EncodeMapStart(yynn941)
+				r.EncodeMapStart(yynn1002)
 			}
-			if yyr941 || yy2arr941 {
+			if yyr1002 || yy2arr1002 {
 				if x.Paths == nil {
 					r.EncodeNil()
 				} else {
-					yym943 := z.EncBinary()
-					_ = yym943
+					yym1004 := z.EncBinary()
+					_ = yym1004
 					if false {
 					} else {
 						h.encSliceHTTPIngressPath(([]HTTPIngressPath)(x.Paths), e)
@@ -10566,15 +11187,15 @@ func (x *HTTPIngressRuleValue) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Paths == nil {
 					r.EncodeNil()
 				} else {
-					yym944 := z.EncBinary()
-					_ = yym944
+					yym1005 := z.EncBinary()
+					_ = yym1005
 					if false {
 					} else {
 						h.encSliceHTTPIngressPath(([]HTTPIngressPath)(x.Paths), e)
 					}
 				}
 			}
-			if yysep941 {
+			if yysep1002 {
 				r.EncodeEnd()
 			}
 		}
@@ -10585,24 +11206,24 @@ func (x *HTTPIngressRuleValue) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym945 := z.DecBinary()
-	_ = yym945
+	yym1006 := z.DecBinary()
+	_ = yym1006
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl946 := r.ReadMapStart()
-			if yyl946 == 0 {
+			yyl1007 := r.ReadMapStart()
+			if yyl1007 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl946, d)
+				x.codecDecodeSelfFromMap(yyl1007, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl946 := r.ReadArrayStart()
-			if yyl946 == 0 {
+			yyl1007 := r.ReadArrayStart()
+			if yyl1007 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl946, d)
+				x.codecDecodeSelfFromArray(yyl1007, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10614,12 +11235,12 @@ func (x *HTTPIngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decode
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys947Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys947Slc
-	var yyhl947 bool = l >= 0
-	for yyj947 := 0; ; yyj947++ {
-		if yyhl947 {
-			if yyj947 >= l {
+	var yys1008Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1008Slc
+	var yyhl1008 bool = l >= 0
+	for yyj1008 := 0; ; yyj1008++ {
+		if yyhl1008 {
+			if yyj1008 >= l {
 				break
 			}
 		} else {
@@ -10627,26 +11248,26 @@ func (x *HTTPIngressRuleValue) codecDecodeSelfFromMap(l int, d *codec1978.Decode
 				break
 			}
 		}
-		yys947Slc = r.DecodeBytes(yys947Slc, true, true)
-		yys947 := string(yys947Slc)
-		switch yys947 {
+		yys1008Slc = r.DecodeBytes(yys1008Slc, true, true)
+		yys1008 := string(yys1008Slc)
+		switch yys1008 {
 		case "paths":
 			if r.TryDecodeAsNil() {
 				x.Paths = nil
 			} else {
-				yyv948 := &x.Paths
-				yym949 := z.DecBinary()
-				_ = yym949
+				yyv1009 := &x.Paths
+				yym1010 := z.DecBinary()
+				_ = yym1010
 				if false {
 				} else {
-					h.decSliceHTTPIngressPath((*[]HTTPIngressPath)(yyv948), d)
+					h.decSliceHTTPIngressPath((*[]HTTPIngressPath)(yyv1009), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys947)
-		} // end switch yys947
-	} // end for yyj947
-	if !yyhl947 {
+			z.DecStructFieldNotFound(-1, yys1008)
+		} // end switch yys1008
+	} // end for yyj1008
+	if !yyhl1008 {
 		r.ReadEnd()
 	}
 }
@@ -10655,41 +11276,41 @@ func (x *HTTPIngressRuleValue) codecDecodeSelfFromArray(l int, d *codec1978.Deco
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj950 int
-	var yyb950 bool
-	var yyhl950 bool = l >= 0
-	yyj950++
-	if yyhl950 {
-		yyb950 = yyj950 > l
+	var yyj1011 int
+	var yyb1011 bool
+	var yyhl1011 bool = l >= 0
+	yyj1011++
+	if yyhl1011 {
+		yyb1011 = yyj1011 > l
 	} else {
-		yyb950 = r.CheckBreak()
+		yyb1011 = r.CheckBreak()
 	}
-	if yyb950 {
+	if yyb1011 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Paths = nil
 	} else {
-		yyv951 := &x.Paths
-		yym952 := z.DecBinary()
-		_ = yym952
+		yyv1012 := &x.Paths
+		yym1013 := z.DecBinary()
+		_ = yym1013
 		if false {
 		} else {
-			h.decSliceHTTPIngressPath((*[]HTTPIngressPath)(yyv951), d)
+			h.decSliceHTTPIngressPath((*[]HTTPIngressPath)(yyv1012), d)
 		}
 	}
 	for {
-		yyj950++
-		if yyhl950 {
-			yyb950 = yyj950 > l
+		yyj1011++
+		if yyhl1011 {
+			yyb1011 = yyj1011 > l
 		} else {
-			yyb950 = r.CheckBreak()
+			yyb1011 = r.CheckBreak()
 		}
-		if yyb950 {
+		if yyb1011 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj950-1, "")
+		z.DecStructFieldNotFound(yyj1011-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10701,32 +11322,32 @@ func (x *HTTPIngressPath) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym953 := z.EncBinary()
-		_ = yym953
+		yym1014 := z.EncBinary()
+		_ = yym1014
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep954 := !z.EncBinary()
-			yy2arr954 := z.EncBasicHandle().StructToArray
-			var yyq954 [2]bool
-			_, _, _ = yysep954, yyq954, yy2arr954
-			const yyr954 bool = false
-			yyq954[0] = x.Path != ""
-			if yyr954 || yy2arr954 {
+			yysep1015 := !z.EncBinary()
+			yy2arr1015 := z.EncBasicHandle().StructToArray
+			var yyq1015 [2]bool
+			_, _, _ = yysep1015, yyq1015, yy2arr1015
+			const yyr1015 bool = false
+			yyq1015[0] = x.Path != ""
+			if yyr1015 || yy2arr1015 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn954 int = 1
-				for _, b := range yyq954 {
+				var yynn1015 int = 1
+				for _, b := range yyq1015 {
 					if b {
-						yynn954++
+						yynn1015++
 					}
 				}
-				r.EncodeMapStart(yynn954)
+				r.EncodeMapStart(yynn1015)
 			}
-			if yyr954 || yy2arr954 {
-				if yyq954[0] {
-					yym956 := z.EncBinary()
-					_ = yym956
+			if yyr1015 || yy2arr1015 {
+				if yyq1015[0] {
+					yym1017 := z.EncBinary()
+					_ = yym1017
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Path))
@@ -10735,25 +11356,25 @@ func (x *HTTPIngressPath) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq954[0] {
+				if yyq1015[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("path"))
-					yym957 := z.EncBinary()
-					_ = yym957
+					yym1018 := z.EncBinary()
+					_ = yym1018
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Path))
 					}
 				}
 			}
-			if yyr954 || yy2arr954 {
-				yy959 := &x.Backend
-				yy959.CodecEncodeSelf(e)
+			if yyr1015 || yy2arr1015 {
+				yy1020 := &x.Backend
+				yy1020.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("backend"))
-				yy960 := &x.Backend
-				yy960.CodecEncodeSelf(e)
+				yy1021 := &x.Backend
+				yy1021.CodecEncodeSelf(e)
 			}
-			if yysep954 {
+			if yysep1015 {
 				r.EncodeEnd()
 			}
 		}
@@ -10764,24 +11385,24 @@ func (x *HTTPIngressPath) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym961 := z.DecBinary()
-	_ = yym961
+	yym1022 := z.DecBinary()
+	_ = yym1022
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl962 := r.ReadMapStart()
-			if yyl962 == 0 {
+			yyl1023 := r.ReadMapStart()
+			if yyl1023 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl962, d)
+				x.codecDecodeSelfFromMap(yyl1023, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl962 := r.ReadArrayStart()
-			if yyl962 == 0 {
+			yyl1023 := r.ReadArrayStart()
+			if yyl1023 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl962, d)
+				x.codecDecodeSelfFromArray(yyl1023, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10793,12 +11414,12 @@ func (x *HTTPIngressPath) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys963Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys963Slc
-	var yyhl963 bool = l >= 0
-	for yyj963 := 0; ; yyj963++ {
-		if yyhl963 {
-			if yyj963 >= l {
+	var yys1024Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1024Slc
+	var yyhl1024 bool = l >= 0
+	for yyj1024 := 0; ; yyj1024++ {
+		if yyhl1024 {
+			if yyj1024 >= l {
 				break
 			}
 		} else {
@@ -10806,9 +11427,9 @@ func (x *HTTPIngressPath) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys963Slc = r.DecodeBytes(yys963Slc, true, true)
-		yys963 := string(yys963Slc)
-		switch yys963 {
+		yys1024Slc = r.DecodeBytes(yys1024Slc, true, true)
+		yys1024 := string(yys1024Slc)
+		switch yys1024 {
 		case "path":
 			if r.TryDecodeAsNil() {
 				x.Path = ""
@@ -10819,14 +11440,14 @@ func (x *HTTPIngressPath) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.Backend = IngressBackend{}
 			} else {
-				yyv965 := &x.Backend
-				yyv965.CodecDecodeSelf(d)
+				yyv1026 := &x.Backend
+				yyv1026.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys963)
-		} // end switch yys963
-	} // end for yyj963
-	if !yyhl963 {
+			z.DecStructFieldNotFound(-1, yys1024)
+		} // end switch yys1024
+	} // end for yyj1024
+	if !yyhl1024 {
 		r.ReadEnd()
 	}
 }
@@ -10835,16 +11456,16 @@ func (x *HTTPIngressPath) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj966 int
-	var yyb966 bool
-	var yyhl966 bool = l >= 0
-	yyj966++
-	if yyhl966 {
-		yyb966 = yyj966 > l
+	var yyj1027 int
+	var yyb1027 bool
+	var yyhl1027 bool = l >= 0
+	yyj1027++
+	if yyhl1027 {
+		yyb1027 = yyj1027 > l
 	} else {
-		yyb966 = r.CheckBreak()
+		yyb1027 = r.CheckBreak()
 	}
-	if yyb966 {
+	if yyb1027 {
 		r.ReadEnd()
 		return
 	}
@@ -10853,33 +11474,33 @@ func (x *HTTPIngressPath) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	} else {
 		x.Path = string(r.DecodeString())
 	}
-	yyj966++
-	if yyhl966 {
-		yyb966 = yyj966 > l
+	yyj1027++
+	if yyhl1027 {
+		yyb1027 = yyj1027 > l
 	} else {
-		yyb966 = r.CheckBreak()
+		yyb1027 = r.CheckBreak()
 	}
-	if yyb966 {
+	if yyb1027 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Backend = IngressBackend{}
 	} else {
-		yyv968 := &x.Backend
-		yyv968.CodecDecodeSelf(d)
+		yyv1029 := &x.Backend
+		yyv1029.CodecDecodeSelf(d)
 	}
 	for {
-		yyj966++
-		if yyhl966 {
-			yyb966 = yyj966 > l
+		yyj1027++
+		if yyhl1027 {
+			yyb1027 = yyj1027 > l
 		} else {
-			yyb966 = r.CheckBreak()
+			yyb1027 = r.CheckBreak()
 		}
-		if yyb966 {
+		if yyb1027 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj966-1, "")
+		z.DecStructFieldNotFound(yyj1027-1, "")
 	}
 	r.ReadEnd()
 }
@@ -10891,68 +11512,68 @@ func (x *IngressBackend) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym969 := z.EncBinary()
-		_ = yym969
+		yym1030 := z.EncBinary()
+		_ = yym1030
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep970 := !z.EncBinary()
-			yy2arr970 := z.EncBasicHandle().StructToArray
-			var yyq970 [2]bool
-			_, _, _ = yysep970, yyq970, yy2arr970
-			const yyr970 bool = false
-			if yyr970 || yy2arr970 {
+			yysep1031 := !z.EncBinary()
+			yy2arr1031 := z.EncBasicHandle().StructToArray
+			var yyq1031 [2]bool
+			_, _, _ = yysep1031, yyq1031, yy2arr1031
+			const yyr1031 bool = false
+			if yyr1031 || yy2arr1031 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn970 int = 2
-				for _, b := range yyq970 {
+				var yynn1031 int = 2
+				for _, b := range yyq1031 {
 					if b {
-						yynn970++
+						yynn1031++
 					}
 				}
-				r.EncodeMapStart(yynn970)
+				r.EncodeMapStart(yynn1031)
 			}
-			if yyr970 || yy2arr970 {
-				yym972 := z.EncBinary()
-				_ = yym972
+			if yyr1031 || yy2arr1031 {
+				yym1033 := z.EncBinary()
+				_ = yym1033
 				if false {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.ServiceName))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("serviceName"))
-				yym973 := z.EncBinary()
-				_ = yym973
+				yym1034 := z.EncBinary()
+				_ = yym1034
 				if false {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.ServiceName))
 				}
 			}
-			if yyr970 || yy2arr970 {
-				yy975 := &x.ServicePort
-				yym976 := z.EncBinary()
-				_ = yym976
+			if yyr1031 || yy2arr1031 {
+				yy1036 := &x.ServicePort
+				yym1037 := z.EncBinary()
+				_ = yym1037
 				if false {
-				} else if z.HasExtensions() && z.EncExt(yy975) {
-				} else if !yym976 && z.IsJSONHandle() {
-					z.EncJSONMarshal(yy975)
+				} else if z.HasExtensions() && z.EncExt(yy1036) {
+				} else if !yym1037 && z.IsJSONHandle() {
+					z.EncJSONMarshal(yy1036)
 				} else {
-					z.EncFallback(yy975)
+					z.EncFallback(yy1036)
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("servicePort"))
-				yy977 := &x.ServicePort
-				yym978 := z.EncBinary()
-				_ = yym978
+				yy1038 := &x.ServicePort
+				yym1039 := z.EncBinary()
+				_ = yym1039
 				if false {
-				} else if z.HasExtensions() && z.EncExt(yy977) {
-				} else if !yym978 && z.IsJSONHandle() {
-					z.EncJSONMarshal(yy977)
+				} else if z.HasExtensions() && z.EncExt(yy1038) {
+				} else if !yym1039 && z.IsJSONHandle() {
+					z.EncJSONMarshal(yy1038)
 				} else {
-					z.EncFallback(yy977)
+					z.EncFallback(yy1038)
 				}
 			}
-			if yysep970 {
+			if yysep1031 {
 				r.EncodeEnd()
 			}
 		}
@@ -10963,24 +11584,24 @@ func (x *IngressBackend) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym979 := z.DecBinary()
-	_ = yym979
+	yym1040 := z.DecBinary()
+	_ = yym1040
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl980 := r.ReadMapStart()
-			if yyl980 == 0 {
+			yyl1041 := r.ReadMapStart()
+			if yyl1041 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl980, d)
+				x.codecDecodeSelfFromMap(yyl1041, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl980 := r.ReadArrayStart()
-			if yyl980 == 0 {
+			yyl1041 := r.ReadArrayStart()
+			if yyl1041 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl980, d)
+				x.codecDecodeSelfFromArray(yyl1041, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -10992,12 +11613,12 @@ func (x *IngressBackend) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys981Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys981Slc
-	var yyhl981 bool = l >= 0
-	for yyj981 := 0; ; yyj981++ {
-		if yyhl981 {
-			if yyj981 >= l {
+	var yys1042Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1042Slc
+	var yyhl1042 bool = l >= 0
+	for yyj1042 := 0; ; yyj1042++ {
+		if yyhl1042 {
+			if yyj1042 >= l {
 				break
 			}
 		} else {
@@ -11005,9 +11626,9 @@ func (x *IngressBackend) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys981Slc = r.DecodeBytes(yys981Slc, true, true)
-		yys981 := string(yys981Slc)
-		switch yys981 {
+		yys1042Slc = r.DecodeBytes(yys1042Slc, true, true)
+		yys1042 := string(yys1042Slc)
+		switch yys1042 {
 		case "serviceName":
 			if r.TryDecodeAsNil() {
 				x.ServiceName = ""
@@ -11018,22 +11639,22 @@ func (x *IngressBackend) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			if r.TryDecodeAsNil() {
 				x.ServicePort = pkg6_util.IntOrString{}
 			} else {
-				yyv983 := &x.ServicePort
-				yym984 := z.DecBinary()
-				_ = yym984
+				yyv1044 := &x.ServicePort
+				yym1045 := z.DecBinary()
+				_ = yym1045
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv983) {
-				} else if !yym984 && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(yyv983)
+				} else if z.HasExtensions() && z.DecExt(yyv1044) {
+				} else if !yym1045 && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(yyv1044)
 				} else {
-					z.DecFallback(yyv983, false)
+					z.DecFallback(yyv1044, false)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys981)
-		} // end switch yys981
-	} // end for yyj981
-	if !yyhl981 {
+			z.DecStructFieldNotFound(-1, yys1042)
+		} // end switch yys1042
+	} // end for yyj1042
+	if !yyhl1042 {
 		r.ReadEnd()
 	}
 }
@@ -11042,16 +11663,16 @@ func (x *IngressBackend) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj985 int
-	var yyb985 bool
-	var yyhl985 bool = l >= 0
-	yyj985++
-	if yyhl985 {
-		yyb985 = yyj985 > l
+	var yyj1046 int
+	var yyb1046 bool
+	var yyhl1046 bool = l >= 0
+	yyj1046++
+	if yyhl1046 {
+		yyb1046 = yyj1046 > l
 	} else {
-		yyb985 = r.CheckBreak()
+		yyb1046 = r.CheckBreak()
 	}
-	if yyb985 {
+	if yyb1046 {
 		r.ReadEnd()
 		return
 	}
@@ -11060,41 +11681,41 @@ func (x *IngressBackend) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.ServiceName = string(r.DecodeString())
 	}
-	yyj985++
-	if yyhl985 {
-		yyb985 = yyj985 > l
+	yyj1046++
+	if yyhl1046 {
+		yyb1046 = yyj1046 > l
 	} else {
-		yyb985 = r.CheckBreak()
+		yyb1046 = r.CheckBreak()
 	}
-	if yyb985 {
+	if yyb1046 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ServicePort = pkg6_util.IntOrString{}
 	} else {
-		yyv987 := &x.ServicePort
-		yym988 := z.DecBinary()
-		_ = yym988
+		yyv1048 := &x.ServicePort
+		yym1049 := z.DecBinary()
+		_ = yym1049
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv987) {
-		} else if !yym988 && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(yyv987)
+		} else if z.HasExtensions() && z.DecExt(yyv1048) {
+		} else if !yym1049 && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(yyv1048)
 		} else {
-			z.DecFallback(yyv987, false)
+			z.DecFallback(yyv1048, false)
 		}
 	}
 	for {
-		yyj985++
-		if yyhl985 {
-			yyb985 = yyj985 > l
+		yyj1046++
+		if yyhl1046 {
+			yyb1046 = yyj1046 > l
 		} else {
-			yyb985 = r.CheckBreak()
+			yyb1046 = r.CheckBreak()
 		}
-		if yyb985 {
+		if yyb1046 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj985-1, "")
+		z.DecStructFieldNotFound(yyj1046-1, "")
 	}
 	r.ReadEnd()
 }
@@ -11103,8 +11724,8 @@ func (x NodeResource) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	yym989 := z.EncBinary()
-	_ = yym989
+	yym1050 := z.EncBinary()
+	_ = yym1050
 	if false {
 	} else if z.HasExtensions() && z.EncExt(x) {
 	} else {
@@ -11116,8 +11737,8 @@ func (x *NodeResource) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym990 := z.DecBinary()
-	_ = yym990
+	yym1051 := z.DecBinary()
+	_ = yym1051
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
@@ -11132,50 +11753,50 @@ func (x *NodeUtilization) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym991 := z.EncBinary()
-		_ = yym991
+		yym1052 := z.EncBinary()
+		_ = yym1052
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep992 := !z.EncBinary()
-			yy2arr992 := z.EncBasicHandle().StructToArray
-			var yyq992 [2]bool
-			_, _, _ = yysep992, yyq992, yy2arr992
-			const yyr992 bool = false
-			if yyr992 || yy2arr992 {
+			yysep1053 := !z.EncBinary()
+			yy2arr1053 := z.EncBasicHandle().StructToArray
+			var yyq1053 [2]bool
+			_, _, _ = yysep1053, yyq1053, yy2arr1053
+			const yyr1053 bool = false
+			if yyr1053 || yy2arr1053 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn992 int = 2
-				for _, b := range yyq992 {
+				var yynn1053 int = 2
+				for _, b := range yyq1053 {
 					if b {
-						yynn992++
+						yynn1053++
 					}
 				}
-				r.EncodeMapStart(yynn992)
+				r.EncodeMapStart(yynn1053)
 			}
-			if yyr992 || yy2arr992 {
+			if yyr1053 || yy2arr1053 {
 				x.Resource.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("resource"))
 				x.Resource.CodecEncodeSelf(e)
 			}
-			if yyr992 || yy2arr992 {
-				yym995 := z.EncBinary()
-				_ = yym995
+			if yyr1053 || yy2arr1053 {
+				yym1056 := z.EncBinary()
+				_ = yym1056
 				if false {
 				} else {
 					r.EncodeFloat64(float64(x.Value))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("value"))
-				yym996 := z.EncBinary()
-				_ = yym996
+				yym1057 := z.EncBinary()
+				_ = yym1057
 				if false {
 				} else {
 					r.EncodeFloat64(float64(x.Value))
 				}
 			}
-			if yysep992 {
+			if yysep1053 {
 				r.EncodeEnd()
 			}
 		}
@@ -11186,24 +11807,24 @@ func (x *NodeUtilization) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym997 := z.DecBinary()
-	_ = yym997
+	yym1058 := z.DecBinary()
+	_ = yym1058
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl998 := r.ReadMapStart()
-			if yyl998 == 0 {
+			yyl1059 := r.ReadMapStart()
+			if yyl1059 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl998, d)
+				x.codecDecodeSelfFromMap(yyl1059, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl998 := r.ReadArrayStart()
-			if yyl998 == 0 {
+			yyl1059 := r.ReadArrayStart()
+			if yyl1059 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl998, d)
+				x.codecDecodeSelfFromArray(yyl1059, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -11215,12 +11836,12 @@ func (x *NodeUtilization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys999Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys999Slc
-	var yyhl999 bool = l >= 0
-	for yyj999 := 0; ; yyj999++ {
-		if yyhl999 {
-			if yyj999 >= l {
+	var yys1060Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1060Slc
+	var yyhl1060 bool = l >= 0
+	for yyj1060 := 0; ; yyj1060++ {
+		if yyhl1060 {
+			if yyj1060 >= l {
 				break
 			}
 		} else {
@@ -11228,9 +11849,9 @@ func (x *NodeUtilization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys999Slc = r.DecodeBytes(yys999Slc, true, true)
-		yys999 := string(yys999Slc)
-		switch yys999 {
+		yys1060Slc = r.DecodeBytes(yys1060Slc, true, true)
+		yys1060 := string(yys1060Slc)
+		switch yys1060 {
 		case "resource":
 			if r.TryDecodeAsNil() {
 				x.Resource = ""
@@ -11244,10 +11865,10 @@ func (x *NodeUtilization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				x.Value = float64(r.DecodeFloat(false))
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys999)
-		} // end switch yys999
-	} // end for yyj999
-	if !yyhl999 {
+			z.DecStructFieldNotFound(-1, yys1060)
+		} // end switch yys1060
+	} // end for yyj1060
+	if !yyhl1060 {
 		r.ReadEnd()
 	}
 }
@@ -11256,16 +11877,16 @@ func (x *NodeUtilization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1002 int
-	var yyb1002 bool
-	var yyhl1002 bool = l >= 0
-	yyj1002++
-	if yyhl1002 {
-		yyb1002 = yyj1002 > l
+	var yyj1063 int
+	var yyb1063 bool
+	var yyhl1063 bool = l >= 0
+	yyj1063++
+	if yyhl1063 {
+		yyb1063 = yyj1063 > l
 	} else {
-		yyb1002 = r.CheckBreak()
+		yyb1063 = r.CheckBreak()
 	}
-	if yyb1002 {
+	if yyb1063 {
 		r.ReadEnd()
 		return
 	}
@@ -11274,13 +11895,13 @@ func (x *NodeUtilization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 	} else {
 		x.Resource = NodeResource(r.DecodeString())
 	}
-	yyj1002++
-	if yyhl1002 {
-		yyb1002 = yyj1002 > l
+	yyj1063++
+	if yyhl1063 {
+		yyb1063 = yyj1063 > l
 	} else {
-		yyb1002 = r.CheckBreak()
+		yyb1063 = r.CheckBreak()
 	}
-	if yyb1002 {
+	if yyb1063 {
 		r.ReadEnd()
 		return
 	}
@@ -11290,16 +11911,16 @@ func (x *NodeUtilization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder)
 		x.Value = float64(r.DecodeFloat(false))
 	}
 	for {
-		yyj1002++
-		if yyhl1002 {
-			yyb1002 = yyj1002 > l
+		yyj1063++
+		if yyhl1063 {
+			yyb1063 = yyj1063 > l
 		} else {
-			yyb1002 = r.CheckBreak()
+			yyb1063 = r.CheckBreak()
 		}
-		if yyb1002 {
+		if yyb1063 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1002-1, "")
+		z.DecStructFieldNotFound(yyj1063-1, "")
 	}
 	r.ReadEnd()
 }
@@ -11311,65 +11932,65 @@ func (x *ClusterAutoscalerSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1005 := z.EncBinary()
-		_ = yym1005
+		yym1066 := z.EncBinary()
+		_ = yym1066
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1006 := !z.EncBinary()
-			yy2arr1006 := z.EncBasicHandle().StructToArray
-			var yyq1006 [3]bool
-			_, _, _ = yysep1006, yyq1006, yy2arr1006
-			const yyr1006 bool = false
-			if yyr1006 || yy2arr1006 {
+			yysep1067 := !z.EncBinary()
+			yy2arr1067 := z.EncBasicHandle().StructToArray
+			var yyq1067 [3]bool
+			_, _, _ = yysep1067, yyq1067, yy2arr1067
+			const yyr1067 bool = false
+			if yyr1067 || yy2arr1067 {
 				r.EncodeArrayStart(3)
 			} else {
-				var yynn1006 int = 3
-				for _, b := range yyq1006 {
+				var yynn1067 int = 3
+				for _, b := range yyq1067 {
 					if b {
-						yynn1006++
+						yynn1067++
 					}
 				}
-				r.EncodeMapStart(yynn1006)
+				r.EncodeMapStart(yynn1067)
 			}
-			if yyr1006 || yy2arr1006 {
-				yym1008 := z.EncBinary()
-				_ = yym1008
+			if yyr1067 || yy2arr1067 {
+				yym1069 := z.EncBinary()
+				_ = yym1069
 				if false {
 				} else {
 					r.EncodeInt(int64(x.MinNodes))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("minNodes"))
-				yym1009 := z.EncBinary()
-				_ = yym1009
+				yym1070 := z.EncBinary()
+				_ = yym1070
 				if false {
 				} else {
 					r.EncodeInt(int64(x.MinNodes))
 				}
 			}
-			if yyr1006 || yy2arr1006 {
-				yym1011 := z.EncBinary()
-				_ = yym1011
+			if yyr1067 || yy2arr1067 {
+				yym1072 := z.EncBinary()
+				_ = yym1072
 				if false {
 				} else {
 					r.EncodeInt(int64(x.MaxNodes))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("maxNodes"))
-				yym1012 := z.EncBinary()
-				_ = yym1012
+				yym1073 := z.EncBinary()
+				_ = yym1073
 				if false {
 				} else {
 					r.EncodeInt(int64(x.MaxNodes))
 				}
 			}
-			if yyr1006 || yy2arr1006 {
+			if yyr1067 || yy2arr1067 {
 				if x.TargetUtilization == nil {
 					r.EncodeNil()
 				} else {
-					yym1014 := z.EncBinary()
-					_ = yym1014
+					yym1075 := z.EncBinary()
+					_ = yym1075
 					if false {
 					} else {
 						h.encSliceNodeUtilization(([]NodeUtilization)(x.TargetUtilization), e)
@@ -11380,15 +12001,15 @@ func (x *ClusterAutoscalerSpec) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.TargetUtilization == nil {
 					r.EncodeNil()
 				} else {
-					yym1015 := z.EncBinary()
-					_ = yym1015
+					yym1076 := z.EncBinary()
+					_ = yym1076
 					if false {
 					} else {
 						h.encSliceNodeUtilization(([]NodeUtilization)(x.TargetUtilization), e)
 					}
 				}
 			}
-			if yysep1006 {
+			if yysep1067 {
 				r.EncodeEnd()
 			}
 		}
@@ -11399,24 +12020,24 @@ func (x *ClusterAutoscalerSpec) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1016 := z.DecBinary()
-	_ = yym1016
+	yym1077 := z.DecBinary()
+	_ = yym1077
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1017 := r.ReadMapStart()
-			if yyl1017 == 0 {
+			yyl1078 := r.ReadMapStart()
+			if yyl1078 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1017, d)
+				x.codecDecodeSelfFromMap(yyl1078, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1017 := r.ReadArrayStart()
-			if yyl1017 == 0 {
+			yyl1078 := r.ReadArrayStart()
+			if yyl1078 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1017, d)
+				x.codecDecodeSelfFromArray(yyl1078, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -11428,12 +12049,12 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1018Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1018Slc
-	var yyhl1018 bool = l >= 0
-	for yyj1018 := 0; ; yyj1018++ {
-		if yyhl1018 {
-			if yyj1018 >= l {
+	var yys1079Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1079Slc
+	var yyhl1079 bool = l >= 0
+	for yyj1079 := 0; ; yyj1079++ {
+		if yyhl1079 {
+			if yyj1079 >= l {
 				break
 			}
 		} else {
@@ -11441,9 +12062,9 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 				break
 			}
 		}
-		yys1018Slc = r.DecodeBytes(yys1018Slc, true, true)
-		yys1018 := string(yys1018Slc)
-		switch yys1018 {
+		yys1079Slc = r.DecodeBytes(yys1079Slc, true, true)
+		yys1079 := string(yys1079Slc)
+		switch yys1079 {
 		case "minNodes":
 			if r.TryDecodeAsNil() {
 				x.MinNodes = 0
@@ -11460,19 +12081,19 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 			if r.TryDecodeAsNil() {
 				x.TargetUtilization = nil
 			} else {
-				yyv1021 := &x.TargetUtilization
-				yym1022 := z.DecBinary()
-				_ = yym1022
+				yyv1082 := &x.TargetUtilization
+				yym1083 := z.DecBinary()
+				_ = yym1083
 				if false {
 				} else {
-					h.decSliceNodeUtilization((*[]NodeUtilization)(yyv1021), d)
+					h.decSliceNodeUtilization((*[]NodeUtilization)(yyv1082), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1018)
-		} // end switch yys1018
-	} // end for yyj1018
-	if !yyhl1018 {
+			z.DecStructFieldNotFound(-1, yys1079)
+		} // end switch yys1079
+	} // end for yyj1079
+	if !yyhl1079 {
 		r.ReadEnd()
 	}
 }
@@ -11481,16 +12102,16 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1023 int
-	var yyb1023 bool
-	var yyhl1023 bool = l >= 0
-	yyj1023++
-	if yyhl1023 {
-		yyb1023 = yyj1023 > l
+	var yyj1084 int
+	var yyb1084 bool
+	var yyhl1084 bool = l >= 0
+	yyj1084++
+	if yyhl1084 {
+		yyb1084 = yyj1084 > l
 	} else {
-		yyb1023 = r.CheckBreak()
+		yyb1084 = r.CheckBreak()
 	}
-	if yyb1023 {
+	if yyb1084 {
 		r.ReadEnd()
 		return
 	}
@@ -11499,13 +12120,13 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	} else {
 		x.MinNodes = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj1023++
-	if yyhl1023 {
-		yyb1023 = yyj1023 > l
+	yyj1084++
+	if yyhl1084 {
+		yyb1084 = yyj1084 > l
 	} else {
-		yyb1023 = r.CheckBreak()
+		yyb1084 = r.CheckBreak()
 	}
-	if yyb1023 {
+	if yyb1084 {
 		r.ReadEnd()
 		return
 	}
@@ -11514,38 +12135,38 @@ func (x *ClusterAutoscalerSpec) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	} else {
 		x.MaxNodes = int(r.DecodeInt(codecSelferBitsize1234))
 	}
-	yyj1023++
-	if yyhl1023 {
-		yyb1023 = yyj1023 > l
+	yyj1084++
+	if yyhl1084 {
+		yyb1084 = yyj1084 > l
 	} else {
-		yyb1023 = r.CheckBreak()
+		yyb1084 = r.CheckBreak()
 	}
-	if yyb1023 {
+	if yyb1084 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.TargetUtilization = nil
 	} else {
-		yyv1026 := &x.TargetUtilization
-		yym1027 := z.DecBinary()
-		_ = yym1027
+		yyv1087 := &x.TargetUtilization
+		yym1088 := z.DecBinary()
+		_ = yym1088
 		if false {
 		} else {
-			h.decSliceNodeUtilization((*[]NodeUtilization)(yyv1026), d)
+			h.decSliceNodeUtilization((*[]NodeUtilization)(yyv1087), d)
 		}
 	}
 	for {
-		yyj1023++
-		if yyhl1023 {
-			yyb1023 = yyj1023 > l
+		yyj1084++
+		if yyhl1084 {
+			yyb1084 = yyj1084 > l
 		} else {
-			yyb1023 = r.CheckBreak()
+			yyb1084 = r.CheckBreak()
 		}
-		if yyb1023 {
+		if yyb1084 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1023-1, "")
+		z.DecStructFieldNotFound(yyj1084-1, "")
 	}
 	r.ReadEnd()
 }
@@ -11557,35 +12178,35 @@ func (x *ClusterAutoscaler) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1028 := z.EncBinary()
-		_ = yym1028
+		yym1089 := z.EncBinary()
+		_ = yym1089
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1029 := !z.EncBinary()
-			yy2arr1029 := z.EncBasicHandle().StructToArray
-			var yyq1029 [4]bool
-			_, _, _ = yysep1029, yyq1029, yy2arr1029
-			const yyr1029 bool = false
-			yyq1029[0] = x.Kind != ""
-			yyq1029[1] = x.APIVersion != ""
-			yyq1029[2] = true
-			yyq1029[3] = true
-			if yyr1029 || yy2arr1029 {
+			yysep1090 := !z.EncBinary()
+			yy2arr1090 := z.EncBasicHandle().StructToArray
+			var yyq1090 [4]bool
+			_, _, _ = yysep1090, yyq1090, yy2arr1090
+			const yyr1090 bool = false
+			yyq1090[0] = x.Kind != ""
+			yyq1090[1] = x.APIVersion != ""
+			yyq1090[2] = true
+			yyq1090[3] = true
+			if yyr1090 || yy2arr1090 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn1029 int = 0
-				for _, b := range yyq1029 {
+				var yynn1090 int = 0
+				for _, b := range yyq1090 {
 					if b {
-						yynn1029++
+						yynn1090++
 					}
 				}
-				r.EncodeMapStart(yynn1029)
+				r.EncodeMapStart(yynn1090)
 			}
-			if yyr1029 || yy2arr1029 {
-				if yyq1029[0] {
-					yym1031 := z.EncBinary()
-					_ = yym1031
+			if yyr1090 || yy2arr1090 {
+				if yyq1090[0] {
+					yym1092 := z.EncBinary()
+					_ = yym1092
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -11594,20 +12215,20 @@ func (x *ClusterAutoscaler) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq1029[0] {
+				if yyq1090[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym1032 := z.EncBinary()
-					_ = yym1032
+					yym1093 := z.EncBinary()
+					_ = yym1093
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr1029 || yy2arr1029 {
-				if yyq1029[1] {
-					yym1034 := z.EncBinary()
-					_ = yym1034
+			if yyr1090 || yy2arr1090 {
+				if yyq1090[1] {
+					yym1095 := z.EncBinary()
+					_ = yym1095
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -11616,45 +12237,45 @@ func (x *ClusterAutoscaler) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq1029[1] {
+				if yyq1090[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym1035 := z.EncBinary()
-					_ = yym1035
+					yym1096 := z.EncBinary()
+					_ = yym1096
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr1029 || yy2arr1029 {
-				if yyq1029[2] {
-					yy1037 := &x.ObjectMeta
-					yy1037.CodecEncodeSelf(e)
+			if yyr1090 || yy2arr1090 {
+				if yyq1090[2] {
+					yy1098 := &x.ObjectMeta
+					yy1098.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1029[2] {
+				if yyq1090[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy1038 := &x.ObjectMeta
-					yy1038.CodecEncodeSelf(e)
+					yy1099 := &x.ObjectMeta
+					yy1099.CodecEncodeSelf(e)
 				}
 			}
-			if yyr1029 || yy2arr1029 {
-				if yyq1029[3] {
-					yy1040 := &x.Spec
-					yy1040.CodecEncodeSelf(e)
+			if yyr1090 || yy2arr1090 {
+				if yyq1090[3] {
+					yy1101 := &x.Spec
+					yy1101.CodecEncodeSelf(e)
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1029[3] {
+				if yyq1090[3] {
 					r.EncodeString(codecSelferC_UTF81234, string("spec"))
-					yy1041 := &x.Spec
-					yy1041.CodecEncodeSelf(e)
+					yy1102 := &x.Spec
+					yy1102.CodecEncodeSelf(e)
 				}
 			}
-			if yysep1029 {
+			if yysep1090 {
 				r.EncodeEnd()
 			}
 		}
@@ -11665,24 +12286,24 @@ func (x *ClusterAutoscaler) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1042 := z.DecBinary()
-	_ = yym1042
+	yym1103 := z.DecBinary()
+	_ = yym1103
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1043 := r.ReadMapStart()
-			if yyl1043 == 0 {
+			yyl1104 := r.ReadMapStart()
+			if yyl1104 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1043, d)
+				x.codecDecodeSelfFromMap(yyl1104, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1043 := r.ReadArrayStart()
-			if yyl1043 == 0 {
+			yyl1104 := r.ReadArrayStart()
+			if yyl1104 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1043, d)
+				x.codecDecodeSelfFromArray(yyl1104, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -11694,12 +12315,12 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1044Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1044Slc
-	var yyhl1044 bool = l >= 0
-	for yyj1044 := 0; ; yyj1044++ {
-		if yyhl1044 {
-			if yyj1044 >= l {
+	var yys1105Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1105Slc
+	var yyhl1105 bool = l >= 0
+	for yyj1105 := 0; ; yyj1105++ {
+		if yyhl1105 {
+			if yyj1105 >= l {
 				break
 			}
 		} else {
@@ -11707,9 +12328,9 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 				break
 			}
 		}
-		yys1044Slc = r.DecodeBytes(yys1044Slc, true, true)
-		yys1044 := string(yys1044Slc)
-		switch yys1044 {
+		yys1105Slc = r.DecodeBytes(yys1105Slc, true, true)
+		yys1105 := string(yys1105Slc)
+		switch yys1105 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -11726,21 +12347,21 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 			if r.TryDecodeAsNil() {
 				x.ObjectMeta = pkg2_v1.ObjectMeta{}
 			} else {
-				yyv1047 := &x.ObjectMeta
-				yyv1047.CodecDecodeSelf(d)
+				yyv1108 := &x.ObjectMeta
+				yyv1108.CodecDecodeSelf(d)
 			}
 		case "spec":
 			if r.TryDecodeAsNil() {
 				x.Spec = ClusterAutoscalerSpec{}
 			} else {
-				yyv1048 := &x.Spec
-				yyv1048.CodecDecodeSelf(d)
+				yyv1109 := &x.Spec
+				yyv1109.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1044)
-		} // end switch yys1044
-	} // end for yyj1044
-	if !yyhl1044 {
+			z.DecStructFieldNotFound(-1, yys1105)
+		} // end switch yys1105
+	} // end for yyj1105
+	if !yyhl1105 {
 		r.ReadEnd()
 	}
 }
@@ -11749,16 +12370,16 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromArray(l int, d *codec1978.Decoder
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1049 int
-	var yyb1049 bool
-	var yyhl1049 bool = l >= 0
-	yyj1049++
-	if yyhl1049 {
-		yyb1049 = yyj1049 > l
+	var yyj1110 int
+	var yyb1110 bool
+	var yyhl1110 bool = l >= 0
+	yyj1110++
+	if yyhl1110 {
+		yyb1110 = yyj1110 > l
 	} else {
-		yyb1049 = r.CheckBreak()
+		yyb1110 = r.CheckBreak()
 	}
-	if yyb1049 {
+	if yyb1110 {
 		r.ReadEnd()
 		return
 	}
@@ -11767,13 +12388,13 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromArray(l int, d *codec1978.Decoder
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj1049++
-	if yyhl1049 {
-		yyb1049 = yyj1049 > l
+	yyj1110++
+	if yyhl1110 {
+		yyb1110 = yyj1110 > l
 	} else {
-		yyb1049 = r.CheckBreak()
+		yyb1110 = r.CheckBreak()
 	}
-	if yyb1049 {
+	if yyb1110 {
 		r.ReadEnd()
 		return
 	}
@@ -11782,49 +12403,49 @@ func (x *ClusterAutoscaler) codecDecodeSelfFromArray(l int, d *codec1978.Decoder
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj1049++
-	if yyhl1049 {
-		yyb1049 = yyj1049 > l
+	yyj1110++
+	if yyhl1110 {
+		yyb1110 = yyj1110 > l
 	} else {
-		yyb1049 = r.CheckBreak()
+		yyb1110 = r.CheckBreak()
 	}
-	if yyb1049 {
+	if yyb1110 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ObjectMeta = pkg2_v1.ObjectMeta{}
 	} else {
-		yyv1052 := &x.ObjectMeta
-		yyv1052.CodecDecodeSelf(d)
+		yyv1113 := &x.ObjectMeta
+		yyv1113.CodecDecodeSelf(d)
 	}
-	yyj1049++
-	if yyhl1049 {
-		yyb1049 = yyj1049 > l
+	yyj1110++
+	if yyhl1110 {
+		yyb1110 = yyj1110 > l
 	} else {
-		yyb1049 = r.CheckBreak()
+		yyb1110 = r.CheckBreak()
 	}
-	if yyb1049 {
+	if yyb1110 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Spec = ClusterAutoscalerSpec{}
 	} else {
-		yyv1053 := &x.Spec
-		yyv1053.CodecDecodeSelf(d)
+		yyv1114 := &x.Spec
+		yyv1114.CodecDecodeSelf(d)
 	}
 	for {
-		yyj1049++
-		if yyhl1049 {
-			yyb1049 = yyj1049 > l
+		yyj1110++
+		if yyhl1110 {
+			yyb1110 = yyj1110 > l
 		} else {
-			yyb1049 = r.CheckBreak()
+			yyb1110 = r.CheckBreak()
 		}
-		if yyb1049 {
+		if yyb1110 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1049-1, "")
+		z.DecStructFieldNotFound(yyj1110-1, "")
 	}
 	r.ReadEnd()
 }
@@ -11836,34 +12457,34 @@ func (x *ClusterAutoscalerList) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1054 := z.EncBinary()
-		_ = yym1054
+		yym1115 := z.EncBinary()
+		_ = yym1115
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1055 := !z.EncBinary()
-			yy2arr1055 := z.EncBasicHandle().StructToArray
-			var yyq1055 [4]bool
-			_, _, _ = yysep1055, yyq1055, yy2arr1055
-			const yyr1055 bool = false
-			yyq1055[0] = x.Kind != ""
-			yyq1055[1] = x.APIVersion != ""
-			yyq1055[2] = true
-			if yyr1055 || yy2arr1055 {
+			yysep1116 := !z.EncBinary()
+			yy2arr1116 := z.EncBasicHandle().StructToArray
+			var yyq1116 [4]bool
+			_, _, _ = yysep1116, yyq1116, yy2arr1116
+			const yyr1116 bool = false
+			yyq1116[0] = x.Kind != ""
+			yyq1116[1] = x.APIVersion != ""
+			yyq1116[2] = true
+			if yyr1116 || yy2arr1116 {
 				r.EncodeArrayStart(4)
 			} else {
-				var yynn1055 int = 1
-				for _, b := range yyq1055 {
+				var yynn1116 int = 1
+				for _, b := range yyq1116 {
 					if b {
-						yynn1055++
+						yynn1116++
 					}
 				}
-				r.EncodeMapStart(yynn1055)
+				r.EncodeMapStart(yynn1116)
 			}
-			if yyr1055 || yy2arr1055 {
-				if yyq1055[0] {
-					yym1057 := z.EncBinary()
-					_ = yym1057
+			if yyr1116 || yy2arr1116 {
+				if yyq1116[0] {
+					yym1118 := z.EncBinary()
+					_ = yym1118
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
@@ -11872,20 +12493,20 @@ func (x *ClusterAutoscalerList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq1055[0] {
+				if yyq1116[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("kind"))
-					yym1058 := z.EncBinary()
-					_ = yym1058
+					yym1119 := z.EncBinary()
+					_ = yym1119
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
 					}
 				}
 			}
-			if yyr1055 || yy2arr1055 {
-				if yyq1055[1] {
-					yym1060 := z.EncBinary()
-					_ = yym1060
+			if yyr1116 || yy2arr1116 {
+				if yyq1116[1] {
+					yym1121 := z.EncBinary()
+					_ = yym1121
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
@@ -11894,48 +12515,48 @@ func (x *ClusterAutoscalerList) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeString(codecSelferC_UTF81234, "")
 				}
 			} else {
-				if yyq1055[1] {
+				if yyq1116[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
-					yym1061 := z.EncBinary()
-					_ = yym1061
+					yym1122 := z.EncBinary()
+					_ = yym1122
 					if false {
 					} else {
 						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
 					}
 				}
 			}
-			if yyr1055 || yy2arr1055 {
-				if yyq1055[2] {
-					yy1063 := &x.ListMeta
-					yym1064 := z.EncBinary()
-					_ = yym1064
+			if yyr1116 || yy2arr1116 {
+				if yyq1116[2] {
+					yy1124 := &x.ListMeta
+					yym1125 := z.EncBinary()
+					_ = yym1125
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy1063) {
+					} else if z.HasExtensions() && z.EncExt(yy1124) {
 					} else {
-						z.EncFallback(yy1063)
+						z.EncFallback(yy1124)
 					}
 				} else {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1055[2] {
+				if yyq1116[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
-					yy1065 := &x.ListMeta
-					yym1066 := z.EncBinary()
-					_ = yym1066
+					yy1126 := &x.ListMeta
+					yym1127 := z.EncBinary()
+					_ = yym1127
 					if false {
-					} else if z.HasExtensions() && z.EncExt(yy1065) {
+					} else if z.HasExtensions() && z.EncExt(yy1126) {
 					} else {
-						z.EncFallback(yy1065)
+						z.EncFallback(yy1126)
 					}
 				}
 			}
-			if yyr1055 || yy2arr1055 {
+			if yyr1116 || yy2arr1116 {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym1068 := z.EncBinary()
-					_ = yym1068
+					yym1129 := z.EncBinary()
+					_ = yym1129
 					if false {
 					} else {
 						h.encSliceClusterAutoscaler(([]ClusterAutoscaler)(x.Items), e)
@@ -11946,15 +12567,15 @@ func (x *ClusterAutoscalerList) CodecEncodeSelf(e *codec1978.Encoder) {
 				if x.Items == nil {
 					r.EncodeNil()
 				} else {
-					yym1069 := z.EncBinary()
-					_ = yym1069
+					yym1130 := z.EncBinary()
+					_ = yym1130
 					if false {
 					} else {
 						h.encSliceClusterAutoscaler(([]ClusterAutoscaler)(x.Items), e)
 					}
 				}
 			}
-			if yysep1055 {
+			if yysep1116 {
 				r.EncodeEnd()
 			}
 		}
@@ -11965,24 +12586,24 @@ func (x *ClusterAutoscalerList) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1070 := z.DecBinary()
-	_ = yym1070
+	yym1131 := z.DecBinary()
+	_ = yym1131
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1071 := r.ReadMapStart()
-			if yyl1071 == 0 {
+			yyl1132 := r.ReadMapStart()
+			if yyl1132 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1071, d)
+				x.codecDecodeSelfFromMap(yyl1132, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1071 := r.ReadArrayStart()
-			if yyl1071 == 0 {
+			yyl1132 := r.ReadArrayStart()
+			if yyl1132 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1071, d)
+				x.codecDecodeSelfFromArray(yyl1132, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -11994,12 +12615,12 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1072Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1072Slc
-	var yyhl1072 bool = l >= 0
-	for yyj1072 := 0; ; yyj1072++ {
-		if yyhl1072 {
-			if yyj1072 >= l {
+	var yys1133Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1133Slc
+	var yyhl1133 bool = l >= 0
+	for yyj1133 := 0; ; yyj1133++ {
+		if yyhl1133 {
+			if yyj1133 >= l {
 				break
 			}
 		} else {
@@ -12007,9 +12628,9 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 				break
 			}
 		}
-		yys1072Slc = r.DecodeBytes(yys1072Slc, true, true)
-		yys1072 := string(yys1072Slc)
-		switch yys1072 {
+		yys1133Slc = r.DecodeBytes(yys1133Slc, true, true)
+		yys1133 := string(yys1133Slc)
+		switch yys1133 {
 		case "kind":
 			if r.TryDecodeAsNil() {
 				x.Kind = ""
@@ -12026,32 +12647,32 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 			if r.TryDecodeAsNil() {
 				x.ListMeta = pkg1_unversioned.ListMeta{}
 			} else {
-				yyv1075 := &x.ListMeta
-				yym1076 := z.DecBinary()
-				_ = yym1076
+				yyv1136 := &x.ListMeta
+				yym1137 := z.DecBinary()
+				_ = yym1137
 				if false {
-				} else if z.HasExtensions() && z.DecExt(yyv1075) {
+				} else if z.HasExtensions() && z.DecExt(yyv1136) {
 				} else {
-					z.DecFallback(yyv1075, false)
+					z.DecFallback(yyv1136, false)
 				}
 			}
 		case "items":
 			if r.TryDecodeAsNil() {
 				x.Items = nil
 			} else {
-				yyv1077 := &x.Items
-				yym1078 := z.DecBinary()
-				_ = yym1078
+				yyv1138 := &x.Items
+				yym1139 := z.DecBinary()
+				_ = yym1139
 				if false {
 				} else {
-					h.decSliceClusterAutoscaler((*[]ClusterAutoscaler)(yyv1077), d)
+					h.decSliceClusterAutoscaler((*[]ClusterAutoscaler)(yyv1138), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1072)
-		} // end switch yys1072
-	} // end for yyj1072
-	if !yyhl1072 {
+			z.DecStructFieldNotFound(-1, yys1133)
+		} // end switch yys1133
+	} // end for yyj1133
+	if !yyhl1133 {
 		r.ReadEnd()
 	}
 }
@@ -12060,16 +12681,16 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1079 int
-	var yyb1079 bool
-	var yyhl1079 bool = l >= 0
-	yyj1079++
-	if yyhl1079 {
-		yyb1079 = yyj1079 > l
+	var yyj1140 int
+	var yyb1140 bool
+	var yyhl1140 bool = l >= 0
+	yyj1140++
+	if yyhl1140 {
+		yyb1140 = yyj1140 > l
 	} else {
-		yyb1079 = r.CheckBreak()
+		yyb1140 = r.CheckBreak()
 	}
-	if yyb1079 {
+	if yyb1140 {
 		r.ReadEnd()
 		return
 	}
@@ -12078,13 +12699,13 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	} else {
 		x.Kind = string(r.DecodeString())
 	}
-	yyj1079++
-	if yyhl1079 {
-		yyb1079 = yyj1079 > l
+	yyj1140++
+	if yyhl1140 {
+		yyb1140 = yyj1140 > l
 	} else {
-		yyb1079 = r.CheckBreak()
+		yyb1140 = r.CheckBreak()
 	}
-	if yyb1079 {
+	if yyb1140 {
 		r.ReadEnd()
 		return
 	}
@@ -12093,60 +12714,60 @@ func (x *ClusterAutoscalerList) codecDecodeSelfFromArray(l int, d *codec1978.Dec
 	} else {
 		x.APIVersion = string(r.DecodeString())
 	}
-	yyj1079++
-	if yyhl1079 {
-		yyb1079 = yyj1079 > l
+	yyj1140++
+	if yyhl1140 {
+		yyb1140 = yyj1140 > l
 	} else {
-		yyb1079 = r.CheckBreak()
+		yyb1140 = r.CheckBreak()
 	}
-	if yyb1079 {
+	if yyb1140 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.ListMeta = pkg1_unversioned.ListMeta{}
 	} else {
-		yyv1082 := &x.ListMeta
-		yym1083 := z.DecBinary()
-		_ = yym1083
+		yyv1143 := &x.ListMeta
+		yym1144 := z.DecBinary()
+		_ = yym1144
 		if false {
-		} else if z.HasExtensions() && z.DecExt(yyv1082) {
+		} else if z.HasExtensions() && z.DecExt(yyv1143) {
 		} else {
-			z.DecFallback(yyv1082, false)
+			z.DecFallback(yyv1143, false)
 		}
 	}
-	yyj1079++
-	if yyhl1079 {
-		yyb1079 = yyj1079 > l
+	yyj1140++
+	if yyhl1140 {
+		yyb1140 = yyj1140 > l
 	} else {
-		yyb1079 = r.CheckBreak()
+		yyb1140 = r.CheckBreak()
 	}
-	if yyb1079 {
+	if yyb1140 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Items = nil
 	} else {
-		yyv1084 := &x.Items
-		yym1085 := z.DecBinary()
-		_ = yym1085
+		yyv1145 := &x.Items
+		yym1146 := z.DecBinary()
+		_ = yym1146
 		if false {
 		} else {
-			h.decSliceClusterAutoscaler((*[]ClusterAutoscaler)(yyv1084), d)
+			h.decSliceClusterAutoscaler((*[]ClusterAutoscaler)(yyv1145), d)
 		}
 	}
 	for {
-		yyj1079++
-		if yyhl1079 {
-			yyb1079 = yyj1079 > l
+		yyj1140++
+		if yyhl1140 {
+			yyb1140 = yyj1140 > l
 		} else {
-			yyb1079 = r.CheckBreak()
+			yyb1140 = r.CheckBreak()
 		}
-		if yyb1079 {
+		if yyb1140 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1079-1, "")
+		z.DecStructFieldNotFound(yyj1140-1, "")
 	}
 	r.ReadEnd()
 }
@@ -12158,36 +12779,36 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1086 := z.EncBinary()
-		_ = yym1086
+		yym1147 := z.EncBinary()
+		_ = yym1147
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1087 := !z.EncBinary()
-			yy2arr1087 := z.EncBasicHandle().StructToArray
-			var yyq1087 [2]bool
-			_, _, _ = yysep1087, yyq1087, yy2arr1087
-			const yyr1087 bool = false
-			yyq1087[0] = len(x.MatchLabels) != 0
-			yyq1087[1] = len(x.MatchExpressions) != 0
-			if yyr1087 || yy2arr1087 {
+			yysep1148 := !z.EncBinary()
+			yy2arr1148 := z.EncBasicHandle().StructToArray
+			var yyq1148 [2]bool
+			_, _, _ = yysep1148, yyq1148, yy2arr1148
+			const yyr1148 bool = false
+			yyq1148[0] = len(x.MatchLabels) != 0
+			yyq1148[1] = len(x.MatchExpressions) != 0
+			if yyr1148 || yy2arr1148 {
 				r.EncodeArrayStart(2)
 			} else {
-				var yynn1087 int = 0
-				for _, b := range yyq1087 {
+				var yynn1148 int = 0
+				for _, b := range yyq1148 {
 					if b {
-						yynn1087++
+						yynn1148++
 					}
 				}
-				r.EncodeMapStart(yynn1087)
+				r.EncodeMapStart(yynn1148)
 			}
-			if yyr1087 || yy2arr1087 {
-				if yyq1087[0] {
+			if yyr1148 || yy2arr1148 {
+				if yyq1148[0] {
 					if x.MatchLabels == nil {
 						r.EncodeNil()
 					} else {
-						yym1089 := z.EncBinary()
-						_ = yym1089
+						yym1150 := z.EncBinary()
+						_ = yym1150
 						if false {
 						} else {
 							z.F.EncMapStringStringV(x.MatchLabels, false, e)
@@ -12197,13 +12818,13 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1087[0] {
+				if yyq1148[0] {
 					r.EncodeString(codecSelferC_UTF81234, string("matchLabels"))
 					if x.MatchLabels == nil {
 						r.EncodeNil()
 					} else {
-						yym1090 := z.EncBinary()
-						_ = yym1090
+						yym1151 := z.EncBinary()
+						_ = yym1151
 						if false {
 						} else {
 							z.F.EncMapStringStringV(x.MatchLabels, false, e)
@@ -12211,13 +12832,13 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yyr1087 || yy2arr1087 {
-				if yyq1087[1] {
+			if yyr1148 || yy2arr1148 {
+				if yyq1148[1] {
 					if x.MatchExpressions == nil {
 						r.EncodeNil()
 					} else {
-						yym1092 := z.EncBinary()
-						_ = yym1092
+						yym1153 := z.EncBinary()
+						_ = yym1153
 						if false {
 						} else {
 							h.encSlicePodSelectorRequirement(([]PodSelectorRequirement)(x.MatchExpressions), e)
@@ -12227,13 +12848,13 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1087[1] {
+				if yyq1148[1] {
 					r.EncodeString(codecSelferC_UTF81234, string("matchExpressions"))
 					if x.MatchExpressions == nil {
 						r.EncodeNil()
 					} else {
-						yym1093 := z.EncBinary()
-						_ = yym1093
+						yym1154 := z.EncBinary()
+						_ = yym1154
 						if false {
 						} else {
 							h.encSlicePodSelectorRequirement(([]PodSelectorRequirement)(x.MatchExpressions), e)
@@ -12241,7 +12862,7 @@ func (x *PodSelector) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep1087 {
+			if yysep1148 {
 				r.EncodeEnd()
 			}
 		}
@@ -12252,24 +12873,24 @@ func (x *PodSelector) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1094 := z.DecBinary()
-	_ = yym1094
+	yym1155 := z.DecBinary()
+	_ = yym1155
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1095 := r.ReadMapStart()
-			if yyl1095 == 0 {
+			yyl1156 := r.ReadMapStart()
+			if yyl1156 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1095, d)
+				x.codecDecodeSelfFromMap(yyl1156, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1095 := r.ReadArrayStart()
-			if yyl1095 == 0 {
+			yyl1156 := r.ReadArrayStart()
+			if yyl1156 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1095, d)
+				x.codecDecodeSelfFromArray(yyl1156, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -12281,12 +12902,12 @@ func (x *PodSelector) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1096Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1096Slc
-	var yyhl1096 bool = l >= 0
-	for yyj1096 := 0; ; yyj1096++ {
-		if yyhl1096 {
-			if yyj1096 >= l {
+	var yys1157Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1157Slc
+	var yyhl1157 bool = l >= 0
+	for yyj1157 := 0; ; yyj1157++ {
+		if yyhl1157 {
+			if yyj1157 >= l {
 				break
 			}
 		} else {
@@ -12294,38 +12915,38 @@ func (x *PodSelector) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 				break
 			}
 		}
-		yys1096Slc = r.DecodeBytes(yys1096Slc, true, true)
-		yys1096 := string(yys1096Slc)
-		switch yys1096 {
+		yys1157Slc = r.DecodeBytes(yys1157Slc, true, true)
+		yys1157 := string(yys1157Slc)
+		switch yys1157 {
 		case "matchLabels":
 			if r.TryDecodeAsNil() {
 				x.MatchLabels = nil
 			} else {
-				yyv1097 := &x.MatchLabels
-				yym1098 := z.DecBinary()
-				_ = yym1098
+				yyv1158 := &x.MatchLabels
+				yym1159 := z.DecBinary()
+				_ = yym1159
 				if false {
 				} else {
-					z.F.DecMapStringStringX(yyv1097, false, d)
+					z.F.DecMapStringStringX(yyv1158, false, d)
 				}
 			}
 		case "matchExpressions":
 			if r.TryDecodeAsNil() {
 				x.MatchExpressions = nil
 			} else {
-				yyv1099 := &x.MatchExpressions
-				yym1100 := z.DecBinary()
-				_ = yym1100
+				yyv1160 := &x.MatchExpressions
+				yym1161 := z.DecBinary()
+				_ = yym1161
 				if false {
 				} else {
-					h.decSlicePodSelectorRequirement((*[]PodSelectorRequirement)(yyv1099), d)
+					h.decSlicePodSelectorRequirement((*[]PodSelectorRequirement)(yyv1160), d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1096)
-		} // end switch yys1096
-	} // end for yyj1096
-	if !yyhl1096 {
+			z.DecStructFieldNotFound(-1, yys1157)
+		} // end switch yys1157
+	} // end for yyj1157
+	if !yyhl1157 {
 		r.ReadEnd()
 	}
 }
@@ -12334,62 +12955,62 @@ func (x *PodSelector) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1101 int
-	var yyb1101 bool
-	var yyhl1101 bool = l >= 0
-	yyj1101++
-	if yyhl1101 {
-		yyb1101 = yyj1101 > l
+	var yyj1162 int
+	var yyb1162 bool
+	var yyhl1162 bool = l >= 0
+	yyj1162++
+	if yyhl1162 {
+		yyb1162 = yyj1162 > l
 	} else {
-		yyb1101 = r.CheckBreak()
+		yyb1162 = r.CheckBreak()
 	}
-	if yyb1101 {
+	if yyb1162 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.MatchLabels = nil
 	} else {
-		yyv1102 := &x.MatchLabels
-		yym1103 := z.DecBinary()
-		_ = yym1103
+		yyv1163 := &x.MatchLabels
+		yym1164 := z.DecBinary()
+		_ = yym1164
 		if false {
 		} else {
-			z.F.DecMapStringStringX(yyv1102, false, d)
+			z.F.DecMapStringStringX(yyv1163, false, d)
 		}
 	}
-	yyj1101++
-	if yyhl1101 {
-		yyb1101 = yyj1101 > l
+	yyj1162++
+	if yyhl1162 {
+		yyb1162 = yyj1162 > l
 	} else {
-		yyb1101 = r.CheckBreak()
+		yyb1162 = r.CheckBreak()
 	}
-	if yyb1101 {
+	if yyb1162 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.MatchExpressions = nil
 	} else {
-		yyv1104 := &x.MatchExpressions
-		yym1105 := z.DecBinary()
-		_ = yym1105
+		yyv1165 := &x.MatchExpressions
+		yym1166 := z.DecBinary()
+		_ = yym1166
 		if false {
 		} else {
-			h.decSlicePodSelectorRequirement((*[]PodSelectorRequirement)(yyv1104), d)
+			h.decSlicePodSelectorRequirement((*[]PodSelectorRequirement)(yyv1165), d)
 		}
 	}
 	for {
-		yyj1101++
-		if yyhl1101 {
-			yyb1101 = yyj1101 > l
+		yyj1162++
+		if yyhl1162 {
+			yyb1162 = yyj1162 > l
 		} else {
-			yyb1101 = r.CheckBreak()
+			yyb1162 = r.CheckBreak()
 		}
-		if yyb1101 {
+		if yyb1162 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1101-1, "")
+		z.DecStructFieldNotFound(yyj1162-1, "")
 	}
 	r.ReadEnd()
 }
@@ -12401,57 +13022,57 @@ func (x *PodSelectorRequirement) CodecEncodeSelf(e *codec1978.Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		yym1106 := z.EncBinary()
-		_ = yym1106
+		yym1167 := z.EncBinary()
+		_ = yym1167
 		if false {
 		} else if z.HasExtensions() && z.EncExt(x) {
 		} else {
-			yysep1107 := !z.EncBinary()
-			yy2arr1107 := z.EncBasicHandle().StructToArray
-			var yyq1107 [3]bool
-			_, _, _ = yysep1107, yyq1107, yy2arr1107
-			const yyr1107 bool = false
-			yyq1107[2] = len(x.Values) != 0
-			if yyr1107 || yy2arr1107 {
+			yysep1168 := !z.EncBinary()
+			yy2arr1168 := z.EncBasicHandle().StructToArray
+			var yyq1168 [3]bool
+			_, _, _ = yysep1168, yyq1168, yy2arr1168
+			const yyr1168 bool = false
+			yyq1168[2] = len(x.Values) != 0
+			if yyr1168 || yy2arr1168 {
 				r.EncodeArrayStart(3)
 			} else {
-				var yynn1107 int = 2
-				for _, b := range yyq1107 {
+				var yynn1168 int = 2
+				for _, b := range yyq1168 {
 					if b {
-						yynn1107++
+						yynn1168++
 					}
 				}
-				r.EncodeMapStart(yynn1107)
+				r.EncodeMapStart(yynn1168)
 			}
-			if yyr1107 || yy2arr1107 {
-				yym1109 := z.EncBinary()
-				_ = yym1109
+			if yyr1168 || yy2arr1168 {
+				yym1170 := z.EncBinary()
+				_ = yym1170
 				if false {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.Key))
 				}
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("key"))
-				yym1110 := z.EncBinary()
-				_ = yym1110
+				yym1171 := z.EncBinary()
+				_ = yym1171
 				if false {
 				} else {
 					r.EncodeString(codecSelferC_UTF81234, string(x.Key))
 				}
 			}
-			if yyr1107 || yy2arr1107 {
+			if yyr1168 || yy2arr1168 {
 				x.Operator.CodecEncodeSelf(e)
 			} else {
 				r.EncodeString(codecSelferC_UTF81234, string("operator"))
 				x.Operator.CodecEncodeSelf(e)
 			}
-			if yyr1107 || yy2arr1107 {
-				if yyq1107[2] {
+			if yyr1168 || yy2arr1168 {
+				if yyq1168[2] {
 					if x.Values == nil {
 						r.EncodeNil()
 					} else {
-						yym1113 := z.EncBinary()
-						_ = yym1113
+						yym1174 := z.EncBinary()
+						_ = yym1174
 						if false {
 						} else {
 							z.F.EncSliceStringV(x.Values, false, e)
@@ -12461,13 +13082,13 @@ func (x *PodSelectorRequirement) CodecEncodeSelf(e *codec1978.Encoder) {
 					r.EncodeNil()
 				}
 			} else {
-				if yyq1107[2] {
+				if yyq1168[2] {
 					r.EncodeString(codecSelferC_UTF81234, string("values"))
 					if x.Values == nil {
 						r.EncodeNil()
 					} else {
-						yym1114 := z.EncBinary()
-						_ = yym1114
+						yym1175 := z.EncBinary()
+						_ = yym1175
 						if false {
 						} else {
 							z.F.EncSliceStringV(x.Values, false, e)
@@ -12475,7 +13096,7 @@ func (x *PodSelectorRequirement) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
-			if yysep1107 {
+			if yysep1168 {
 				r.EncodeEnd()
 			}
 		}
@@ -12486,24 +13107,24 @@ func (x *PodSelectorRequirement) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1115 := z.DecBinary()
-	_ = yym1115
+	yym1176 := z.DecBinary()
+	_ = yym1176
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
 		if r.IsContainerType(codecSelferValueTypeMap1234) {
-			yyl1116 := r.ReadMapStart()
-			if yyl1116 == 0 {
+			yyl1177 := r.ReadMapStart()
+			if yyl1177 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromMap(yyl1116, d)
+				x.codecDecodeSelfFromMap(yyl1177, d)
 			}
 		} else if r.IsContainerType(codecSelferValueTypeArray1234) {
-			yyl1116 := r.ReadArrayStart()
-			if yyl1116 == 0 {
+			yyl1177 := r.ReadArrayStart()
+			if yyl1177 == 0 {
 				r.ReadEnd()
 			} else {
-				x.codecDecodeSelfFromArray(yyl1116, d)
+				x.codecDecodeSelfFromArray(yyl1177, d)
 			}
 		} else {
 			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
@@ -12515,12 +13136,12 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromMap(l int, d *codec1978.Deco
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yys1117Slc = z.DecScratchBuffer() // default slice to decode into
-	_ = yys1117Slc
-	var yyhl1117 bool = l >= 0
-	for yyj1117 := 0; ; yyj1117++ {
-		if yyhl1117 {
-			if yyj1117 >= l {
+	var yys1178Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys1178Slc
+	var yyhl1178 bool = l >= 0
+	for yyj1178 := 0; ; yyj1178++ {
+		if yyhl1178 {
+			if yyj1178 >= l {
 				break
 			}
 		} else {
@@ -12528,9 +13149,9 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromMap(l int, d *codec1978.Deco
 				break
 			}
 		}
-		yys1117Slc = r.DecodeBytes(yys1117Slc, true, true)
-		yys1117 := string(yys1117Slc)
-		switch yys1117 {
+		yys1178Slc = r.DecodeBytes(yys1178Slc, true, true)
+		yys1178 := string(yys1178Slc)
+		switch yys1178 {
 		case "key":
 			if r.TryDecodeAsNil() {
 				x.Key = ""
@@ -12547,19 +13168,19 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromMap(l int, d *codec1978.Deco
 			if r.TryDecodeAsNil() {
 				x.Values = nil
 			} else {
-				yyv1120 := &x.Values
-				yym1121 := z.DecBinary()
-				_ = yym1121
+				yyv1181 := &x.Values
+				yym1182 := z.DecBinary()
+				_ = yym1182
 				if false {
 				} else {
-					z.F.DecSliceStringX(yyv1120, false, d)
+					z.F.DecSliceStringX(yyv1181, false, d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys1117)
-		} // end switch yys1117
-	} // end for yyj1117
-	if !yyhl1117 {
+			z.DecStructFieldNotFound(-1, yys1178)
+		} // end switch yys1178
+	} // end for yyj1178
+	if !yyhl1178 {
 		r.ReadEnd()
 	}
 }
@@ -12568,16 +13189,16 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromArray(l int, d *codec1978.De
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj1122 int
-	var yyb1122 bool
-	var yyhl1122 bool = l >= 0
-	yyj1122++
-	if yyhl1122 {
-		yyb1122 = yyj1122 > l
+	var yyj1183 int
+	var yyb1183 bool
+	var yyhl1183 bool = l >= 0
+	yyj1183++
+	if yyhl1183 {
+		yyb1183 = yyj1183 > l
 	} else {
-		yyb1122 = r.CheckBreak()
+		yyb1183 = r.CheckBreak()
 	}
-	if yyb1122 {
+	if yyb1183 {
 		r.ReadEnd()
 		return
 	}
@@ -12586,13 +13207,13 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromArray(l int, d *codec1978.De
 	} else {
 		x.Key = string(r.DecodeString())
 	}
-	yyj1122++
-	if yyhl1122 {
-		yyb1122 = yyj1122 > l
+	yyj1183++
+	if yyhl1183 {
+		yyb1183 = yyj1183 > l
 	} else {
-		yyb1122 = r.CheckBreak()
+		yyb1183 = r.CheckBreak()
 	}
-	if yyb1122 {
+	if yyb1183 {
 		r.ReadEnd()
 		return
 	}
@@ -12601,38 +13222,38 @@ func (x *PodSelectorRequirement) codecDecodeSelfFromArray(l int, d *codec1978.De
 	} else {
 		x.Operator = PodSelectorOperator(r.DecodeString())
 	}
-	yyj1122++
-	if yyhl1122 {
-		yyb1122 = yyj1122 > l
+	yyj1183++
+	if yyhl1183 {
+		yyb1183 = yyj1183 > l
 	} else {
-		yyb1122 = r.CheckBreak()
+		yyb1183 = r.CheckBreak()
 	}
-	if yyb1122 {
+	if yyb1183 {
 		r.ReadEnd()
 		return
 	}
 	if r.TryDecodeAsNil() {
 		x.Values = nil
 	} else {
-		yyv1125 := &x.Values
-		yym1126 := z.DecBinary()
-		_ = yym1126
+		yyv1186 := &x.Values
+		yym1187 := z.DecBinary()
+		_ = yym1187
 		if false {
 		} else {
-			z.F.DecSliceStringX(yyv1125, false, d)
+			z.F.DecSliceStringX(yyv1186, false, d)
 		}
 	}
 	for {
-		yyj1122++
-		if yyhl1122 {
-			yyb1122 = yyj1122 > l
+		yyj1183++
+		if yyhl1183 {
+			yyb1183 = yyj1183 > l
 		} else {
-			yyb1122 = r.CheckBreak()
+			yyb1183 = r.CheckBreak()
 		}
-		if yyb1122 {
+		if yyb1183 {
 			break
 		}
-		z.DecStructFieldNotFound(yyj1122-1, "")
+		z.DecStructFieldNotFound(yyj1183-1, "")
 	}
 	r.ReadEnd()
 }
@@ -12641,8 +13262,8 @@ func (x PodSelectorOperator) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	yym1127 := z.EncBinary()
-	_ = yym1127
+	yym1188 := z.EncBinary()
+	_ = yym1188
 	if false {
 	} else if z.HasExtensions() && z.EncExt(x) {
 	} else {
@@ -12654,8 +13275,8 @@ func (x *PodSelectorOperator) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer1234
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	yym1128 := z.DecBinary()
-	_ = yym1128
+	yym1189 := z.DecBinary()
+	_ = yym1189
 	if false {
 	} else if z.HasExtensions() && z.DecExt(x) {
 	} else {
@@ -12668,9 +13289,9 @@ func (x codecSelfer1234) encSliceHorizontalPodAutoscaler(v []HorizontalPodAutosc
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1129 := range v {
-		yy1130 := &yyv1129
-		yy1130.CodecEncodeSelf(e)
+	for _, yyv1190 := range v {
+		yy1191 := &yyv1190
+		yy1191.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12680,75 +13301,75 @@ func (x codecSelfer1234) decSliceHorizontalPodAutoscaler(v *[]HorizontalPodAutos
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1131 := *v
-	yyh1131, yyl1131 := z.DecSliceHelperStart()
+	yyv1192 := *v
+	yyh1192, yyl1192 := z.DecSliceHelperStart()
 
-	var yyrr1131, yyrl1131 int
-	var yyc1131, yyrt1131 bool
-	_, _, _ = yyc1131, yyrt1131, yyrl1131
-	yyrr1131 = yyl1131
+	var yyrr1192, yyrl1192 int
+	var yyc1192, yyrt1192 bool
+	_, _, _ = yyc1192, yyrt1192, yyrl1192
+	yyrr1192 = yyl1192
 
-	if yyv1131 == nil {
-		if yyrl1131, yyrt1131 = z.DecInferLen(yyl1131, z.DecBasicHandle().MaxInitLen, 320); yyrt1131 {
-			yyrr1131 = yyrl1131
+	if yyv1192 == nil {
+		if yyrl1192, yyrt1192 = z.DecInferLen(yyl1192, z.DecBasicHandle().MaxInitLen, 320); yyrt1192 {
+			yyrr1192 = yyrl1192
 		}
-		yyv1131 = make([]HorizontalPodAutoscaler, yyrl1131)
-		yyc1131 = true
+		yyv1192 = make([]HorizontalPodAutoscaler, yyrl1192)
+		yyc1192 = true
 	}
 
-	if yyl1131 == 0 {
-		if len(yyv1131) != 0 {
-			yyv1131 = yyv1131[:0]
-			yyc1131 = true
+	if yyl1192 == 0 {
+		if len(yyv1192) != 0 {
+			yyv1192 = yyv1192[:0]
+			yyc1192 = true
 		}
-	} else if yyl1131 > 0 {
+	} else if yyl1192 > 0 {
 
-		if yyl1131 > cap(yyv1131) {
-			yyrl1131, yyrt1131 = z.DecInferLen(yyl1131, z.DecBasicHandle().MaxInitLen, 320)
-			yyv1131 = make([]HorizontalPodAutoscaler, yyrl1131)
-			yyc1131 = true
+		if yyl1192 > cap(yyv1192) {
+			yyrl1192, yyrt1192 = z.DecInferLen(yyl1192, z.DecBasicHandle().MaxInitLen, 320)
+			yyv1192 = make([]HorizontalPodAutoscaler, yyrl1192)
+			yyc1192 = true
 
-			yyrr1131 = len(yyv1131)
-		} else if yyl1131 != len(yyv1131) {
-			yyv1131 = yyv1131[:yyl1131]
-			yyc1131 = true
+			yyrr1192 = len(yyv1192)
+		} else if yyl1192 != len(yyv1192) {
+			yyv1192 = yyv1192[:yyl1192]
+			yyc1192 = true
 		}
-		yyj1131 := 0
-		for ; yyj1131 < yyrr1131; yyj1131++ {
+		yyj1192 := 0
+		for ; yyj1192 < yyrr1192; yyj1192++ {
 			if r.TryDecodeAsNil() {
-				yyv1131[yyj1131] = HorizontalPodAutoscaler{}
+				yyv1192[yyj1192] = HorizontalPodAutoscaler{}
 			} else {
-				yyv1132 := &yyv1131[yyj1131]
-				yyv1132.CodecDecodeSelf(d)
+				yyv1193 := &yyv1192[yyj1192]
+				yyv1193.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1131 {
-			for ; yyj1131 < yyl1131; yyj1131++ {
-				yyv1131 = append(yyv1131, HorizontalPodAutoscaler{})
+		if yyrt1192 {
+			for ; yyj1192 < yyl1192; yyj1192++ {
+				yyv1192 = append(yyv1192, HorizontalPodAutoscaler{})
 				if r.TryDecodeAsNil() {
-					yyv1131[yyj1131] = HorizontalPodAutoscaler{}
+					yyv1192[yyj1192] = HorizontalPodAutoscaler{}
 				} else {
-					yyv1133 := &yyv1131[yyj1131]
-					yyv1133.CodecDecodeSelf(d)
+					yyv1194 := &yyv1192[yyj1192]
+					yyv1194.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1131 := 0; !r.CheckBreak(); yyj1131++ {
-			if yyj1131 >= len(yyv1131) {
-				yyv1131 = append(yyv1131, HorizontalPodAutoscaler{}) // var yyz1131 HorizontalPodAutoscaler
-				yyc1131 = true
+		for yyj1192 := 0; !r.CheckBreak(); yyj1192++ {
+			if yyj1192 >= len(yyv1192) {
+				yyv1192 = append(yyv1192, HorizontalPodAutoscaler{}) // var yyz1192 HorizontalPodAutoscaler
+				yyc1192 = true
 			}
 
-			if yyj1131 < len(yyv1131) {
+			if yyj1192 < len(yyv1192) {
 				if r.TryDecodeAsNil() {
-					yyv1131[yyj1131] = HorizontalPodAutoscaler{}
+					yyv1192[yyj1192] = HorizontalPodAutoscaler{}
 				} else {
-					yyv1134 := &yyv1131[yyj1131]
-					yyv1134.CodecDecodeSelf(d)
+					yyv1195 := &yyv1192[yyj1192]
+					yyv1195.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -12756,10 +13377,10 @@ func (x codecSelfer1234) decSliceHorizontalPodAutoscaler(v *[]HorizontalPodAutos
 			}
 
 		}
-		yyh1131.End()
+		yyh1192.End()
 	}
-	if yyc1131 {
-		*v = yyv1131
+	if yyc1192 {
+		*v = yyv1192
 	}
 
 }
@@ -12769,9 +13390,9 @@ func (x codecSelfer1234) encSliceAPIVersion(v []APIVersion, e *codec1978.Encoder
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1135 := range v {
-		yy1136 := &yyv1135
-		yy1136.CodecEncodeSelf(e)
+	for _, yyv1196 := range v {
+		yy1197 := &yyv1196
+		yy1197.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12781,75 +13402,75 @@ func (x codecSelfer1234) decSliceAPIVersion(v *[]APIVersion, d *codec1978.Decode
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1137 := *v
-	yyh1137, yyl1137 := z.DecSliceHelperStart()
+	yyv1198 := *v
+	yyh1198, yyl1198 := z.DecSliceHelperStart()
 
-	var yyrr1137, yyrl1137 int
-	var yyc1137, yyrt1137 bool
-	_, _, _ = yyc1137, yyrt1137, yyrl1137
-	yyrr1137 = yyl1137
+	var yyrr1198, yyrl1198 int
+	var yyc1198, yyrt1198 bool
+	_, _, _ = yyc1198, yyrt1198, yyrl1198
+	yyrr1198 = yyl1198
 
-	if yyv1137 == nil {
-		if yyrl1137, yyrt1137 = z.DecInferLen(yyl1137, z.DecBasicHandle().MaxInitLen, 32); yyrt1137 {
-			yyrr1137 = yyrl1137
+	if yyv1198 == nil {
+		if yyrl1198, yyrt1198 = z.DecInferLen(yyl1198, z.DecBasicHandle().MaxInitLen, 32); yyrt1198 {
+			yyrr1198 = yyrl1198
 		}
-		yyv1137 = make([]APIVersion, yyrl1137)
-		yyc1137 = true
+		yyv1198 = make([]APIVersion, yyrl1198)
+		yyc1198 = true
 	}
 
-	if yyl1137 == 0 {
-		if len(yyv1137) != 0 {
-			yyv1137 = yyv1137[:0]
-			yyc1137 = true
+	if yyl1198 == 0 {
+		if len(yyv1198) != 0 {
+			yyv1198 = yyv1198[:0]
+			yyc1198 = true
 		}
-	} else if yyl1137 > 0 {
+	} else if yyl1198 > 0 {
 
-		if yyl1137 > cap(yyv1137) {
-			yyrl1137, yyrt1137 = z.DecInferLen(yyl1137, z.DecBasicHandle().MaxInitLen, 32)
-			yyv1137 = make([]APIVersion, yyrl1137)
-			yyc1137 = true
+		if yyl1198 > cap(yyv1198) {
+			yyrl1198, yyrt1198 = z.DecInferLen(yyl1198, z.DecBasicHandle().MaxInitLen, 32)
+			yyv1198 = make([]APIVersion, yyrl1198)
+			yyc1198 = true
 
-			yyrr1137 = len(yyv1137)
-		} else if yyl1137 != len(yyv1137) {
-			yyv1137 = yyv1137[:yyl1137]
-			yyc1137 = true
+			yyrr1198 = len(yyv1198)
+		} else if yyl1198 != len(yyv1198) {
+			yyv1198 = yyv1198[:yyl1198]
+			yyc1198 = true
 		}
-		yyj1137 := 0
-		for ; yyj1137 < yyrr1137; yyj1137++ {
+		yyj1198 := 0
+		for ; yyj1198 < yyrr1198; yyj1198++ {
 			if r.TryDecodeAsNil() {
-				yyv1137[yyj1137] = APIVersion{}
+				yyv1198[yyj1198] = APIVersion{}
 			} else {
-				yyv1138 := &yyv1137[yyj1137]
-				yyv1138.CodecDecodeSelf(d)
+				yyv1199 := &yyv1198[yyj1198]
+				yyv1199.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1137 {
-			for ; yyj1137 < yyl1137; yyj1137++ {
-				yyv1137 = append(yyv1137, APIVersion{})
+		if yyrt1198 {
+			for ; yyj1198 < yyl1198; yyj1198++ {
+				yyv1198 = append(yyv1198, APIVersion{})
 				if r.TryDecodeAsNil() {
-					yyv1137[yyj1137] = APIVersion{}
+					yyv1198[yyj1198] = APIVersion{}
 				} else {
-					yyv1139 := &yyv1137[yyj1137]
-					yyv1139.CodecDecodeSelf(d)
+					yyv1200 := &yyv1198[yyj1198]
+					yyv1200.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1137 := 0; !r.CheckBreak(); yyj1137++ {
-			if yyj1137 >= len(yyv1137) {
-				yyv1137 = append(yyv1137, APIVersion{}) // var yyz1137 APIVersion
-				yyc1137 = true
+		for yyj1198 := 0; !r.CheckBreak(); yyj1198++ {
+			if yyj1198 >= len(yyv1198) {
+				yyv1198 = append(yyv1198, APIVersion{}) // var yyz1198 APIVersion
+				yyc1198 = true
 			}
 
-			if yyj1137 < len(yyv1137) {
+			if yyj1198 < len(yyv1198) {
 				if r.TryDecodeAsNil() {
-					yyv1137[yyj1137] = APIVersion{}
+					yyv1198[yyj1198] = APIVersion{}
 				} else {
-					yyv1140 := &yyv1137[yyj1137]
-					yyv1140.CodecDecodeSelf(d)
+					yyv1201 := &yyv1198[yyj1198]
+					yyv1201.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -12857,10 +13478,10 @@ func (x codecSelfer1234) decSliceAPIVersion(v *[]APIVersion, d *codec1978.Decode
 			}
 
 		}
-		yyh1137.End()
+		yyh1198.End()
 	}
-	if yyc1137 {
-		*v = yyv1137
+	if yyc1198 {
+		*v = yyv1198
 	}
 
 }
@@ -12870,9 +13491,9 @@ func (x codecSelfer1234) encSliceThirdPartyResource(v []ThirdPartyResource, e *c
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1141 := range v {
-		yy1142 := &yyv1141
-		yy1142.CodecEncodeSelf(e)
+	for _, yyv1202 := range v {
+		yy1203 := &yyv1202
+		yy1203.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12882,75 +13503,176 @@ func (x codecSelfer1234) decSliceThirdPartyResource(v *[]ThirdPartyResource, d *
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1143 := *v
-	yyh1143, yyl1143 := z.DecSliceHelperStart()
+	yyv1204 := *v
+	yyh1204, yyl1204 := z.DecSliceHelperStart()
+
+	var yyrr1204, yyrl1204 int
+	var yyc1204, yyrt1204 bool
+	_, _, _ = yyc1204, yyrt1204, yyrl1204
+	yyrr1204 = yyl1204
+
+	if yyv1204 == nil {
+		if yyrl1204, yyrt1204 = z.DecInferLen(yyl1204, z.DecBasicHandle().MaxInitLen, 232); yyrt1204 {
+			yyrr1204 = yyrl1204
+		}
+		yyv1204 = make([]ThirdPartyResource, yyrl1204)
+		yyc1204 = true
+	}
+
+	if yyl1204 == 0 {
+		if len(yyv1204) != 0 {
+			yyv1204 = yyv1204[:0]
+			yyc1204 = true
+		}
+	} else if yyl1204 > 0 {
+
+		if yyl1204 > cap(yyv1204) {
+			yyrl1204, yyrt1204 = z.DecInferLen(yyl1204, z.DecBasicHandle().MaxInitLen, 232)
+			yyv1204 = make([]ThirdPartyResource, yyrl1204)
+			yyc1204 = true
+
+			yyrr1204 = len(yyv1204)
+		} else if yyl1204 != len(yyv1204) {
+			yyv1204 = yyv1204[:yyl1204]
+			yyc1204 = true
+		}
+		yyj1204 := 0
+		for ; yyj1204 < yyrr1204; yyj1204++ {
+			if r.TryDecodeAsNil() {
+				yyv1204[yyj1204] = ThirdPartyResource{}
+			} else {
+				yyv1205 := &yyv1204[yyj1204]
+				yyv1205.CodecDecodeSelf(d)
+			}
+
+		}
+		if yyrt1204 {
+			for ; yyj1204 < yyl1204; yyj1204++ {
+				yyv1204 = append(yyv1204, ThirdPartyResource{})
+				if r.TryDecodeAsNil() {
+					yyv1204[yyj1204] = ThirdPartyResource{}
+				} else {
+					yyv1206 := &yyv1204[yyj1204]
+					yyv1206.CodecDecodeSelf(d)
+				}
+
+			}
+		}
+
+	} else {
+		for yyj1204 := 0; !r.CheckBreak(); yyj1204++ {
+			if yyj1204 >= len(yyv1204) {
+				yyv1204 = append(yyv1204, ThirdPartyResource{}) // var yyz1204 ThirdPartyResource
+				yyc1204 = true
+			}
+
+			if yyj1204 < len(yyv1204) {
+				if r.TryDecodeAsNil() {
+					yyv1204[yyj1204] = ThirdPartyResource{}
+				} else {
+					yyv1207 := &yyv1204[yyj1204]
+					yyv1207.CodecDecodeSelf(d)
+				}
+
+			} else {
+				z.DecSwallow()
+			}
+
+		}
+		yyh1204.End()
+	}
+	if yyc1204 {
+		*v = yyv1204
+	}
+
+}
+
+func (x codecSelfer1234) encSliceDeploymentCondition(v []DeploymentCondition, e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeArrayStart(len(v))
+	for _, yyv1208 := range v {
+		yy1209 := &yyv1208
+		yy1209.CodecEncodeSelf(e)
+	}
+	r.EncodeEnd()
+}
+
+func (x codecSelfer1234) decSliceDeploymentCondition(v *[]DeploymentCondition, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1210 := *v
+	yyh1210, yyl1210 := z.DecSliceHelperStart()
 
-	var yyrr1143, yyrl1143 int
-	var yyc1143, yyrt1143 bool
-	_, _, _ = yyc1143, yyrt1143, yyrl1143
-	yyrr1143 = yyl1143
+	var yyrr1210, yyrl1210 int
+	var yyc1210, yyrt1210 bool
+	_, _, _ = yyc1210, yyrt1210, yyrl1210
+	yyrr1210 = yyl1210
 
-	if yyv1143 == nil {
-		if yyrl1143, yyrt1143 = z.DecInferLen(yyl1143, z.DecBasicHandle().MaxInitLen, 232); yyrt1143 {
-			yyrr1143 = yyrl1143
+	if yyv1210 == nil {
+		if yyrl1210, yyrt1210 = z.DecInferLen(yyl1210, z.DecBasicHandle().MaxInitLen, 112); yyrt1210 {
+			yyrr1210 = yyrl1210
 		}
-		yyv1143 = make([]ThirdPartyResource, yyrl1143)
-		yyc1143 = true
+		yyv1210 = make([]DeploymentCondition, yyrl1210)
+		yyc1210 = true
 	}
 
-	if yyl1143 == 0 {
-		if len(yyv1143) != 0 {
-			yyv1143 = yyv1143[:0]
-			yyc1143 = true
+	if yyl1210 == 0 {
+		if len(yyv1210) != 0 {
+			yyv1210 = yyv1210[:0]
+			yyc1210 = true
 		}
-	} else if yyl1143 > 0 {
+	} else if yyl1210 > 0 {
 
-		if yyl1143 > cap(yyv1143) {
-			yyrl1143, yyrt1143 = z.DecInferLen(yyl1143, z.DecBasicHandle().MaxInitLen, 232)
-			yyv1143 = make([]ThirdPartyResource, yyrl1143)
-			yyc1143 = true
+		if yyl1210 > cap(yyv1210) {
+			yyrl1210, yyrt1210 = z.DecInferLen(yyl1210, z.DecBasicHandle().MaxInitLen, 112)
+			yyv1210 = make([]DeploymentCondition, yyrl1210)
+			yyc1210 = true
 
-			yyrr1143 = len(yyv1143)
-		} else if yyl1143 != len(yyv1143) {
-			yyv1143 = yyv1143[:yyl1143]
-			yyc1143 = true
+			yyrr1210 = len(yyv1210)
+		} else if yyl1210 != len(yyv1210) {
+			yyv1210 = yyv1210[:yyl1210]
+			yyc1210 = true
 		}
-		yyj1143 := 0
-		for ; yyj1143 < yyrr1143; yyj1143++ {
+		yyj1210 := 0
+		for ; yyj1210 < yyrr1210; yyj1210++ {
 			if r.TryDecodeAsNil() {
-				yyv1143[yyj1143] = ThirdPartyResource{}
+				yyv1210[yyj1210] = DeploymentCondition{}
 			} else {
-				yyv1144 := &yyv1143[yyj1143]
-				yyv1144.CodecDecodeSelf(d)
+				yyv1211 := &yyv1210[yyj1210]
+				yyv1211.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1143 {
-			for ; yyj1143 < yyl1143; yyj1143++ {
-				yyv1143 = append(yyv1143, ThirdPartyResource{})
+		if yyrt1210 {
+			for ; yyj1210 < yyl1210; yyj1210++ {
+				yyv1210 = append(yyv1210, DeploymentCondition{})
 				if r.TryDecodeAsNil() {
-					yyv1143[yyj1143] = ThirdPartyResource{}
+					yyv1210[yyj1210] = DeploymentCondition{}
 				} else {
-					yyv1145 := &yyv1143[yyj1143]
-					yyv1145.CodecDecodeSelf(d)
+					yyv1212 := &yyv1210[yyj1210]
+					yyv1212.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1143 := 0; !r.CheckBreak(); yyj1143++ {
-			if yyj1143 >= len(yyv1143) {
-				yyv1143 = append(yyv1143, ThirdPartyResource{}) // var yyz1143 ThirdPartyResource
-				yyc1143 = true
+		for yyj1210 := 0; !r.CheckBreak(); yyj1210++ {
+			if yyj1210 >= len(yyv1210) {
+				yyv1210 = append(yyv1210, DeploymentCondition{}) // var yyz1210 DeploymentCondition
+				yyc1210 = true
 			}
 
-			if yyj1143 < len(yyv1143) {
+			if yyj1210 < len(yyv1210) {
 				if r.TryDecodeAsNil() {
-					yyv1143[yyj1143] = ThirdPartyResource{}
+					yyv1210[yyj1210] = DeploymentCondition{}
 				} else {
-					yyv1146 := &yyv1143[yyj1143]
-					yyv1146.CodecDecodeSelf(d)
+					yyv1213 := &yyv1210[yyj1210]
+					yyv1213.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -12958,10 +13680,10 @@ func (x codecSelfer1234) decSliceThirdPartyResource(v *[]ThirdPartyResource, d *
 			}
 
 		}
-		yyh1143.End()
+		yyh1210.End()
 	}
-	if yyc1143 {
-		*v = yyv1143
+	if yyc1210 {
+		*v = yyv1210
 	}
 
 }
@@ -12971,9 +13693,9 @@ func (x codecSelfer1234) encSliceDeployment(v []Deployment, e *codec1978.Encoder
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1147 := range v {
-		yy1148 := &yyv1147
-		yy1148.CodecEncodeSelf(e)
+	for _, yyv1214 := range v {
+		yy1215 := &yyv1214
+		yy1215.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -12983,75 +13705,75 @@ func (x codecSelfer1234) decSliceDeployment(v *[]Deployment, d *codec1978.Decode
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1149 := *v
-	yyh1149, yyl1149 := z.DecSliceHelperStart()
+	yyv1216 := *v
+	yyh1216, yyl1216 := z.DecSliceHelperStart()
 
-	var yyrr1149, yyrl1149 int
-	var yyc1149, yyrt1149 bool
-	_, _, _ = yyc1149, yyrt1149, yyrl1149
-	yyrr1149 = yyl1149
+	var yyrr1216, yyrl1216 int
+	var yyc1216, yyrt1216 bool
+	_, _, _ = yyc1216, yyrt1216, yyrl1216
+	yyrr1216 = yyl1216
 
-	if yyv1149 == nil {
-		if yyrl1149, yyrt1149 = z.DecInferLen(yyl1149, z.DecBasicHandle().MaxInitLen, 608); yyrt1149 {
-			yyrr1149 = yyrl1149
+	if yyv1216 == nil {
+		if yyrl1216, yyrt1216 = z.DecInferLen(yyl1216, z.DecBasicHandle().MaxInitLen, 648); yyrt1216 {
+			yyrr1216 = yyrl1216
 		}
-		yyv1149 = make([]Deployment, yyrl1149)
-		yyc1149 = true
+		yyv1216 = make([]Deployment, yyrl1216)
+		yyc1216 = true
 	}
 
-	if yyl1149 == 0 {
-		if len(yyv1149) != 0 {
-			yyv1149 = yyv1149[:0]
-			yyc1149 = true
+	if yyl1216 == 0 {
+		if len(yyv1216) != 0 {
+			yyv1216 = yyv1216[:0]
+			yyc1216 = true
 		}
-	} else if yyl1149 > 0 {
+	} else if yyl1216 > 0 {
 
-		if yyl1149 > cap(yyv1149) {
-			yyrl1149, yyrt1149 = z.DecInferLen(yyl1149, z.DecBasicHandle().MaxInitLen, 608)
-			yyv1149 = make([]Deployment, yyrl1149)
-			yyc1149 = true
+		if yyl1216 > cap(yyv1216) {
+			yyrl1216, yyrt1216 = z.DecInferLen(yyl1216, z.DecBasicHandle().MaxInitLen, 648)
+			yyv1216 = make([]Deployment, yyrl1216)
+			yyc1216 = true
 
-			yyrr1149 = len(yyv1149)
-		} else if yyl1149 != len(yyv1149) {
-			yyv1149 = yyv1149[:yyl1149]
-			yyc1149 = true
+			yyrr1216 = len(yyv1216)
+		} else if yyl1216 != len(yyv1216) {
+			yyv1216 = yyv1216[:yyl1216]
+			yyc1216 = true
 		}
-		yyj1149 := 0
-		for ; yyj1149 < yyrr1149; yyj1149++ {
+		yyj1216 := 0
+		for ; yyj1216 < yyrr1216; yyj1216++ {
 			if r.TryDecodeAsNil() {
-				yyv1149[yyj1149] = Deployment{}
+				yyv1216[yyj1216] = Deployment{}
 			} else {
-				yyv1150 := &yyv1149[yyj1149]
-				yyv1150.CodecDecodeSelf(d)
+				yyv1217 := &yyv1216[yyj1216]
+				yyv1217.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1149 {
-			for ; yyj1149 < yyl1149; yyj1149++ {
-				yyv1149 = append(yyv1149, Deployment{})
+		if yyrt1216 {
+			for ; yyj1216 < yyl1216; yyj1216++ {
+				yyv1216 = append(yyv1216, Deployment{})
 				if r.TryDecodeAsNil() {
-					yyv1149[yyj1149] = Deployment{}
+					yyv1216[yyj1216] = Deployment{}
 				} else {
-					yyv1151 := &yyv1149[yyj1149]
-					yyv1151.CodecDecodeSelf(d)
+					yyv1218 := &yyv1216[yyj1216]
+					yyv1218.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1149 := 0; !r.CheckBreak(); yyj1149++ {
-			if yyj1149 >= len(yyv1149) {
-				yyv1149 = append(yyv1149, Deployment{}) // var yyz1149 Deployment
-				yyc1149 = true
+		for yyj1216 := 0; !r.CheckBreak(); yyj1216++ {
+			if yyj1216 >= len(yyv1216) {
+				yyv1216 = append(yyv1216, Deployment{}) // var yyz1216 Deployment
+				yyc1216 = true
 			}
 
-			if yyj1149 < len(yyv1149) {
+			if yyj1216 < len(yyv1216) {
 				if r.TryDecodeAsNil() {
-					yyv1149[yyj1149] = Deployment{}
+					yyv1216[yyj1216] = Deployment{}
 				} else {
-					yyv1152 := &yyv1149[yyj1149]
-					yyv1152.CodecDecodeSelf(d)
+					yyv1219 := &yyv1216[yyj1216]
+					yyv1219.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13059,10 +13781,10 @@ func (x codecSelfer1234) decSliceDeployment(v *[]Deployment, d *codec1978.Decode
 			}
 
 		}
-		yyh1149.End()
+		yyh1216.End()
 	}
-	if yyc1149 {
-		*v = yyv1149
+	if yyc1216 {
+		*v = yyv1216
 	}
 
 }
@@ -13072,9 +13794,9 @@ func (x codecSelfer1234) encSliceDaemonSet(v []DaemonSet, e *codec1978.Encoder)
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1153 := range v {
-		yy1154 := &yyv1153
-		yy1154.CodecEncodeSelf(e)
+	for _, yyv1220 := range v {
+		yy1221 := &yyv1220
+		yy1221.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13084,75 +13806,75 @@ func (x codecSelfer1234) decSliceDaemonSet(v *[]DaemonSet, d *codec1978.Decoder)
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1155 := *v
-	yyh1155, yyl1155 := z.DecSliceHelperStart()
+	yyv1222 := *v
+	yyh1222, yyl1222 := z.DecSliceHelperStart()
 
-	var yyrr1155, yyrl1155 int
-	var yyc1155, yyrt1155 bool
-	_, _, _ = yyc1155, yyrt1155, yyrl1155
-	yyrr1155 = yyl1155
+	var yyrr1222, yyrl1222 int
+	var yyc1222, yyrt1222 bool
+	_, _, _ = yyc1222, yyrt1222, yyrl1222
+	yyrr1222 = yyl1222
 
-	if yyv1155 == nil {
-		if yyrl1155, yyrt1155 = z.DecInferLen(yyl1155, z.DecBasicHandle().MaxInitLen, 232); yyrt1155 {
-			yyrr1155 = yyrl1155
+	if yyv1222 == nil {
+		if yyrl1222, yyrt1222 = z.DecInferLen(yyl1222, z.DecBasicHandle().MaxInitLen, 232); yyrt1222 {
+			yyrr1222 = yyrl1222
 		}
-		yyv1155 = make([]DaemonSet, yyrl1155)
-		yyc1155 = true
+		yyv1222 = make([]DaemonSet, yyrl1222)
+		yyc1222 = true
 	}
 
-	if yyl1155 == 0 {
-		if len(yyv1155) != 0 {
-			yyv1155 = yyv1155[:0]
-			yyc1155 = true
+	if yyl1222 == 0 {
+		if len(yyv1222) != 0 {
+			yyv1222 = yyv1222[:0]
+			yyc1222 = true
 		}
-	} else if yyl1155 > 0 {
+	} else if yyl1222 > 0 {
 
-		if yyl1155 > cap(yyv1155) {
-			yyrl1155, yyrt1155 = z.DecInferLen(yyl1155, z.DecBasicHandle().MaxInitLen, 232)
-			yyv1155 = make([]DaemonSet, yyrl1155)
-			yyc1155 = true
+		if yyl1222 > cap(yyv1222) {
+			yyrl1222, yyrt1222 = z.DecInferLen(yyl1222, z.DecBasicHandle().MaxInitLen, 232)
+			yyv1222 = make([]DaemonSet, yyrl1222)
+			yyc1222 = true
 
-			yyrr1155 = len(yyv1155)
-		} else if yyl1155 != len(yyv1155) {
-			yyv1155 = yyv1155[:yyl1155]
-			yyc1155 = true
+			yyrr1222 = len(yyv1222)
+		} else if yyl1222 != len(yyv1222) {
+			yyv1222 = yyv1222[:yyl1222]
+			yyc1222 = true
 		}
-		yyj1155 := 0
-		for ; yyj1155 < yyrr1155; yyj1155++ {
+		yyj1222 := 0
+		for ; yyj1222 < yyrr1222; yyj1222++ {
 			if r.TryDecodeAsNil() {
-				yyv1155[yyj1155] = DaemonSet{}
+				yyv1222[yyj1222] = DaemonSet{}
 			} else {
-				yyv1156 := &yyv1155[yyj1155]
-				yyv1156.CodecDecodeSelf(d)
+				yyv1223 := &yyv1222[yyj1222]
+				yyv1223.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1155 {
-			for ; yyj1155 < yyl1155; yyj1155++ {
-				yyv1155 = append(yyv1155, DaemonSet{})
+		if yyrt1222 {
+			for ; yyj1222 < yyl1222; yyj1222++ {
+				yyv1222 = append(yyv1222, DaemonSet{})
 				if r.TryDecodeAsNil() {
-					yyv1155[yyj1155] = DaemonSet{}
+					yyv1222[yyj1222] = DaemonSet{}
 				} else {
-					yyv1157 := &yyv1155[yyj1155]
-					yyv1157.CodecDecodeSelf(d)
+					yyv1224 := &yyv1222[yyj1222]
+					yyv1224.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1155 := 0; !r.CheckBreak(); yyj1155++ {
-			if yyj1155 >= len(yyv1155) {
-				yyv1155 = append(yyv1155, DaemonSet{}) // var yyz1155 DaemonSet
-				yyc1155 = true
+		for yyj1222 := 0; !r.CheckBreak(); yyj1222++ {
+			if yyj1222 >= len(yyv1222) {
+				yyv1222 = append(yyv1222, DaemonSet{}) // var yyz1222 DaemonSet
+				yyc1222 = true
 			}
 
-			if yyj1155 < len(yyv1155) {
+			if yyj1222 < len(yyv1222) {
 				if r.TryDecodeAsNil() {
-					yyv1155[yyj1155] = DaemonSet{}
+					yyv1222[yyj1222] = DaemonSet{}
 				} else {
-					yyv1158 := &yyv1155[yyj1155]
-					yyv1158.CodecDecodeSelf(d)
+					yyv1225 := &yyv1222[yyj1222]
+					yyv1225.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13160,10 +13882,10 @@ func (x codecSelfer1234) decSliceDaemonSet(v *[]DaemonSet, d *codec1978.Decoder)
 			}
 
 		}
-		yyh1155.End()
+		yyh1222.End()
 	}
-	if yyc1155 {
-		*v = yyv1155
+	if yyc1222 {
+		*v = yyv1222
 	}
 
 }
@@ -13173,9 +13895,9 @@ func (x codecSelfer1234) encSliceThirdPartyResourceData(v []ThirdPartyResourceDa
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1159 := range v {
-		yy1160 := &yyv1159
-		yy1160.CodecEncodeSelf(e)
+	for _, yyv1226 := range v {
+		yy1227 := &yyv1226
+		yy1227.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13185,75 +13907,75 @@ func (x codecSelfer1234) decSliceThirdPartyResourceData(v *[]ThirdPartyResourceD
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1161 := *v
-	yyh1161, yyl1161 := z.DecSliceHelperStart()
+	yyv1228 := *v
+	yyh1228, yyl1228 := z.DecSliceHelperStart()
 
-	var yyrr1161, yyrl1161 int
-	var yyc1161, yyrt1161 bool
-	_, _, _ = yyc1161, yyrt1161, yyrl1161
-	yyrr1161 = yyl1161
+	var yyrr1228, yyrl1228 int
+	var yyc1228, yyrt1228 bool
+	_, _, _ = yyc1228, yyrt1228, yyrl1228
+	yyrr1228 = yyl1228
 
-	if yyv1161 == nil {
-		if yyrl1161, yyrt1161 = z.DecInferLen(yyl1161, z.DecBasicHandle().MaxInitLen, 216); yyrt1161 {
-			yyrr1161 = yyrl1161
+	if yyv1228 == nil {
+		if yyrl1228, yyrt1228 = z.DecInferLen(yyl1228, z.DecBasicHandle().MaxInitLen, 216); yyrt1228 {
+			yyrr1228 = yyrl1228
 		}
-		yyv1161 = make([]ThirdPartyResourceData, yyrl1161)
-		yyc1161 = true
+		yyv1228 = make([]ThirdPartyResourceData, yyrl1228)
+		yyc1228 = true
 	}
 
-	if yyl1161 == 0 {
-		if len(yyv1161) != 0 {
-			yyv1161 = yyv1161[:0]
-			yyc1161 = true
+	if yyl1228 == 0 {
+		if len(yyv1228) != 0 {
+			yyv1228 = yyv1228[:0]
+			yyc1228 = true
 		}
-	} else if yyl1161 > 0 {
+	} else if yyl1228 > 0 {
 
-		if yyl1161 > cap(yyv1161) {
-			yyrl1161, yyrt1161 = z.DecInferLen(yyl1161, z.DecBasicHandle().MaxInitLen, 216)
-			yyv1161 = make([]ThirdPartyResourceData, yyrl1161)
-			yyc1161 = true
+		if yyl1228 > cap(yyv1228) {
+			yyrl1228, yyrt1228 = z.DecInferLen(yyl1228, z.DecBasicHandle().MaxInitLen, 216)
+			yyv1228 = make([]ThirdPartyResourceData, yyrl1228)
+			yyc1228 = true
 
-			yyrr1161 = len(yyv1161)
-		} else if yyl1161 != len(yyv1161) {
-			yyv1161 = yyv1161[:yyl1161]
-			yyc1161 = true
+			yyrr1228 = len(yyv1228)
+		} else if yyl1228 != len(yyv1228) {
+			yyv1228 = yyv1228[:yyl1228]
+			yyc1228 = true
 		}
-		yyj1161 := 0
-		for ; yyj1161 < yyrr1161; yyj1161++ {
+		yyj1228 := 0
+		for ; yyj1228 < yyrr1228; yyj1228++ {
 			if r.TryDecodeAsNil() {
-				yyv1161[yyj1161] = ThirdPartyResourceData{}
+				yyv1228[yyj1228] = ThirdPartyResourceData{}
 			} else {
-				yyv1162 := &yyv1161[yyj1161]
-				yyv1162.CodecDecodeSelf(d)
+				yyv1229 := &yyv1228[yyj1228]
+				yyv1229.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1161 {
-			for ; yyj1161 < yyl1161; yyj1161++ {
-				yyv1161 = append(yyv1161, ThirdPartyResourceData{})
+		if yyrt1228 {
+			for ; yyj1228 < yyl1228; yyj1228++ {
+				yyv1228 = append(yyv1228, ThirdPartyResourceData{})
 				if r.TryDecodeAsNil() {
-					yyv1161[yyj1161] = ThirdPartyResourceData{}
+					yyv1228[yyj1228] = ThirdPartyResourceData{}
 				} else {
-					yyv1163 := &yyv1161[yyj1161]
-					yyv1163.CodecDecodeSelf(d)
+					yyv1230 := &yyv1228[yyj1228]
+					yyv1230.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1161 := 0; !r.CheckBreak(); yyj1161++ {
-			if yyj1161 >= len(yyv1161) {
-				yyv1161 = append(yyv1161, ThirdPartyResourceData{}) // var yyz1161 ThirdPartyResourceData
-				yyc1161 = true
+		for yyj1228 := 0; !r.CheckBreak(); yyj1228++ {
+			if yyj1228 >= len(yyv1228) {
+				yyv1228 = append(yyv1228, ThirdPartyResourceData{}) // var yyz1228 ThirdPartyResourceData
+				yyc1228 = true
 			}
 
-			if yyj1161 < len(yyv1161) {
+			if yyj1228 < len(yyv1228) {
 				if r.TryDecodeAsNil() {
-					yyv1161[yyj1161] = ThirdPartyResourceData{}
+					yyv1228[yyj1228] = ThirdPartyResourceData{}
 				} else {
-					yyv1164 := &yyv1161[yyj1161]
-					yyv1164.CodecDecodeSelf(d)
+					yyv1231 := &yyv1228[yyj1228]
+					yyv1231.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13261,10 +13983,10 @@ func (x codecSelfer1234) decSliceThirdPartyResourceData(v *[]ThirdPartyResourceD
 			}
 
 		}
-		yyh1161.End()
+		yyh1228.End()
 	}
-	if yyc1161 {
-		*v = yyv1161
+	if yyc1228 {
+		*v = yyv1228
 	}
 
 }
@@ -13274,9 +13996,9 @@ func (x codecSelfer1234) encSliceJob(v []Job, e *codec1978.Encoder) {
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1165 := range v {
-		yy1166 := &yyv1165
-		yy1166.CodecEncodeSelf(e)
+	for _, yyv1232 := range v {
+		yy1233 := &yyv1232
+		yy1233.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13286,75 +14008,75 @@ func (x codecSelfer1234) decSliceJob(v *[]Job, d *codec1978.Decoder) {
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1167 := *v
-	yyh1167, yyl1167 := z.DecSliceHelperStart()
+	yyv1234 := *v
+	yyh1234, yyl1234 := z.DecSliceHelperStart()
 
-	var yyrr1167, yyrl1167 int
-	var yyc1167, yyrt1167 bool
-	_, _, _ = yyc1167, yyrt1167, yyrl1167
-	yyrr1167 = yyl1167
+	var yyrr1234, yyrl1234 int
+	var yyc1234, yyrt1234 bool
+	_, _, _ = yyc1234, yyrt1234, yyrl1234
+	yyrr1234 = yyl1234
 
-	if yyv1167 == nil {
-		if yyrl1167, yyrt1167 = z.DecInferLen(yyl1167, z.DecBasicHandle().MaxInitLen, 632); yyrt1167 {
-			yyrr1167 = yyrl1167
+	if yyv1234 == nil {
+		if yyrl1234, yyrt1234 = z.DecInferLen(yyl1234, z.DecBasicHandle().MaxInitLen, 632); yyrt1234 {
+			yyrr1234 = yyrl1234
 		}
-		yyv1167 = make([]Job, yyrl1167)
-		yyc1167 = true
+		yyv1234 = make([]Job, yyrl1234)
+		yyc1234 = true
 	}
 
-	if yyl1167 == 0 {
-		if len(yyv1167) != 0 {
-			yyv1167 = yyv1167[:0]
-			yyc1167 = true
+	if yyl1234 == 0 {
+		if len(yyv1234) != 0 {
+			yyv1234 = yyv1234[:0]
+			yyc1234 = true
 		}
-	} else if yyl1167 > 0 {
+	} else if yyl1234 > 0 {
 
-		if yyl1167 > cap(yyv1167) {
-			yyrl1167, yyrt1167 = z.DecInferLen(yyl1167, z.DecBasicHandle().MaxInitLen, 632)
-			yyv1167 = make([]Job, yyrl1167)
-			yyc1167 = true
+		if yyl1234 > cap(yyv1234) {
+			yyrl1234, yyrt1234 = z.DecInferLen(yyl1234, z.DecBasicHandle().MaxInitLen, 632)
+			yyv1234 = make([]Job, yyrl1234)
+			yyc1234 = true
 
-			yyrr1167 = len(yyv1167)
-		} else if yyl1167 != len(yyv1167) {
-			yyv1167 = yyv1167[:yyl1167]
-			yyc1167 = true
+			yyrr1234 = len(yyv1234)
+		} else if yyl1234 != len(yyv1234) {
+			yyv1234 = yyv1234[:yyl1234]
+			yyc1234 = true
 		}
-		yyj1167 := 0
-		for ; yyj1167 < yyrr1167; yyj1167++ {
+		yyj1234 := 0
+		for ; yyj1234 < yyrr1234; yyj1234++ {
 			if r.TryDecodeAsNil() {
-				yyv1167[yyj1167] = Job{}
+				yyv1234[yyj1234] = Job{}
 			} else {
-				yyv1168 := &yyv1167[yyj1167]
-				yyv1168.CodecDecodeSelf(d)
+				yyv1235 := &yyv1234[yyj1234]
+				yyv1235.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1167 {
-			for ; yyj1167 < yyl1167; yyj1167++ {
-				yyv1167 = append(yyv1167, Job{})
+		if yyrt1234 {
+			for ; yyj1234 < yyl1234; yyj1234++ {
+				yyv1234 = append(yyv1234, Job{})
 				if r.TryDecodeAsNil() {
-					yyv1167[yyj1167] = Job{}
+					yyv1234[yyj1234] = Job{}
 				} else {
-					yyv1169 := &yyv1167[yyj1167]
-					yyv1169.CodecDecodeSelf(d)
+					yyv1236 := &yyv1234[yyj1234]
+					yyv1236.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1167 := 0; !r.CheckBreak(); yyj1167++ {
-			if yyj1167 >= len(yyv1167) {
-				yyv1167 = append(yyv1167, Job{}) // var yyz1167 Job
-				yyc1167 = true
+		for yyj1234 := 0; !r.CheckBreak(); yyj1234++ {
+			if yyj1234 >= len(yyv1234) {
+				yyv1234 = append(yyv1234, Job{}) // var yyz1234 Job
+				yyc1234 = true
 			}
 
-			if yyj1167 < len(yyv1167) {
+			if yyj1234 < len(yyv1234) {
 				if r.TryDecodeAsNil() {
-					yyv1167[yyj1167] = Job{}
+					yyv1234[yyj1234] = Job{}
 				} else {
-					yyv1170 := &yyv1167[yyj1167]
-					yyv1170.CodecDecodeSelf(d)
+					yyv1237 := &yyv1234[yyj1234]
+					yyv1237.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13362,10 +14084,10 @@ func (x codecSelfer1234) decSliceJob(v *[]Job, d *codec1978.Decoder) {
 			}
 
 		}
-		yyh1167.End()
+		yyh1234.End()
 	}
-	if yyc1167 {
-		*v = yyv1167
+	if yyc1234 {
+		*v = yyv1234
 	}
 
 }
@@ -13375,9 +14097,9 @@ func (x codecSelfer1234) encSliceJobCondition(v []JobCondition, e *codec1978.Enc
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1171 := range v {
-		yy1172 := &yyv1171
-		yy1172.CodecEncodeSelf(e)
+	for _, yyv1238 := range v {
+		yy1239 := &yyv1238
+		yy1239.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13387,75 +14109,75 @@ func (x codecSelfer1234) decSliceJobCondition(v *[]JobCondition, d *codec1978.De
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1173 := *v
-	yyh1173, yyl1173 := z.DecSliceHelperStart()
+	yyv1240 := *v
+	yyh1240, yyl1240 := z.DecSliceHelperStart()
 
-	var yyrr1173, yyrl1173 int
-	var yyc1173, yyrt1173 bool
-	_, _, _ = yyc1173, yyrt1173, yyrl1173
-	yyrr1173 = yyl1173
+	var yyrr1240, yyrl1240 int
+	var yyc1240, yyrt1240 bool
+	_, _, _ = yyc1240, yyrt1240, yyrl1240
+	yyrr1240 = yyl1240
 
-	if yyv1173 == nil {
-		if yyrl1173, yyrt1173 = z.DecInferLen(yyl1173, z.DecBasicHandle().MaxInitLen, 112); yyrt1173 {
-			yyrr1173 = yyrl1173
+	if yyv1240 == nil {
+		if yyrl1240, yyrt1240 = z.DecInferLen(yyl1240, z.DecBasicHandle().MaxInitLen, 112); yyrt1240 {
+			yyrr1240 = yyrl1240
 		}
-		yyv1173 = make([]JobCondition, yyrl1173)
-		yyc1173 = true
+		yyv1240 = make([]JobCondition, yyrl1240)
+		yyc1240 = true
 	}
 
-	if yyl1173 == 0 {
-		if len(yyv1173) != 0 {
-			yyv1173 = yyv1173[:0]
-			yyc1173 = true
+	if yyl1240 == 0 {
+		if len(yyv1240) != 0 {
+			yyv1240 = yyv1240[:0]
+			yyc1240 = true
 		}
-	} else if yyl1173 > 0 {
+	} else if yyl1240 > 0 {
 
-		if yyl1173 > cap(yyv1173) {
-			yyrl1173, yyrt1173 = z.DecInferLen(yyl1173, z.DecBasicHandle().MaxInitLen, 112)
-			yyv1173 = make([]JobCondition, yyrl1173)
-			yyc1173 = true
+		if yyl1240 > cap(yyv1240) {
+			yyrl1240, yyrt1240 = z.DecInferLen(yyl1240, z.DecBasicHandle().MaxInitLen, 112)
+			yyv1240 = make([]JobCondition, yyrl1240)
+			yyc1240 = true
 
-			yyrr1173 = len(yyv1173)
-		} else if yyl1173 != len(yyv1173) {
-			yyv1173 = yyv1173[:yyl1173]
-			yyc1173 = true
+			yyrr1240 = len(yyv1240)
+		} else if yyl1240 != len(yyv1240) {
+			yyv1240 = yyv1240[:yyl1240]
+			yyc1240 = true
 		}
-		yyj1173 := 0
-		for ; yyj1173 < yyrr1173; yyj1173++ {
+		yyj1240 := 0
+		for ; yyj1240 < yyrr1240; yyj1240++ {
 			if r.TryDecodeAsNil() {
-				yyv1173[yyj1173] = JobCondition{}
+				yyv1240[yyj1240] = JobCondition{}
 			} else {
-				yyv1174 := &yyv1173[yyj1173]
-				yyv1174.CodecDecodeSelf(d)
+				yyv1241 := &yyv1240[yyj1240]
+				yyv1241.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1173 {
-			for ; yyj1173 < yyl1173; yyj1173++ {
-				yyv1173 = append(yyv1173, JobCondition{})
+		if yyrt1240 {
+			for ; yyj1240 < yyl1240; yyj1240++ {
+				yyv1240 = append(yyv1240, JobCondition{})
 				if r.TryDecodeAsNil() {
-					yyv1173[yyj1173] = JobCondition{}
+					yyv1240[yyj1240] = JobCondition{}
 				} else {
-					yyv1175 := &yyv1173[yyj1173]
-					yyv1175.CodecDecodeSelf(d)
+					yyv1242 := &yyv1240[yyj1240]
+					yyv1242.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1173 := 0; !r.CheckBreak(); yyj1173++ {
-			if yyj1173 >= len(yyv1173) {
-				yyv1173 = append(yyv1173, JobCondition{}) // var yyz1173 JobCondition
-				yyc1173 = true
+		for yyj1240 := 0; !r.CheckBreak(); yyj1240++ {
+			if yyj1240 >= len(yyv1240) {
+				yyv1240 = append(yyv1240, JobCondition{}) // var yyz1240 JobCondition
+				yyc1240 = true
 			}
 
-			if yyj1173 < len(yyv1173) {
+			if yyj1240 < len(yyv1240) {
 				if r.TryDecodeAsNil() {
-					yyv1173[yyj1173] = JobCondition{}
+					yyv1240[yyj1240] = JobCondition{}
 				} else {
-					yyv1176 := &yyv1173[yyj1173]
-					yyv1176.CodecDecodeSelf(d)
+					yyv1243 := &yyv1240[yyj1240]
+					yyv1243.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13463,10 +14185,10 @@ func (x codecSelfer1234) decSliceJobCondition(v *[]JobCondition, d *codec1978.De
 			}
 
 		}
-		yyh1173.End()
+		yyh1240.End()
 	}
-	if yyc1173 {
-		*v = yyv1173
+	if yyc1240 {
+		*v = yyv1240
 	}
 
 }
@@ -13476,9 +14198,9 @@ func (x codecSelfer1234) encSliceIngress(v []Ingress, e *codec1978.Encoder) {
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1177 := range v {
-		yy1178 := &yyv1177
-		yy1178.CodecEncodeSelf(e)
+	for _, yyv1244 := range v {
+		yy1245 := &yyv1244
+		yy1245.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13488,75 +14210,75 @@ func (x codecSelfer1234) decSliceIngress(v *[]Ingress, d *codec1978.Decoder) {
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1179 := *v
-	yyh1179, yyl1179 := z.DecSliceHelperStart()
+	yyv1246 := *v
+	yyh1246, yyl1246 := z.DecSliceHelperStart()
 
-	var yyrr1179, yyrl1179 int
-	var yyc1179, yyrt1179 bool
-	_, _, _ = yyc1179, yyrt1179, yyrl1179
-	yyrr1179 = yyl1179
+	var yyrr1246, yyrl1246 int
+	var yyc1246, yyrt1246 bool
+	_, _, _ = yyc1246, yyrt1246, yyrl1246
+	yyrr1246 = yyl1246
 
-	if yyv1179 == nil {
-		if yyrl1179, yyrt1179 = z.DecInferLen(yyl1179, z.DecBasicHandle().MaxInitLen, 248); yyrt1179 {
-			yyrr1179 = yyrl1179
+	if yyv1246 == nil {
+		if yyrl1246, yyrt1246 = z.DecInferLen(yyl1246, z.DecBasicHandle().MaxInitLen, 248); yyrt1246 {
+			yyrr1246 = yyrl1246
 		}
-		yyv1179 = make([]Ingress, yyrl1179)
-		yyc1179 = true
+		yyv1246 = make([]Ingress, yyrl1246)
+		yyc1246 = true
 	}
 
-	if yyl1179 == 0 {
-		if len(yyv1179) != 0 {
-			yyv1179 = yyv1179[:0]
-			yyc1179 = true
+	if yyl1246 == 0 {
+		if len(yyv1246) != 0 {
+			yyv1246 = yyv1246[:0]
+			yyc1246 = true
 		}
-	} else if yyl1179 > 0 {
+	} else if yyl1246 > 0 {
 
-		if yyl1179 > cap(yyv1179) {
-			yyrl1179, yyrt1179 = z.DecInferLen(yyl1179, z.DecBasicHandle().MaxInitLen, 248)
-			yyv1179 = make([]Ingress, yyrl1179)
-			yyc1179 = true
+		if yyl1246 > cap(yyv1246) {
+			yyrl1246, yyrt1246 = z.DecInferLen(yyl1246, z.DecBasicHandle().MaxInitLen, 248)
+			yyv1246 = make([]Ingress, yyrl1246)
+			yyc1246 = true
 
-			yyrr1179 = len(yyv1179)
-		} else if yyl1179 != len(yyv1179) {
-			yyv1179 = yyv1179[:yyl1179]
-			yyc1179 = true
+			yyrr1246 = len(yyv1246)
+		} else if yyl1246 != len(yyv1246) {
+			yyv1246 = yyv1246[:yyl1246]
+			yyc1246 = true
 		}
-		yyj1179 := 0
-		for ; yyj1179 < yyrr1179; yyj1179++ {
+		yyj1246 := 0
+		for ; yyj1246 < yyrr1246; yyj1246++ {
 			if r.TryDecodeAsNil() {
-				yyv1179[yyj1179] = Ingress{}
+				yyv1246[yyj1246] = Ingress{}
 			} else {
-				yyv1180 := &yyv1179[yyj1179]
-				yyv1180.CodecDecodeSelf(d)
+				yyv1247 := &yyv1246[yyj1246]
+				yyv1247.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1179 {
-			for ; yyj1179 < yyl1179; yyj1179++ {
-				yyv1179 = append(yyv1179, Ingress{})
+		if yyrt1246 {
+			for ; yyj1246 < yyl1246; yyj1246++ {
+				yyv1246 = append(yyv1246, Ingress{})
 				if r.TryDecodeAsNil() {
-					yyv1179[yyj1179] = Ingress{}
+					yyv1246[yyj1246] = Ingress{}
 				} else {
-					yyv1181 := &yyv1179[yyj1179]
-					yyv1181.CodecDecodeSelf(d)
+					yyv1248 := &yyv1246[yyj1246]
+					yyv1248.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1179 := 0; !r.CheckBreak(); yyj1179++ {
-			if yyj1179 >= len(yyv1179) {
-				yyv1179 = append(yyv1179, Ingress{}) // var yyz1179 Ingress
-				yyc1179 = true
+		for yyj1246 := 0; !r.CheckBreak(); yyj1246++ {
+			if yyj1246 >= len(yyv1246) {
+				yyv1246 = append(yyv1246, Ingress{}) // var yyz1246 Ingress
+				yyc1246 = true
 			}
 
-			if yyj1179 < len(yyv1179) {
+			if yyj1246 < len(yyv1246) {
 				if r.TryDecodeAsNil() {
-					yyv1179[yyj1179] = Ingress{}
+					yyv1246[yyj1246] = Ingress{}
 				} else {
-					yyv1182 := &yyv1179[yyj1179]
-					yyv1182.CodecDecodeSelf(d)
+					yyv1249 := &yyv1246[yyj1246]
+					yyv1249.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13564,10 +14286,10 @@ func (x codecSelfer1234) decSliceIngress(v *[]Ingress, d *codec1978.Decoder) {
 			}
 
 		}
-		yyh1179.End()
+		yyh1246.End()
 	}
-	if yyc1179 {
-		*v = yyv1179
+	if yyc1246 {
+		*v = yyv1246
 	}
 
 }
@@ -13577,9 +14299,9 @@ func (x codecSelfer1234) encSliceIngressRule(v []IngressRule, e *codec1978.Encod
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1183 := range v {
-		yy1184 := &yyv1183
-		yy1184.CodecEncodeSelf(e)
+	for _, yyv1250 := range v {
+		yy1251 := &yyv1250
+		yy1251.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13589,75 +14311,75 @@ func (x codecSelfer1234) decSliceIngressRule(v *[]IngressRule, d *codec1978.Deco
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1185 := *v
-	yyh1185, yyl1185 := z.DecSliceHelperStart()
+	yyv1252 := *v
+	yyh1252, yyl1252 := z.DecSliceHelperStart()
 
-	var yyrr1185, yyrl1185 int
-	var yyc1185, yyrt1185 bool
-	_, _, _ = yyc1185, yyrt1185, yyrl1185
-	yyrr1185 = yyl1185
+	var yyrr1252, yyrl1252 int
+	var yyc1252, yyrt1252 bool
+	_, _, _ = yyc1252, yyrt1252, yyrl1252
+	yyrr1252 = yyl1252
 
-	if yyv1185 == nil {
-		if yyrl1185, yyrt1185 = z.DecInferLen(yyl1185, z.DecBasicHandle().MaxInitLen, 24); yyrt1185 {
-			yyrr1185 = yyrl1185
+	if yyv1252 == nil {
+		if yyrl1252, yyrt1252 = z.DecInferLen(yyl1252, z.DecBasicHandle().MaxInitLen, 24); yyrt1252 {
+			yyrr1252 = yyrl1252
 		}
-		yyv1185 = make([]IngressRule, yyrl1185)
-		yyc1185 = true
+		yyv1252 = make([]IngressRule, yyrl1252)
+		yyc1252 = true
 	}
 
-	if yyl1185 == 0 {
-		if len(yyv1185) != 0 {
-			yyv1185 = yyv1185[:0]
-			yyc1185 = true
+	if yyl1252 == 0 {
+		if len(yyv1252) != 0 {
+			yyv1252 = yyv1252[:0]
+			yyc1252 = true
 		}
-	} else if yyl1185 > 0 {
+	} else if yyl1252 > 0 {
 
-		if yyl1185 > cap(yyv1185) {
-			yyrl1185, yyrt1185 = z.DecInferLen(yyl1185, z.DecBasicHandle().MaxInitLen, 24)
-			yyv1185 = make([]IngressRule, yyrl1185)
-			yyc1185 = true
+		if yyl1252 > cap(yyv1252) {
+			yyrl1252, yyrt1252 = z.DecInferLen(yyl1252, z.DecBasicHandle().MaxInitLen, 24)
+			yyv1252 = make([]IngressRule, yyrl1252)
+			yyc1252 = true
 
-			yyrr1185 = len(yyv1185)
-		} else if yyl1185 != len(yyv1185) {
-			yyv1185 = yyv1185[:yyl1185]
-			yyc1185 = true
+			yyrr1252 = len(yyv1252)
+		} else if yyl1252 != len(yyv1252) {
+			yyv1252 = yyv1252[:yyl1252]
+			yyc1252 = true
 		}
-		yyj1185 := 0
-		for ; yyj1185 < yyrr1185; yyj1185++ {
+		yyj1252 := 0
+		for ; yyj1252 < yyrr1252; yyj1252++ {
 			if r.TryDecodeAsNil() {
-				yyv1185[yyj1185] = IngressRule{}
+				yyv1252[yyj1252] = IngressRule{}
 			} else {
-				yyv1186 := &yyv1185[yyj1185]
-				yyv1186.CodecDecodeSelf(d)
+				yyv1253 := &yyv1252[yyj1252]
+				yyv1253.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1185 {
-			for ; yyj1185 < yyl1185; yyj1185++ {
-				yyv1185 = append(yyv1185, IngressRule{})
+		if yyrt1252 {
+			for ; yyj1252 < yyl1252; yyj1252++ {
+				yyv1252 = append(yyv1252, IngressRule{})
 				if r.TryDecodeAsNil() {
-					yyv1185[yyj1185] = IngressRule{}
+					yyv1252[yyj1252] = IngressRule{}
 				} else {
-					yyv1187 := &yyv1185[yyj1185]
-					yyv1187.CodecDecodeSelf(d)
+					yyv1254 := &yyv1252[yyj1252]
+					yyv1254.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1185 := 0; !r.CheckBreak(); yyj1185++ {
-			if yyj1185 >= len(yyv1185) {
-				yyv1185 = append(yyv1185, IngressRule{}) // var yyz1185 IngressRule
-				yyc1185 = true
+		for yyj1252 := 0; !r.CheckBreak(); yyj1252++ {
+			if yyj1252 >= len(yyv1252) {
+				yyv1252 = append(yyv1252, IngressRule{}) // var yyz1252 IngressRule
+				yyc1252 = true
 			}
 
-			if yyj1185 < len(yyv1185) {
+			if yyj1252 < len(yyv1252) {
 				if r.TryDecodeAsNil() {
-					yyv1185[yyj1185] = IngressRule{}
+					yyv1252[yyj1252] = IngressRule{}
 				} else {
-					yyv1188 := &yyv1185[yyj1185]
-					yyv1188.CodecDecodeSelf(d)
+					yyv1255 := &yyv1252[yyj1252]
+					yyv1255.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13665,10 +14387,10 @@ func (x codecSelfer1234) decSliceIngressRule(v *[]IngressRule, d *codec1978.Deco
 			}
 
 		}
-		yyh1185.End()
+		yyh1252.End()
 	}
-	if yyc1185 {
-		*v = yyv1185
+	if yyc1252 {
+		*v = yyv1252
 	}
 
 }
@@ -13678,9 +14400,9 @@ func (x codecSelfer1234) encSliceHTTPIngressPath(v []HTTPIngressPath, e *codec19
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1189 := range v {
-		yy1190 := &yyv1189
-		yy1190.CodecEncodeSelf(e)
+	for _, yyv1256 := range v {
+		yy1257 := &yyv1256
+		yy1257.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13690,75 +14412,75 @@ func (x codecSelfer1234) decSliceHTTPIngressPath(v *[]HTTPIngressPath, d *codec1
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1191 := *v
-	yyh1191, yyl1191 := z.DecSliceHelperStart()
+	yyv1258 := *v
+	yyh1258, yyl1258 := z.DecSliceHelperStart()
 
-	var yyrr1191, yyrl1191 int
-	var yyc1191, yyrt1191 bool
-	_, _, _ = yyc1191, yyrt1191, yyrl1191
-	yyrr1191 = yyl1191
+	var yyrr1258, yyrl1258 int
+	var yyc1258, yyrt1258 bool
+	_, _, _ = yyc1258, yyrt1258, yyrl1258
+	yyrr1258 = yyl1258
 
-	if yyv1191 == nil {
-		if yyrl1191, yyrt1191 = z.DecInferLen(yyl1191, z.DecBasicHandle().MaxInitLen, 64); yyrt1191 {
-			yyrr1191 = yyrl1191
+	if yyv1258 == nil {
+		if yyrl1258, yyrt1258 = z.DecInferLen(yyl1258, z.DecBasicHandle().MaxInitLen, 64); yyrt1258 {
+			yyrr1258 = yyrl1258
 		}
-		yyv1191 = make([]HTTPIngressPath, yyrl1191)
-		yyc1191 = true
+		yyv1258 = make([]HTTPIngressPath, yyrl1258)
+		yyc1258 = true
 	}
 
-	if yyl1191 == 0 {
-		if len(yyv1191) != 0 {
-			yyv1191 = yyv1191[:0]
-			yyc1191 = true
+	if yyl1258 == 0 {
+		if len(yyv1258) != 0 {
+			yyv1258 = yyv1258[:0]
+			yyc1258 = true
 		}
-	} else if yyl1191 > 0 {
+	} else if yyl1258 > 0 {
 
-		if yyl1191 > cap(yyv1191) {
-			yyrl1191, yyrt1191 = z.DecInferLen(yyl1191, z.DecBasicHandle().MaxInitLen, 64)
-			yyv1191 = make([]HTTPIngressPath, yyrl1191)
-			yyc1191 = true
+		if yyl1258 > cap(yyv1258) {
+			yyrl1258, yyrt1258 = z.DecInferLen(yyl1258, z.DecBasicHandle().MaxInitLen, 64)
+			yyv1258 = make([]HTTPIngressPath, yyrl1258)
+			yyc1258 = true
 
-			yyrr1191 = len(yyv1191)
-		} else if yyl1191 != len(yyv1191) {
-			yyv1191 = yyv1191[:yyl1191]
-			yyc1191 = true
+			yyrr1258 = len(yyv1258)
+		} else if yyl1258 != len(yyv1258) {
+			yyv1258 = yyv1258[:yyl1258]
+			yyc1258 = true
 		}
-		yyj1191 := 0
-		for ; yyj1191 < yyrr1191; yyj1191++ {
+		yyj1258 := 0
+		for ; yyj1258 < yyrr1258; yyj1258++ {
 			if r.TryDecodeAsNil() {
-				yyv1191[yyj1191] = HTTPIngressPath{}
+				yyv1258[yyj1258] = HTTPIngressPath{}
 			} else {
-				yyv1192 := &yyv1191[yyj1191]
-				yyv1192.CodecDecodeSelf(d)
+				yyv1259 := &yyv1258[yyj1258]
+				yyv1259.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1191 {
-			for ; yyj1191 < yyl1191; yyj1191++ {
-				yyv1191 = append(yyv1191, HTTPIngressPath{})
+		if yyrt1258 {
+			for ; yyj1258 < yyl1258; yyj1258++ {
+				yyv1258 = append(yyv1258, HTTPIngressPath{})
 				if r.TryDecodeAsNil() {
-					yyv1191[yyj1191] = HTTPIngressPath{}
+					yyv1258[yyj1258] = HTTPIngressPath{}
 				} else {
-					yyv1193 := &yyv1191[yyj1191]
-					yyv1193.CodecDecodeSelf(d)
+					yyv1260 := &yyv1258[yyj1258]
+					yyv1260.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1191 := 0; !r.CheckBreak(); yyj1191++ {
-			if yyj1191 >= len(yyv1191) {
-				yyv1191 = append(yyv1191, HTTPIngressPath{}) // var yyz1191 HTTPIngressPath
-				yyc1191 = true
+		for yyj1258 := 0; !r.CheckBreak(); yyj1258++ {
+			if yyj1258 >= len(yyv1258) {
+				yyv1258 = append(yyv1258, HTTPIngressPath{}) // var yyz1258 HTTPIngressPath
+				yyc1258 = true
 			}
 
-			if yyj1191 < len(yyv1191) {
+			if yyj1258 < len(yyv1258) {
 				if r.TryDecodeAsNil() {
-					yyv1191[yyj1191] = HTTPIngressPath{}
+					yyv1258[yyj1258] = HTTPIngressPath{}
 				} else {
-					yyv1194 := &yyv1191[yyj1191]
-					yyv1194.CodecDecodeSelf(d)
+					yyv1261 := &yyv1258[yyj1258]
+					yyv1261.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13766,10 +14488,10 @@ func (x codecSelfer1234) decSliceHTTPIngressPath(v *[]HTTPIngressPath, d *codec1
 			}
 
 		}
-		yyh1191.End()
+		yyh1258.End()
 	}
-	if yyc1191 {
-		*v = yyv1191
+	if yyc1258 {
+		*v = yyv1258
 	}
 
 }
@@ -13779,9 +14501,9 @@ func (x codecSelfer1234) encSliceNodeUtilization(v []NodeUtilization, e *codec19
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1195 := range v {
-		yy1196 := &yyv1195
-		yy1196.CodecEncodeSelf(e)
+	for _, yyv1262 := range v {
+		yy1263 := &yyv1262
+		yy1263.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13791,75 +14513,75 @@ func (x codecSelfer1234) decSliceNodeUtilization(v *[]NodeUtilization, d *codec1
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1197 := *v
-	yyh1197, yyl1197 := z.DecSliceHelperStart()
+	yyv1264 := *v
+	yyh1264, yyl1264 := z.DecSliceHelperStart()
 
-	var yyrr1197, yyrl1197 int
-	var yyc1197, yyrt1197 bool
-	_, _, _ = yyc1197, yyrt1197, yyrl1197
-	yyrr1197 = yyl1197
+	var yyrr1264, yyrl1264 int
+	var yyc1264, yyrt1264 bool
+	_, _, _ = yyc1264, yyrt1264, yyrl1264
+	yyrr1264 = yyl1264
 
-	if yyv1197 == nil {
-		if yyrl1197, yyrt1197 = z.DecInferLen(yyl1197, z.DecBasicHandle().MaxInitLen, 24); yyrt1197 {
-			yyrr1197 = yyrl1197
+	if yyv1264 == nil {
+		if yyrl1264, yyrt1264 = z.DecInferLen(yyl1264, z.DecBasicHandle().MaxInitLen, 24); yyrt1264 {
+			yyrr1264 = yyrl1264
 		}
-		yyv1197 = make([]NodeUtilization, yyrl1197)
-		yyc1197 = true
+		yyv1264 = make([]NodeUtilization, yyrl1264)
+		yyc1264 = true
 	}
 
-	if yyl1197 == 0 {
-		if len(yyv1197) != 0 {
-			yyv1197 = yyv1197[:0]
-			yyc1197 = true
+	if yyl1264 == 0 {
+		if len(yyv1264) != 0 {
+			yyv1264 = yyv1264[:0]
+			yyc1264 = true
 		}
-	} else if yyl1197 > 0 {
+	} else if yyl1264 > 0 {
 
-		if yyl1197 > cap(yyv1197) {
-			yyrl1197, yyrt1197 = z.DecInferLen(yyl1197, z.DecBasicHandle().MaxInitLen, 24)
-			yyv1197 = make([]NodeUtilization, yyrl1197)
-			yyc1197 = true
+		if yyl1264 > cap(yyv1264) {
+			yyrl1264, yyrt1264 = z.DecInferLen(yyl1264, z.DecBasicHandle().MaxInitLen, 24)
+			yyv1264 = make([]NodeUtilization, yyrl1264)
+			yyc1264 = true
 
-			yyrr1197 = len(yyv1197)
-		} else if yyl1197 != len(yyv1197) {
-			yyv1197 = yyv1197[:yyl1197]
-			yyc1197 = true
+			yyrr1264 = len(yyv1264)
+		} else if yyl1264 != len(yyv1264) {
+			yyv1264 = yyv1264[:yyl1264]
+			yyc1264 = true
 		}
-		yyj1197 := 0
-		for ; yyj1197 < yyrr1197; yyj1197++ {
+		yyj1264 := 0
+		for ; yyj1264 < yyrr1264; yyj1264++ {
 			if r.TryDecodeAsNil() {
-				yyv1197[yyj1197] = NodeUtilization{}
+				yyv1264[yyj1264] = NodeUtilization{}
 			} else {
-				yyv1198 := &yyv1197[yyj1197]
-				yyv1198.CodecDecodeSelf(d)
+				yyv1265 := &yyv1264[yyj1264]
+				yyv1265.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1197 {
-			for ; yyj1197 < yyl1197; yyj1197++ {
-				yyv1197 = append(yyv1197, NodeUtilization{})
+		if yyrt1264 {
+			for ; yyj1264 < yyl1264; yyj1264++ {
+				yyv1264 = append(yyv1264, NodeUtilization{})
 				if r.TryDecodeAsNil() {
-					yyv1197[yyj1197] = NodeUtilization{}
+					yyv1264[yyj1264] = NodeUtilization{}
 				} else {
-					yyv1199 := &yyv1197[yyj1197]
-					yyv1199.CodecDecodeSelf(d)
+					yyv1266 := &yyv1264[yyj1264]
+					yyv1266.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1197 := 0; !r.CheckBreak(); yyj1197++ {
-			if yyj1197 >= len(yyv1197) {
-				yyv1197 = append(yyv1197, NodeUtilization{}) // var yyz1197 NodeUtilization
-				yyc1197 = true
+		for yyj1264 := 0; !r.CheckBreak(); yyj1264++ {
+			if yyj1264 >= len(yyv1264) {
+				yyv1264 = append(yyv1264, NodeUtilization{}) // var yyz1264 NodeUtilization
+				yyc1264 = true
 			}
 
-			if yyj1197 < len(yyv1197) {
+			if yyj1264 < len(yyv1264) {
 				if r.TryDecodeAsNil() {
-					yyv1197[yyj1197] = NodeUtilization{}
+					yyv1264[yyj1264] = NodeUtilization{}
 				} else {
-					yyv1200 := &yyv1197[yyj1197]
-					yyv1200.CodecDecodeSelf(d)
+					yyv1267 := &yyv1264[yyj1264]
+					yyv1267.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13867,10 +14589,10 @@ func (x codecSelfer1234) decSliceNodeUtilization(v *[]NodeUtilization, d *codec1
 			}
 
 		}
-		yyh1197.End()
+		yyh1264.End()
 	}
-	if yyc1197 {
-		*v = yyv1197
+	if yyc1264 {
+		*v = yyv1264
 	}
 
 }
@@ -13880,9 +14602,9 @@ func (x codecSelfer1234) encSliceClusterAutoscaler(v []ClusterAutoscaler, e *cod
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1201 := range v {
-		yy1202 := &yyv1201
-		yy1202.CodecEncodeSelf(e)
+	for _, yyv1268 := range v {
+		yy1269 := &yyv1268
+		yy1269.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13892,75 +14614,75 @@ func (x codecSelfer1234) decSliceClusterAutoscaler(v *[]ClusterAutoscaler, d *co
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1203 := *v
-	yyh1203, yyl1203 := z.DecSliceHelperStart()
+	yyv1270 := *v
+	yyh1270, yyl1270 := z.DecSliceHelperStart()
 
-	var yyrr1203, yyrl1203 int
-	var yyc1203, yyrt1203 bool
-	_, _, _ = yyc1203, yyrt1203, yyrl1203
-	yyrr1203 = yyl1203
+	var yyrr1270, yyrl1270 int
+	var yyc1270, yyrt1270 bool
+	_, _, _ = yyc1270, yyrt1270, yyrl1270
+	yyrr1270 = yyl1270
 
-	if yyv1203 == nil {
-		if yyrl1203, yyrt1203 = z.DecInferLen(yyl1203, z.DecBasicHandle().MaxInitLen, 232); yyrt1203 {
-			yyrr1203 = yyrl1203
+	if yyv1270 == nil {
+		if yyrl1270, yyrt1270 = z.DecInferLen(yyl1270, z.DecBasicHandle().MaxInitLen, 232); yyrt1270 {
+			yyrr1270 = yyrl1270
 		}
-		yyv1203 = make([]ClusterAutoscaler, yyrl1203)
-		yyc1203 = true
+		yyv1270 = make([]ClusterAutoscaler, yyrl1270)
+		yyc1270 = true
 	}
 
-	if yyl1203 == 0 {
-		if len(yyv1203) != 0 {
-			yyv1203 = yyv1203[:0]
-			yyc1203 = true
+	if yyl1270 == 0 {
+		if len(yyv1270) != 0 {
+			yyv1270 = yyv1270[:0]
+			yyc1270 = true
 		}
-	} else if yyl1203 > 0 {
+	} else if yyl1270 > 0 {
 
-		if yyl1203 > cap(yyv1203) {
-			yyrl1203, yyrt1203 = z.DecInferLen(yyl1203, z.DecBasicHandle().MaxInitLen, 232)
-			yyv1203 = make([]ClusterAutoscaler, yyrl1203)
-			yyc1203 = true
+		if yyl1270 > cap(yyv1270) {
+			yyrl1270, yyrt1270 = z.DecInferLen(yyl1270, z.DecBasicHandle().MaxInitLen, 232)
+			yyv1270 = make([]ClusterAutoscaler, yyrl1270)
+			yyc1270 = true
 
-			yyrr1203 = len(yyv1203)
-		} else if yyl1203 != len(yyv1203) {
-			yyv1203 = yyv1203[:yyl1203]
-			yyc1203 = true
+			yyrr1270 = len(yyv1270)
+		} else if yyl1270 != len(yyv1270) {
+			yyv1270 = yyv1270[:yyl1270]
+			yyc1270 = true
 		}
-		yyj1203 := 0
-		for ; yyj1203 < yyrr1203; yyj1203++ {
+		yyj1270 := 0
+		for ; yyj1270 < yyrr1270; yyj1270++ {
 			if r.TryDecodeAsNil() {
-				yyv1203[yyj1203] = ClusterAutoscaler{}
+				yyv1270[yyj1270] = ClusterAutoscaler{}
 			} else {
-				yyv1204 := &yyv1203[yyj1203]
-				yyv1204.CodecDecodeSelf(d)
+				yyv1271 := &yyv1270[yyj1270]
+				yyv1271.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1203 {
-			for ; yyj1203 < yyl1203; yyj1203++ {
-				yyv1203 = append(yyv1203, ClusterAutoscaler{})
+		if yyrt1270 {
+			for ; yyj1270 < yyl1270; yyj1270++ {
+				yyv1270 = append(yyv1270, ClusterAutoscaler{})
 				if r.TryDecodeAsNil() {
-					yyv1203[yyj1203] = ClusterAutoscaler{}
+					yyv1270[yyj1270] = ClusterAutoscaler{}
 				} else {
-					yyv1205 := &yyv1203[yyj1203]
-					yyv1205.CodecDecodeSelf(d)
+					yyv1272 := &yyv1270[yyj1270]
+					yyv1272.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1203 := 0; !r.CheckBreak(); yyj1203++ {
-			if yyj1203 >= len(yyv1203) {
-				yyv1203 = append(yyv1203, ClusterAutoscaler{}) // var yyz1203 ClusterAutoscaler
-				yyc1203 = true
+		for yyj1270 := 0; !r.CheckBreak(); yyj1270++ {
+			if yyj1270 >= len(yyv1270) {
+				yyv1270 = append(yyv1270, ClusterAutoscaler{}) // var yyz1270 ClusterAutoscaler
+				yyc1270 = true
 			}
 
-			if yyj1203 < len(yyv1203) {
+			if yyj1270 < len(yyv1270) {
 				if r.TryDecodeAsNil() {
-					yyv1203[yyj1203] = ClusterAutoscaler{}
+					yyv1270[yyj1270] = ClusterAutoscaler{}
 				} else {
-					yyv1206 := &yyv1203[yyj1203]
-					yyv1206.CodecDecodeSelf(d)
+					yyv1273 := &yyv1270[yyj1270]
+					yyv1273.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -13968,10 +14690,10 @@ func (x codecSelfer1234) decSliceClusterAutoscaler(v *[]ClusterAutoscaler, d *co
 			}
 
 		}
-		yyh1203.End()
+		yyh1270.End()
 	}
-	if yyc1203 {
-		*v = yyv1203
+	if yyc1270 {
+		*v = yyv1270
 	}
 
 }
@@ -13981,9 +14703,9 @@ func (x codecSelfer1234) encSlicePodSelectorRequirement(v []PodSelectorRequireme
 	z, r := codec1978.GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.EncodeArrayStart(len(v))
-	for _, yyv1207 := range v {
-		yy1208 := &yyv1207
-		yy1208.CodecEncodeSelf(e)
+	for _, yyv1274 := range v {
+		yy1275 := &yyv1274
+		yy1275.CodecEncodeSelf(e)
 	}
 	r.EncodeEnd()
 }
@@ -13993,75 +14715,75 @@ func (x codecSelfer1234) decSlicePodSelectorRequirement(v *[]PodSelectorRequirem
 	z, r := codec1978.GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1209 := *v
-	yyh1209, yyl1209 := z.DecSliceHelperStart()
+	yyv1276 := *v
+	yyh1276, yyl1276 := z.DecSliceHelperStart()
 
-	var yyrr1209, yyrl1209 int
-	var yyc1209, yyrt1209 bool
-	_, _, _ = yyc1209, yyrt1209, yyrl1209
-	yyrr1209 = yyl1209
+	var yyrr1276, yyrl1276 int
+	var yyc1276, yyrt1276 bool
+	_, _, _ = yyc1276, yyrt1276, yyrl1276
+	yyrr1276 = yyl1276
 
-	if yyv1209 == nil {
-		if yyrl1209, yyrt1209 = z.DecInferLen(yyl1209, z.DecBasicHandle().MaxInitLen, 56); yyrt1209 {
-			yyrr1209 = yyrl1209
+	if yyv1276 == nil {
+		if yyrl1276, yyrt1276 = z.DecInferLen(yyl1276, z.DecBasicHandle().MaxInitLen, 56); yyrt1276 {
+			yyrr1276 = yyrl1276
 		}
-		yyv1209 = make([]PodSelectorRequirement, yyrl1209)
-		yyc1209 = true
+		yyv1276 = make([]PodSelectorRequirement, yyrl1276)
+		yyc1276 = true
 	}
 
-	if yyl1209 == 0 {
-		if len(yyv1209) != 0 {
-			yyv1209 = yyv1209[:0]
-			yyc1209 = true
+	if yyl1276 == 0 {
+		if len(yyv1276) != 0 {
+			yyv1276 = yyv1276[:0]
+			yyc1276 = true
 		}
-	} else if yyl1209 > 0 {
+	} else if yyl1276 > 0 {
 
-		if yyl1209 > cap(yyv1209) {
-			yyrl1209, yyrt1209 = z.DecInferLen(yyl1209, z.DecBasicHandle().MaxInitLen, 56)
-			yyv1209 = make([]PodSelectorRequirement, yyrl1209)
-			yyc1209 = true
+		if yyl1276 > cap(yyv1276) {
+			yyrl1276, yyrt1276 = z.DecInferLen(yyl1276, z.DecBasicHandle().MaxInitLen, 56)
+			yyv1276 = make([]PodSelectorRequirement, yyrl1276)
+			yyc1276 = true
 
-			yyrr1209 = len(yyv1209)
-		} else if yyl1209 != len(yyv1209) {
-			yyv1209 = yyv1209[:yyl1209]
-			yyc1209 = true
+			yyrr1276 = len(yyv1276)
+		} else if yyl1276 != len(yyv1276) {
+			yyv1276 = yyv1276[:yyl1276]
+			yyc1276 = true
 		}
-		yyj1209 := 0
-		for ; yyj1209 < yyrr1209; yyj1209++ {
+		yyj1276 := 0
+		for ; yyj1276 < yyrr1276; yyj1276++ {
 			if r.TryDecodeAsNil() {
-				yyv1209[yyj1209] = PodSelectorRequirement{}
+				yyv1276[yyj1276] = PodSelectorRequirement{}
 			} else {
-				yyv1210 := &yyv1209[yyj1209]
-				yyv1210.CodecDecodeSelf(d)
+				yyv1277 := &yyv1276[yyj1276]
+				yyv1277.CodecDecodeSelf(d)
 			}
 
 		}
-		if yyrt1209 {
-			for ; yyj1209 < yyl1209; yyj1209++ {
-				yyv1209 = append(yyv1209, PodSelectorRequirement{})
+		if yyrt1276 {
+			for ; yyj1276 < yyl1276; yyj1276++ {
+				yyv1276 = append(yyv1276, PodSelectorRequirement{})
 				if r.TryDecodeAsNil() {
-					yyv1209[yyj1209] = PodSelectorRequirement{}
+					yyv1276[yyj1276] = PodSelectorRequirement{}
 				} else {
-					yyv1211 := &yyv1209[yyj1209]
-					yyv1211.CodecDecodeSelf(d)
+					yyv1278 := &yyv1276[yyj1276]
+					yyv1278.CodecDecodeSelf(d)
 				}
 
 			}
 		}
 
 	} else {
-		for yyj1209 := 0; !r.CheckBreak(); yyj1209++ {
-			if yyj1209 >= len(yyv1209) {
-				yyv1209 = append(yyv1209, PodSelectorRequirement{}) // var yyz1209 PodSelectorRequirement
-				yyc1209 = true
+		for yyj1276 := 0; !r.CheckBreak(); yyj1276++ {
+			if yyj1276 >= len(yyv1276) {
+				yyv1276 = append(yyv1276, PodSelectorRequirement{}) // var yyz1276 PodSelectorRequirement
+				yyc1276 = true
 			}
 
-			if yyj1209 < len(yyv1209) {
+			if yyj1276 < len(yyv1276) {
 				if r.TryDecodeAsNil() {
-					yyv1209[yyj1209] = PodSelectorRequirement{}
+					yyv1276[yyj1276] = PodSelectorRequirement{}
 				} else {
-					yyv1212 := &yyv1209[yyj1209]
-					yyv1212.CodecDecodeSelf(d)
+					yyv1279 := &yyv1276[yyj1276]
+					yyv1279.CodecDecodeSelf(d)
 				}
 
 			} else {
@@ -14069,10 +14791,10 @@ func (x codecSelfer1234) decSlicePodSelectorRequirement(v *[]PodSelectorRequirem
 			}
 
 		}
-		yyh1209.End()
+		yyh1276.End()
 	}
-	if yyc1209 {
-		*v = yyv1209
+	if yyc1276 {
+		*v = yyv1276
 	}
 
 }
@@ -196,7 +196,8 @@ type DeploymentSpec struct {
 
 	// Label selector for pods. Existing ReplicationControllers whose pods are
 	// selected by this will be the ones affected by this deployment.
-	Selector map[string]string `json:"selector,omitempty"`
+	// Defaults to the labels on the pod template.
+	Selector *PodSelector `json:"selector,omitempty"`
 
 	// Template describes the pods that will be created.
 	Template v1.PodTemplateSpec `json:"template"`
@@ -213,6 +214,17 @@ type DeploymentSpec struct {
 	// Value of this key is hash of DeploymentSpec.PodTemplateSpec.
 	// No label is added if this is set to empty string.
 	UniqueLabelKey *string `json:"uniqueLabelKey,omitempty"`
+
+	// Indicates that the deployment is paused and will not be rolled out by
+	// the deployment controller.
+	Paused bool `json:"paused,omitempty"`
+
+	// The maximum time in seconds for a deployment to make progress before it
+	// is considered to be failed. The deployment controller will continue to
+	// process failed deployments and a condition with a ProgressDeadlineExceeded
+	// reason will be surfaced in the deployment status. Note that progress will
+	// not be estimated during the time a deployment is paused. Not set by default.
+	ProgressDeadlineSeconds *int `json:"progressDeadlineSeconds,omitempty"`
 }
 
 // DeploymentStrategy describes how to replace existing pods with new ones.
@@ -278,6 +290,37 @@ type DeploymentStatus struct {
 
 	// Total number of non-terminated pods targeted by this deployment that have the desired template spec.
 	UpdatedReplicas int `json:"updatedReplicas,omitempty"`
+
+	// Represents the latest available observations of a deployment's current state.
+	Conditions []DeploymentCondition `json:"conditions,omitempty"`
+}
+
+type DeploymentConditionType string
+
+// These are valid conditions of a deployment.
+const (
+	// DeploymentProgressing means the deployment is progressing. Progress for a
+	// deployment is considered when a new replication controller is created or
+	// adopted, and when new pods scale up or old pods scale down. Progress is
+	// not estimated for paused deployments or when progressDeadlineSeconds is
+	// not specified.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+)
+
+// DeploymentCondition describes the state of a deployment at a certain point.
+type DeploymentCondition struct {
+	// Type of deployment condition, currently only Progressing.
+	Type DeploymentConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime unversioned.Time `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transit from one status to another.
+	LastTransitionTime unversioned.Time `json:"lastTransitionTime,omitempty"`
+	// (brief) reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	Message string `json:"message,omitempty"`
 }
 
 // DeploymentList is a list of Deployments.
@@ -127,6 +127,20 @@ func (Deployment) SwaggerDoc() map[string]string {
 	return map_Deployment
 }
 
+var map_DeploymentCondition = map[string]string{
+	"":                   "DeploymentCondition describes the state of a deployment at a certain point.",
+	"type":               "Type of deployment condition, currently only Progressing.",
+	"status":             "Status of the condition, one of True, False, Unknown.",
+	"lastUpdateTime":     "The last time this condition was updated.",
+	"lastTransitionTime": "Last time the condition transit from one status to another.",
+	"reason":             "(brief) reason for the condition's last transition.",
+	"message":            "Human readable message indicating details about last transition.",
+}
+
+func (DeploymentCondition) SwaggerDoc() map[string]string {
+	return map_DeploymentCondition
+}
+
 var map_DeploymentList = map[string]string{
 	"":         "DeploymentList is a list of Deployments.",
 	"metadata": "Standard list metadata.",
@@ -138,12 +152,14 @@ func (DeploymentList) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentSpec = map[string]string{
-	"":               "DeploymentSpec is the specification of the desired behavior of the Deployment.",
-	"replicas":       "Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
-	"selector":       "Label selector for pods. Existing ReplicationControllers whose pods are selected by this will be the ones affected by this deployment.",
-	"template":       "Template describes the pods that will be created.",
-	"strategy":       "The deployment strategy to use to replace existing pods with new ones.",
-	"uniqueLabelKey": "Key of the selector that is added to existing RCs (and label key that is added to its pods) to prevent the existing RCs to select new pods (and old pods being selected by new RC). Users can set this to an empty string to indicate that the system should not add any selector and label. If unspecified, system uses \"deployment.kubernetes.io/podTemplateHash\". Value of this key is hash of DeploymentSpec.PodTemplateSpec. No label is added if this is set to empty string.",
+	"":                        "DeploymentSpec is the specification of the desired behavior of the Deployment.",
+	"replicas":                "Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
+	"selector":                "Label selector for pods. Existing ReplicationControllers whose pods are selected by this will be the ones affected by this deployment. Defaults to the labels on the pod template.",
+	"template":                "Template describes the pods that will be created.",
+	"strategy":                "The deployment strategy to use to replace existing pods with new ones.",
+	"uniqueLabelKey":          "Key of the selector that is added to existing RCs (and label key that is added to its pods) to prevent the existing RCs to select new pods (and old pods being selected by new RC). Users can set this to an empty string to indicate that the system should not add any selector and label. If unspecified, system uses \"deployment.kubernetes.io/podTemplateHash\". Value of this key is hash of DeploymentSpec.PodTemplateSpec. No label is added if this is set to empty string.",
+	"paused":                  "Indicates that the deployment is paused and will not be rolled out by the deployment controller.",
+	"progressDeadlineSeconds": "The maximum time in seconds for a deployment to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Note that progress will not be estimated during the time a deployment is paused. Not set by default.",
 }
 
 func (DeploymentSpec) SwaggerDoc() map[string]string {
@@ -154,6 +170,7 @@ var map_DeploymentStatus = map[string]string{
 	"":                "DeploymentStatus is the most recently observed status of the Deployment.",
 	"replicas":        "Total number of non-terminated pods targeted by this deployment (their labels match the selector).",
 	"updatedReplicas": "Total number of non-terminated pods targeted by this deployment that have the desired template spec.",
+	"conditions":      "Represents the latest available observations of a deployment's current state.",
 }
 
 func (DeploymentStatus) SwaggerDoc() map[string]string {
@@ -290,11 +290,40 @@ func ValidateDeploymentStrategy(strategy *extensions.DeploymentStrategy, fieldNa
 // Validates given deployment spec.
 func ValidateDeploymentSpec(spec *extensions.DeploymentSpec) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
-	allErrs = append(allErrs, apivalidation.ValidateNonEmptySelector(spec.Selector, "selector")...)
 	allErrs = append(allErrs, apivalidation.ValidatePositiveField(int64(spec.Replicas), "replicas")...)
-	allErrs = append(allErrs, apivalidation.ValidatePodTemplateSpecForRC(&spec.Template, spec.Selector, spec.Replicas, "template")...)
+	if spec.Selector == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	} else if len(spec.Selector.MatchLabels)+len(spec.Selector.MatchExpressions) == 0 {
+		// Selectors sent in the old map form decode to an empty PodSelector.
+		allErrs = append(allErrs, errs.NewFieldInvalid("selector", spec.Selector, "must set matchLabels or matchExpressions"))
+	} else {
+		allErrs = append(allErrs, ValidatePodSelector(spec.Selector).Prefix("selector")...)
+		if selector, err := extensions.PodSelectorAsSelector(spec.Selector); err == nil {
+			labels := labels.Set(spec.Template.Labels)
+			if !selector.Matches(labels) {
+				allErrs = append(allErrs, errs.NewFieldInvalid("template.metadata.labels", spec.Template.Labels, "selector does not match labels in template"))
+			}
+		}
+	}
+	// The selector has been checked against the template labels above.
+	allErrs = append(allErrs, apivalidation.ValidatePodTemplateSpecForRC(&spec.Template, nil, spec.Replicas, "template")...)
 	allErrs = append(allErrs, ValidateDeploymentStrategy(&spec.Strategy, "strategy")...)
 	allErrs = append(allErrs, apivalidation.ValidateLabelName(spec.UniqueLabelKey, "uniqueLabel")...)
+	if spec.ProgressDeadlineSeconds != nil {
+		allErrs = append(allErrs, ValidateProgressDeadlineSeconds(*spec.ProgressDeadlineSeconds, spec.Strategy.RollingUpdate, "progressDeadlineSeconds")...)
+	}
+	return allErrs
+}
+
+// ValidateProgressDeadlineSeconds tests that the progress deadline is positive
+// and leaves time for new pods to become available.
+func ValidateProgressDeadlineSeconds(progressDeadlineSeconds int, rollingUpdate *extensions.RollingUpdateDeployment, fieldName string) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if progressDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid(fieldName, progressDeadlineSeconds, "must be greater than 0"))
+	} else if rollingUpdate != nil && progressDeadlineSeconds <= rollingUpdate.MinReadySeconds {
+		allErrs = append(allErrs, errs.NewFieldInvalid(fieldName, progressDeadlineSeconds, "must be greater than minReadySeconds"))
+	}
 	return allErrs
 }
 
@@ -698,8 +698,10 @@ func validDeployment() *extensions.Deployment {
 			Namespace: api.NamespaceDefault,
 		},
 		Spec: extensions.DeploymentSpec{
-			Selector: map[string]string{
-				"name": "abc",
+			Selector: &extensions.PodSelector{
+				MatchLabels: map[string]string{
+					"name": "abc",
+				},
 			},
 			Template: api.PodTemplateSpec{
 				ObjectMeta: api.ObjectMeta{
@@ -727,8 +729,24 @@ func validDeployment() *extensions.Deployment {
 }
 
 func TestValidateDeployment(t *testing.T) {
+	expressionSelectorDeployment := validDeployment()
+	expressionSelectorDeployment.Spec.Selector = &extensions.PodSelector{
+		MatchExpressions: []extensions.PodSelectorRequirement{
+			{
+				Key:      "name",
+				Operator: extensions.PodSelectorOpIn,
+				Values:   []string{"abc", "def"},
+			},
+		},
+	}
+	progressDeadlineDeployment := validDeployment()
+	progressDeadlineDeployment.Spec.Paused = true
+	progressDeadlineDeployment.Spec.ProgressDeadlineSeconds = new(int)
+	*progressDeadlineDeployment.Spec.ProgressDeadlineSeconds = 600
 	successCases := []*extensions.Deployment{
 		validDeployment(),
+		expressionSelectorDeployment,
+		progressDeadlineDeployment,
 	}
 	for _, successCase := range successCases {
 		if errs := ValidateDeployment(successCase); len(errs) != 0 {
@@ -744,11 +762,36 @@ func TestValidateDeployment(t *testing.T) {
 	}
 	// selector should match the labels in pod template.
 	invalidSelectorDeployment := validDeployment()
-	invalidSelectorDeployment.Spec.Selector = map[string]string{
-		"name": "def",
+	invalidSelectorDeployment.Spec.Selector = &extensions.PodSelector{
+		MatchLabels: map[string]string{
+			"name": "def",
+		},
 	}
 	errorCases["selector does not match labels"] = invalidSelectorDeployment
 
+	// selector expressions should match the labels in pod template.
+	invalidExpressionSelectorDeployment := validDeployment()
+	invalidExpressionSelectorDeployment.Spec.Selector = &extensions.PodSelector{
+		MatchExpressions: []extensions.PodSelectorRequirement{
+			{
+				Key:      "name",
+				Operator: extensions.PodSelectorOpNotIn,
+				Values:   []string{"abc"},
+			},
+		},
+	}
+	errorCases["selector does not match labels in template"] = invalidExpressionSelectorDeployment
+
+	// selector is required.
+	missingSelectorDeployment := validDeployment()
+	missingSelectorDeployment.Spec.Selector = nil
+	errorCases["spec.selector: required value"] = missingSelectorDeployment
+
+	// an empty selector would select every pod.
+	emptySelectorDeployment := validDeployment()
+	emptySelectorDeployment.Spec.Selector = &extensions.PodSelector{}
+	errorCases["must set matchLabels or matchExpressions"] = emptySelectorDeployment
+
 	// RestartPolicy should be always.
 	invalidRestartPolicyDeployment := validDeployment()
 	invalidRestartPolicyDeployment.Spec.Template.Spec.RestartPolicy = api.RestartPolicyNever
@@ -798,6 +841,24 @@ func TestValidateDeployment(t *testing.T) {
 	}
 	errorCases["should not be more than 100%"] = invalidMaxUnavailableDeployment
 
+	// ProgressDeadlineSeconds should be positive.
+	invalidProgressDeadlineDeployment := validDeployment()
+	invalidProgressDeadlineDeployment.Spec.ProgressDeadlineSeconds = new(int)
+	errorCases["spec.progressDeadlineSeconds: invalid value '0'"] = invalidProgressDeadlineDeployment
+
+	// ProgressDeadlineSeconds should be greater than MinReadySeconds.
+	progressDeadlineSeconds := 10
+	invalidMinReadyDeployment := validDeployment()
+	invalidMinReadyDeployment.Spec.ProgressDeadlineSeconds = &progressDeadlineSeconds
+	invalidMinReadyDeployment.Spec.Strategy = extensions.DeploymentStrategy{
+		Type: extensions.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &extensions.RollingUpdateDeployment{
+			MaxUnavailable:  util.NewIntOrStringFromInt(1),
+			MinReadySeconds: progressDeadlineSeconds,
+		},
+	}
+	errorCases["must be greater than minReadySeconds"] = invalidMinReadyDeployment
+
 	for k, v := range errorCases {
 		errs := ValidateDeployment(v)
 		if len(errs) == 0 {
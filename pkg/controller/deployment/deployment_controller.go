@@ -74,6 +74,16 @@ func (d *DeploymentController) reconcileDeployments() []error {
 }
 
 func (d *DeploymentController) reconcileDeployment(deployment *extensions.Deployment) error {
+	if deploymentutil.HasEmptySelector(*deployment) {
+		// Never act on a deployment that would select every pod.
+		return fmt.Errorf("deployment %s has an empty selector", deployment.Name)
+	}
+	if deployment.Spec.Paused {
+		return d.syncPausedDeployment(*deployment)
+	}
+	if condition := deploymentutil.GetDeploymentCondition(deployment.Status, extensions.DeploymentProgressing); condition != nil && condition.Reason == deploymentutil.PausedDeployReason {
+		return d.syncResumedDeployment(*deployment)
+	}
 	switch deployment.Spec.Strategy.Type {
 	case extensions.RecreateDeploymentStrategyType:
 		return d.reconcileRecreateDeployment(*deployment)
@@ -83,13 +93,31 @@ func (d *DeploymentController) reconcileDeployment(deployment *extensions.Deploy
 	return fmt.Errorf("unexpected deployment strategy type: %s", deployment.Spec.Strategy.Type)
 }
 
+// Paused deployments are not rolled out. Their Progressing condition is set to
+// Unknown, so that no progress is expected until they are resumed.
+func (d *DeploymentController) syncPausedDeployment(deployment extensions.Deployment) error {
+	condition := deploymentutil.GetDeploymentCondition(deployment.Status, extensions.DeploymentProgressing)
+	if condition != nil && condition.Reason == deploymentutil.PausedDeployReason {
+		return nil
+	}
+	condition = deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionUnknown, deploymentutil.PausedDeployReason, "Deployment is paused")
+	return d.updateDeploymentCondition(deployment, *condition)
+}
+
+// Records that a paused deployment was resumed, which restarts its progress
+// deadline. The rollout continues on the next sync.
+func (d *DeploymentController) syncResumedDeployment(deployment extensions.Deployment) error {
+	condition := deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionUnknown, deploymentutil.ResumedDeployReason, "Deployment is resumed")
+	return d.updateDeploymentCondition(deployment, *condition)
+}
+
 func (d *DeploymentController) reconcileRecreateDeployment(deployment extensions.Deployment) error {
 	// TODO: implement me.
 	return nil
 }
 
 func (d *DeploymentController) reconcileRollingUpdateDeployment(deployment extensions.Deployment) error {
-	newRC, err := d.getNewRC(deployment)
+	newRC, createdNewRC, err := d.getNewRC(deployment)
 	if err != nil {
 		return err
 	}
@@ -108,7 +136,7 @@ func (d *DeploymentController) reconcileRollingUpdateDeployment(deployment exten
 	}
 	if scaledUp {
 		// Update DeploymentStatus
-		return d.updateDeploymentStatus(allRCs, newRC, deployment)
+		return d.updateDeploymentStatus(allRCs, newRC, deployment, progressingCondition(newRC))
 	}
 
 	// Scale down, if we can.
@@ -116,12 +144,64 @@ func (d *DeploymentController) reconcileRollingUpdateDeployment(deployment exten
 	if err != nil {
 		return err
 	}
-	if scaledDown {
+	if scaledDown || createdNewRC {
+		// Creating the new RC is progress too, even if it cannot be scaled up yet.
 		// Update DeploymentStatus
-		return d.updateDeploymentStatus(allRCs, newRC, deployment)
+		return d.updateDeploymentStatus(allRCs, newRC, deployment, progressingCondition(newRC))
 	}
 	// TODO: raise an event, neither scaled up nor down.
-	return nil
+	return d.syncRolloutStatus(allRCs, newRC, deployment)
+}
+
+// Returns the condition recorded whenever the rollout of a deployment creates,
+// adopts or scales one of its RCs.
+func progressingCondition(newRC *api.ReplicationController) extensions.DeploymentCondition {
+	return *deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionTrue, deploymentutil.RCUpdatedReason, fmt.Sprintf("Replication controller %q is progressing", newRC.Name))
+}
+
+// Returns the condition recorded once the rollout to the given RC is complete.
+func availableCondition(newRC *api.ReplicationController) extensions.DeploymentCondition {
+	return *deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionTrue, deploymentutil.NewRCAvailableReason, fmt.Sprintf("Replication controller %q has successfully progressed", newRC.Name))
+}
+
+// Updates the Progressing condition of a deployment whose RCs did not need
+// scaling. The rollout is complete once the new RC is fully scaled up, no old
+// pods remain and all new pods are available. Otherwise, the rollout has failed
+// if it made no progress within the deployment's progress deadline.
+func (d *DeploymentController) syncRolloutStatus(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment) error {
+	condition := deploymentutil.GetDeploymentCondition(deployment.Status, extensions.DeploymentProgressing)
+	scaled := newRC.Spec.Replicas == deployment.Spec.Replicas && deploymentutil.GetReplicaCountForRCs(allRCs) == deployment.Spec.Replicas
+	if scaled && condition != nil && condition.Reason == deploymentutil.NewRCAvailableReason {
+		// The rollout to this RC is already complete. A rollout to another RC
+		// leaves old pods behind or the new RC short of replicas, so a condition
+		// left over from an earlier rollout is never honoured here.
+		return nil
+	}
+	if scaled {
+		availablePodCount, err := deploymentutil.GetAvailablePodsForRCs(d.client, []*api.ReplicationController{newRC})
+		if err != nil {
+			return fmt.Errorf("could not find available pods: %v", err)
+		}
+		if availablePodCount >= deployment.Spec.Replicas {
+			return d.updateDeploymentStatus(allRCs, newRC, deployment, availableCondition(newRC))
+		}
+	}
+	if condition == nil || condition.Reason == deploymentutil.NewRCAvailableReason {
+		// Progress is not tracked for this rollout yet: either the deployment
+		// predates progress tracking, or its last completed rollout was to another
+		// RC and an existing RC was adopted as the new one. Start the clock now.
+		return d.updateDeploymentStatus(allRCs, newRC, deployment, progressingCondition(newRC))
+	}
+	if !deploymentutil.DeploymentTimedOut(&deployment, time.Now()) {
+		return nil
+	}
+	if condition.Reason == deploymentutil.TimedOutReason {
+		// The deployment has already been marked as failed.
+		return nil
+	}
+	d.eventRecorder.Eventf(&deployment, deploymentutil.TimedOutReason, "Deployment %s has not made progress in %d seconds", deployment.Name, *deployment.Spec.ProgressDeadlineSeconds)
+	condition = deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionFalse, deploymentutil.TimedOutReason, fmt.Sprintf("Replication controller %q has timed out progressing", newRC.Name))
+	return d.updateDeploymentStatus(allRCs, newRC, deployment, *condition)
 }
 
 func (d *DeploymentController) getOldRCs(deployment extensions.Deployment) ([]*api.ReplicationController, error) {
@@ -129,18 +209,18 @@ func (d *DeploymentController) getOldRCs(deployment extensions.Deployment) ([]*a
 }
 
 // Returns an RC that matches the intent of the given deployment.
-// It creates a new RC if required.
-func (d *DeploymentController) getNewRC(deployment extensions.Deployment) (*api.ReplicationController, error) {
+// It creates a new RC if required, in which case it also returns true.
+func (d *DeploymentController) getNewRC(deployment extensions.Deployment) (*api.ReplicationController, bool, error) {
 	existingNewRC, err := deploymentutil.GetNewRC(deployment, d.client)
 	if err != nil || existingNewRC != nil {
-		return existingNewRC, err
+		return existingNewRC, false, err
 	}
 	// new RC does not exist, create one.
 	namespace := deployment.ObjectMeta.Namespace
 	podTemplateSpecHash := deploymentutil.GetPodTemplateSpecHash(deployment.Spec.Template)
 	newRCTemplate := deploymentutil.GetNewRCTemplate(deployment)
 	// Add podTemplateHash label to selector.
-	newRCSelector := deploymentutil.CloneAndAddLabel(deployment.Spec.Selector, deployment.Spec.UniqueLabelKey, podTemplateSpecHash)
+	newRCSelector := deploymentutil.GetNewRCSelector(deployment, podTemplateSpecHash)
 
 	newRC := api.ReplicationController{
 		ObjectMeta: api.ObjectMeta{
@@ -155,9 +235,9 @@ func (d *DeploymentController) getNewRC(deployment extensions.Deployment) (*api.
 	}
 	createdRC, err := d.client.ReplicationControllers(namespace).Create(&newRC)
 	if err != nil {
-		return nil, fmt.Errorf("error creating replication controller: %v", err)
+		return nil, false, fmt.Errorf("error creating replication controller: %v", err)
 	}
-	return createdRC, nil
+	return createdRC, true, nil
 }
 
 func (d *DeploymentController) reconcileNewRC(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment) (bool, error) {
@@ -241,7 +321,7 @@ func (d *DeploymentController) reconcileOldRCs(allRCs []*api.ReplicationControll
 	return true, err
 }
 
-func (d *DeploymentController) updateDeploymentStatus(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment) error {
+func (d *DeploymentController) updateDeploymentStatus(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment, condition extensions.DeploymentCondition) error {
 	totalReplicas := deploymentutil.GetReplicaCountForRCs(allRCs)
 	updatedReplicas := deploymentutil.GetReplicaCountForRCs([]*api.ReplicationController{newRC})
 	newDeployment := deployment
@@ -249,7 +329,18 @@ func (d *DeploymentController) updateDeploymentStatus(allRCs []*api.ReplicationC
 	newDeployment.Status = extensions.DeploymentStatus{
 		Replicas:        totalReplicas,
 		UpdatedReplicas: updatedReplicas,
+		Conditions:      deployment.Status.Conditions,
 	}
+	deploymentutil.SetDeploymentCondition(&newDeployment.Status, condition)
+	_, err := d.client.Extensions().Deployments(deployment.ObjectMeta.Namespace).UpdateStatus(&newDeployment)
+	return err
+}
+
+// Updates a single condition in the deployment status, leaving the replica
+// counts unchanged.
+func (d *DeploymentController) updateDeploymentCondition(deployment extensions.Deployment, condition extensions.DeploymentCondition) error {
+	newDeployment := deployment
+	deploymentutil.SetDeploymentCondition(&newDeployment.Status, condition)
 	_, err := d.client.Extensions().Deployments(deployment.ObjectMeta.Namespace).UpdateStatus(&newDeployment)
 	return err
 }
@@ -19,13 +19,16 @@ package deployment
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	exp "k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/client/record"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
 )
 
 func TestDeploymentController_reconcileNewRC(t *testing.T) {
@@ -229,6 +232,357 @@ func TestDeploymentController_reconcileOldRCs(t *testing.T) {
 	}
 }
 
+func TestDeploymentController_reconcileEmptySelector(t *testing.T) {
+	for _, selector := range []*exp.PodSelector{nil, {}} {
+		deployment := deployment("foo", 10, util.NewIntOrStringFromInt(2), util.NewIntOrStringFromInt(2))
+		deployment.Spec.Selector = selector
+		fake := &testclient.Fake{}
+		controller := &DeploymentController{
+			client:        fake,
+			eventRecorder: &record.FakeRecorder{},
+		}
+		if err := controller.reconcileDeployment(&deployment); err == nil {
+			t.Errorf("expected an error for selector %+v", selector)
+		}
+		if len(fake.Actions()) > 0 {
+			t.Errorf("unexpected actions: %v", fake.Actions())
+		}
+	}
+}
+
+func TestDeploymentController_reconcilePausedDeployment(t *testing.T) {
+	tests := []struct {
+		paused         bool
+		reason         string
+		expectedReason string
+	}{
+		{
+			paused:         true,
+			expectedReason: deploymentutil.PausedDeployReason,
+		},
+		{
+			paused:         true,
+			reason:         deploymentutil.RCUpdatedReason,
+			expectedReason: deploymentutil.PausedDeployReason,
+		},
+		{
+			// Already marked as paused.
+			paused: true,
+			reason: deploymentutil.PausedDeployReason,
+		},
+		{
+			paused:         false,
+			reason:         deploymentutil.PausedDeployReason,
+			expectedReason: deploymentutil.ResumedDeployReason,
+		},
+	}
+
+	for i, test := range tests {
+		t.Logf("executing scenario %d", i)
+		deployment := deployment("foo", 10, util.NewIntOrStringFromInt(2), util.NewIntOrStringFromInt(2))
+		deployment.Spec.Paused = test.paused
+		if test.reason != "" {
+			deployment.Status.Conditions = []exp.DeploymentCondition{
+				*deploymentutil.NewDeploymentCondition(exp.DeploymentProgressing, api.ConditionTrue, test.reason, ""),
+			}
+		}
+		fake := &testclient.Fake{}
+		controller := &DeploymentController{
+			client:        fake,
+			eventRecorder: &record.FakeRecorder{},
+		}
+		if err := controller.reconcileDeployment(&deployment); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if test.expectedReason == "" {
+			if len(fake.Actions()) > 0 {
+				t.Errorf("unexpected actions: %v", fake.Actions())
+			}
+			continue
+		}
+		if len(fake.Actions()) != 1 {
+			t.Errorf("expected 1 status update, got: %v", fake.Actions())
+			continue
+		}
+		updated := fake.Actions()[0].(testclient.UpdateAction).GetObject().(*exp.Deployment)
+		condition := deploymentutil.GetDeploymentCondition(updated.Status, exp.DeploymentProgressing)
+		if condition == nil {
+			t.Errorf("expected a %s condition", exp.DeploymentProgressing)
+			continue
+		}
+		if condition.Status != api.ConditionUnknown || condition.Reason != test.expectedReason {
+			t.Errorf("expected condition %s with reason %s, got %s with reason %s", api.ConditionUnknown, test.expectedReason, condition.Status, condition.Reason)
+		}
+	}
+}
+
+func TestDeploymentController_syncRolloutStatus(t *testing.T) {
+	tests := []struct {
+		newReplicas int
+		readyPods   int
+		// Reason of the existing Progressing condition, if any.
+		reason         string
+		lastUpdate     time.Duration
+		expectedStatus api.ConditionStatus
+		expectedReason string
+		expectedEvent  bool
+	}{
+		{
+			// Still within the progress deadline.
+			newReplicas: 5,
+			reason:      deploymentutil.RCUpdatedReason,
+			lastUpdate:  5 * time.Second,
+		},
+		{
+			newReplicas:    5,
+			reason:         deploymentutil.RCUpdatedReason,
+			lastUpdate:     time.Minute,
+			expectedStatus: api.ConditionFalse,
+			expectedReason: deploymentutil.TimedOutReason,
+			expectedEvent:  true,
+		},
+		{
+			// Already marked as failed.
+			newReplicas: 5,
+			reason:      deploymentutil.TimedOutReason,
+			lastUpdate:  time.Minute,
+		},
+		{
+			newReplicas:    10,
+			readyPods:      10,
+			reason:         deploymentutil.RCUpdatedReason,
+			lastUpdate:     time.Minute,
+			expectedStatus: api.ConditionTrue,
+			expectedReason: deploymentutil.NewRCAvailableReason,
+		},
+		{
+			// Not all new pods are available yet.
+			newReplicas: 10,
+			readyPods:   8,
+			reason:      deploymentutil.RCUpdatedReason,
+			lastUpdate:  5 * time.Second,
+		},
+		{
+			// Already complete. Only the reason of the condition is checked,
+			// not its message.
+			newReplicas: 10,
+			readyPods:   10,
+			reason:      deploymentutil.NewRCAvailableReason,
+			lastUpdate:  time.Minute,
+		},
+		{
+			// The last completed rollout was to another RC.
+			newReplicas:    5,
+			reason:         deploymentutil.NewRCAvailableReason,
+			lastUpdate:     time.Minute,
+			expectedStatus: api.ConditionTrue,
+			expectedReason: deploymentutil.RCUpdatedReason,
+		},
+		{
+			// No progress has been tracked yet.
+			newReplicas:    5,
+			expectedStatus: api.ConditionTrue,
+			expectedReason: deploymentutil.RCUpdatedReason,
+		},
+	}
+
+	for i, test := range tests {
+		t.Logf("executing scenario %d", i)
+		newRc := rc("foo-v2", test.newReplicas)
+		allRcs := []*api.ReplicationController{newRc}
+		deployment := deployment("foo", 10, util.NewIntOrStringFromInt(2), util.NewIntOrStringFromInt(2))
+		progressDeadlineSeconds := 10
+		deployment.Spec.ProgressDeadlineSeconds = &progressDeadlineSeconds
+		if test.reason != "" {
+			condition := deploymentutil.NewDeploymentCondition(exp.DeploymentProgressing, api.ConditionTrue, test.reason, "")
+			condition.LastUpdateTime = unversioned.NewTime(time.Now().Add(-test.lastUpdate))
+			deployment.Status.Conditions = []exp.DeploymentCondition{*condition}
+		}
+		fake := &testclient.Fake{}
+		fake.AddReactor("list", "pods", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+			podList := &api.PodList{}
+			for podIndex := 0; podIndex < test.readyPods; podIndex++ {
+				podList.Items = append(podList.Items, api.Pod{
+					ObjectMeta: api.ObjectMeta{
+						Name: fmt.Sprintf("%s-pod-%d", newRc.Name, podIndex),
+					},
+					Status: api.PodStatus{
+						Conditions: []api.PodCondition{
+							{
+								Type:   api.PodReady,
+								Status: api.ConditionTrue,
+							},
+						},
+					},
+				})
+			}
+			return true, podList, nil
+		})
+		recorder := &record.FakeRecorder{}
+		controller := &DeploymentController{
+			client:        fake,
+			eventRecorder: recorder,
+		}
+		if err := controller.syncRolloutStatus(allRcs, newRc, deployment); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if e, a := test.expectedEvent, len(recorder.Events) > 0; e != a {
+			t.Errorf("expected event: %v, got events: %v", e, recorder.Events)
+		}
+		var updateAction testclient.UpdateAction
+		for _, action := range fake.Actions() {
+			if a, ok := action.(testclient.UpdateAction); ok {
+				updateAction = a
+			}
+		}
+		if test.expectedReason == "" {
+			if updateAction != nil {
+				t.Errorf("unexpected status update: %v", updateAction)
+			}
+			continue
+		}
+		if updateAction == nil {
+			t.Errorf("expected a status update")
+			continue
+		}
+		updated := updateAction.GetObject().(*exp.Deployment)
+		condition := deploymentutil.GetDeploymentCondition(updated.Status, exp.DeploymentProgressing)
+		if condition == nil {
+			t.Errorf("expected a %s condition", exp.DeploymentProgressing)
+			continue
+		}
+		if condition.Status != test.expectedStatus || condition.Reason != test.expectedReason {
+			t.Errorf("expected condition %s with reason %s, got %s with reason %s", test.expectedStatus, test.expectedReason, condition.Status, condition.Reason)
+		}
+	}
+}
+
+func TestDeploymentController_stuckRolloutAfterCompletedOne(t *testing.T) {
+	oldRc := rc("foo-v1", 1)
+	oldRc.Spec.Selector = map[string]string{"name": "foo"}
+	deployment := deployment("foo", 1, util.NewIntOrStringFromInt(0), util.NewIntOrStringFromInt(1))
+	deployment.Spec.Template.Labels = map[string]string{"name": "foo"}
+	progressDeadlineSeconds := 10
+	deployment.Spec.ProgressDeadlineSeconds = &progressDeadlineSeconds
+	// The rollout to the old RC completed a while ago.
+	condition := availableCondition(oldRc)
+	condition.LastUpdateTime = unversioned.NewTime(time.Now().Add(-time.Minute))
+	deployment.Status.Conditions = []exp.DeploymentCondition{condition}
+
+	fake := &testclient.Fake{}
+	fake.AddReactor("list", "replicationcontrollers", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &api.ReplicationControllerList{Items: []api.ReplicationController{*oldRc}}, nil
+	})
+	fake.AddReactor("create", "replicationcontrollers", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+		created := action.(testclient.CreateAction).GetObject().(*api.ReplicationController)
+		created.Name = "foo-v2"
+		return true, created, nil
+	})
+	// The old pod is not ready, so with no surge nothing can be scaled.
+	fake.AddReactor("list", "pods", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &api.PodList{Items: []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "foo-v1-pod", Labels: oldRc.Spec.Selector}}}}, nil
+	})
+	controller := &DeploymentController{
+		client:        fake,
+		eventRecorder: &record.FakeRecorder{},
+	}
+	if err := controller.reconcileDeployment(&deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var updated *exp.Deployment
+	for _, action := range fake.Actions() {
+		if action.GetVerb() == "update" && action.GetResource() == "deployments" {
+			updated = action.(testclient.UpdateAction).GetObject().(*exp.Deployment)
+		}
+	}
+	if updated == nil {
+		t.Fatalf("expected a status update, got: %v", fake.Actions())
+	}
+	condition = *deploymentutil.GetDeploymentCondition(updated.Status, exp.DeploymentProgressing)
+	if condition.Status != api.ConditionTrue || condition.Reason != deploymentutil.RCUpdatedReason {
+		t.Errorf("expected condition %s with reason %s, got %s with reason %s", api.ConditionTrue, deploymentutil.RCUpdatedReason, condition.Status, condition.Reason)
+	}
+
+	// Once the deadline has passed without progress, the rollout has failed.
+	deployment.Status.Conditions = updated.Status.Conditions
+	deployment.Status.Conditions[0].LastUpdateTime = unversioned.NewTime(time.Now().Add(-time.Minute))
+	newRc := rc("foo-v2", 0)
+	fake.ClearActions()
+	if err := controller.syncRolloutStatus([]*api.ReplicationController{oldRc, newRc}, newRc, deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updated = nil
+	for _, action := range fake.Actions() {
+		if action.GetVerb() == "update" && action.GetResource() == "deployments" {
+			updated = action.(testclient.UpdateAction).GetObject().(*exp.Deployment)
+		}
+	}
+	if updated == nil {
+		t.Fatalf("expected a status update, got: %v", fake.Actions())
+	}
+	condition = *deploymentutil.GetDeploymentCondition(updated.Status, exp.DeploymentProgressing)
+	if condition.Status != api.ConditionFalse || condition.Reason != deploymentutil.TimedOutReason {
+		t.Errorf("expected condition %s with reason %s, got %s with reason %s", api.ConditionFalse, deploymentutil.TimedOutReason, condition.Status, condition.Reason)
+	}
+}
+
+func TestDeploymentController_scaleDownAfterCreatingNewRC(t *testing.T) {
+	oldRc := rc("foo-v1", 1)
+	oldRc.Spec.Selector = map[string]string{"name": "foo"}
+	deployment := deployment("foo", 1, util.NewIntOrStringFromInt(0), util.NewIntOrStringFromInt(1))
+	deployment.Spec.Template.Labels = map[string]string{"name": "foo"}
+
+	fake := &testclient.Fake{}
+	fake.AddReactor("list", "replicationcontrollers", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &api.ReplicationControllerList{Items: []api.ReplicationController{*oldRc}}, nil
+	})
+	fake.AddReactor("create", "replicationcontrollers", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+		created := action.(testclient.CreateAction).GetObject().(*api.ReplicationController)
+		created.Name = "foo-v2"
+		return true, created, nil
+	})
+	fake.AddReactor("list", "pods", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+		pod := api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: "foo-v1-pod", Labels: oldRc.Spec.Selector},
+			Status: api.PodStatus{
+				Conditions: []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}},
+			},
+		}
+		return true, &api.PodList{Items: []api.Pod{pod}}, nil
+	})
+	controller := &DeploymentController{
+		client:        fake,
+		eventRecorder: &record.FakeRecorder{},
+	}
+	if err := controller.reconcileDeployment(&deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The old RC is scaled down in the same pass that creates the new one, and
+	// the status is written once.
+	scaledDown, statusUpdates := false, 0
+	for _, action := range fake.Actions() {
+		if action.GetVerb() != "update" {
+			continue
+		}
+		switch obj := action.(testclient.UpdateAction).GetObject().(type) {
+		case *api.ReplicationController:
+			if obj.Name == oldRc.Name && obj.Spec.Replicas == 0 {
+				scaledDown = true
+			}
+		case *exp.Deployment:
+			statusUpdates++
+		}
+	}
+	if !scaledDown {
+		t.Errorf("expected %s to be scaled down, got: %v", oldRc.Name, fake.Actions())
+	}
+	if statusUpdates != 1 {
+		t.Errorf("expected 1 status update, got %d", statusUpdates)
+	}
+}
+
 func rc(name string, replicas int) *api.ReplicationController {
 	return &api.ReplicationController{
 		ObjectMeta: api.ObjectMeta{
@@ -248,6 +602,9 @@ func deployment(name string, replicas int, maxSurge, maxUnavailable util.IntOrSt
 		},
 		Spec: exp.DeploymentSpec{
 			Replicas: replicas,
+			Selector: &exp.PodSelector{
+				MatchLabels: map[string]string{"name": name},
+			},
 			Strategy: exp.DeploymentStrategy{
 				Type: exp.RollingUpdateDeploymentStrategyType,
 				RollingUpdate: &exp.RollingUpdateDeployment{
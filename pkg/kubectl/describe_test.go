@@ -30,6 +30,7 @@ import (
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
 )
 
 type describeClient struct {
@@ -504,6 +505,16 @@ func TestDescribeDeployment(t *testing.T) {
 		},
 		Spec: extensions.DeploymentSpec{
 			Template: api.PodTemplateSpec{},
+			Paused:   true,
+		},
+		Status: extensions.DeploymentStatus{
+			Conditions: []extensions.DeploymentCondition{
+				{
+					Type:   extensions.DeploymentProgressing,
+					Status: api.ConditionUnknown,
+					Reason: deploymentutil.PausedDeployReason,
+				},
+			},
 		},
 	})
 	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
@@ -515,4 +526,7 @@ func TestDescribeDeployment(t *testing.T) {
 	if !strings.Contains(out, "bar") || !strings.Contains(out, "foo") {
 		t.Errorf("unexpected out: %s", out)
 	}
+	if !strings.Contains(out, "Paused") || !strings.Contains(out, deploymentutil.PausedDeployReason) {
+		t.Errorf("unexpected out: %s", out)
+	}
 }
@@ -30,6 +30,7 @@ import (
 	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/storage"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
 )
 
 // DeploymentStorage includes dummy storage for Deployments and for Scale subresource.
@@ -141,7 +142,7 @@ func (r *ScaleREST) Get(ctx api.Context, name string) (runtime.Object, error) {
 		},
 		Status: extensions.ScaleStatus{
 			Replicas: deployment.Status.Replicas,
-			Selector: deployment.Spec.Selector,
+			Selector: deploymentutil.GetSelectorAsMap(*deployment),
 		},
 	}, nil
 }
@@ -174,7 +175,7 @@ func (r *ScaleREST) Update(ctx api.Context, obj runtime.Object) (runtime.Object,
 		},
 		Status: extensions.ScaleStatus{
 			Replicas: deployment.Status.Replicas,
-			Selector: deployment.Spec.Selector,
+			Selector: deploymentutil.GetSelectorAsMap(*deployment),
 		},
 	}, false, nil
 }
@@ -48,7 +48,7 @@ func validNewDeployment() *extensions.Deployment {
 			Namespace: namespace,
 		},
 		Spec: extensions.DeploymentSpec{
-			Selector: map[string]string{"a": "b"},
+			Selector: &extensions.PodSelector{MatchLabels: map[string]string{"a": "b"}},
 			Template: api.PodTemplateSpec{
 				ObjectMeta: api.ObjectMeta{
 					Labels: map[string]string{"a": "b"},
@@ -102,7 +102,7 @@ func TestCreate(t *testing.T) {
 		// invalid (invalid selector)
 		&extensions.Deployment{
 			Spec: extensions.DeploymentSpec{
-				Selector: map[string]string{},
+				Selector: &extensions.PodSelector{},
 				Template: validDeployment.Spec.Template,
 			},
 		},
@@ -139,7 +139,7 @@ func TestUpdate(t *testing.T) {
 		},
 		func(obj runtime.Object) runtime.Object {
 			object := obj.(*extensions.Deployment)
-			object.Spec.Selector = map[string]string{}
+			object.Spec.Selector = &extensions.PodSelector{}
 			return object
 		},
 	)
@@ -238,6 +238,61 @@ func TestScaleUpdate(t *testing.T) {
 	}
 }
 
+func TestScaleUpdateWithExpressionSelector(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+
+	ctx := api.WithNamespace(api.NewContext(), namespace)
+	key := etcdtest.AddPrefix("/deployments/" + namespace + "/" + name)
+	deployment := validNewDeployment()
+	deployment.Spec.Selector = &extensions.PodSelector{
+		MatchExpressions: []extensions.PodSelectorRequirement{
+			{
+				Key:      "a",
+				Operator: extensions.PodSelectorOpNotIn,
+				Values:   []string{"c"},
+			},
+		},
+	}
+	if _, err := fakeClient.Set(key, runtime.EncodeOrDie(testapi.Extensions.Codec(), deployment), 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	replicas := 12
+	update := extensions.Scale{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: extensions.ScaleSpec{
+			Replicas: replicas,
+		},
+	}
+
+	obj, _, err := storage.Scale.Update(ctx, &update)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The selector cannot be expressed as a map, so the template labels are
+	// reported instead.
+	if e, a := deployment.Spec.Template.Labels, obj.(*extensions.Scale).Status.Selector; !api.Semantic.DeepEqual(e, a) {
+		t.Errorf("expected selector %v, got %v", e, a)
+	}
+	response, err := fakeClient.Get(key, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var updated extensions.Deployment
+	testapi.Extensions.Codec().DecodeInto([]byte(response.Node.Value), &updated)
+	if updated.Spec.Replicas != replicas {
+		t.Errorf("wrong replicas count expected: %d got: %d", replicas, updated.Spec.Replicas)
+	}
+
+	obj, err = storage.Scale.Get(ctx, name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := deployment.Spec.Template.Labels, obj.(*extensions.Scale).Status.Selector; !api.Semantic.DeepEqual(e, a) {
+		t.Errorf("expected selector %v, got %v", e, a)
+	}
+}
+
 func TestStatusUpdate(t *testing.T) {
 	storage, fakeClient := newStorage(t)
 
@@ -19,8 +19,10 @@ package deployment
 import (
 	"fmt"
 	"hash/adler32"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
@@ -28,11 +30,42 @@ import (
 	"k8s.io/kubernetes/pkg/util"
 )
 
+// Reasons for the Progressing condition of a deployment.
+const (
+	// RCUpdatedReason is used when one of the deployment's RCs was created or
+	// scaled.
+	RCUpdatedReason = "ReplicationControllerUpdated"
+	// NewRCAvailableReason is used when the new RC of a deployment is fully
+	// scaled up, all of its pods are available and no old pods remain.
+	NewRCAvailableReason = "NewReplicationControllerAvailable"
+	// TimedOutReason is used when the deployment has made no progress within
+	// its progress deadline.
+	TimedOutReason = "ProgressDeadlineExceeded"
+	// PausedDeployReason is used when the deployment is paused.
+	PausedDeployReason = "DeploymentPaused"
+	// ResumedDeployReason is used when a paused deployment is resumed.
+	ResumedDeployReason = "DeploymentResumed"
+)
+
+// Returns true if the given deployment has no selector, or an empty selector
+// that would select every pod.
+func HasEmptySelector(deployment extensions.Deployment) bool {
+	selector := deployment.Spec.Selector
+	return selector == nil || len(selector.MatchLabels)+len(selector.MatchExpressions) == 0
+}
+
 // Returns the old RCs targetted by the given Deployment.
 func GetOldRCs(deployment extensions.Deployment, c client.Interface) ([]*api.ReplicationController, error) {
 	namespace := deployment.ObjectMeta.Namespace
+	if HasEmptySelector(deployment) {
+		return nil, fmt.Errorf("deployment %s has an empty selector", deployment.Name)
+	}
 	// 1. Find all pods whose labels match deployment.Spec.Selector
-	podList, err := c.Pods(namespace).List(labels.SelectorFromSet(deployment.Spec.Selector), fields.Everything())
+	selector, err := extensions.PodSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector: %v", err)
+	}
+	podList, err := c.Pods(namespace).List(selector, fields.Everything())
 	if err != nil {
 		return nil, fmt.Errorf("error listing pods: %v", err)
 	}
@@ -98,6 +131,25 @@ func GetNewRCTemplate(deployment extensions.Deployment) api.PodTemplateSpec {
 	return newRCTemplate
 }
 
+// Returns the selector of the given deployment as a map, for consumers such as
+// RCs and the scale subresource that only support equality-based selectors.
+// If the deployment selector cannot be expressed as a map the pod template
+// labels are returned instead; these are guaranteed by validation to match the
+// deployment selector.
+func GetSelectorAsMap(deployment extensions.Deployment) map[string]string {
+	selector, err := extensions.PodSelectorAsMap(deployment.Spec.Selector)
+	if err != nil || len(selector) == 0 {
+		return deployment.Spec.Template.ObjectMeta.Labels
+	}
+	return selector
+}
+
+// Returns the selector for the new RC corresponding to the given deployment,
+// with the given pod template hash added under the deployment's unique label key.
+func GetNewRCSelector(deployment extensions.Deployment, podTemplateSpecHash uint32) map[string]string {
+	return CloneAndAddLabel(GetSelectorAsMap(deployment), deployment.Spec.UniqueLabelKey, podTemplateSpecHash)
+}
+
 // Clones the given map and returns a new map with the given key and value added.
 // Returns the given map, if labelKey is empty.
 func CloneAndAddLabel(labels map[string]string, labelKey string, labelValue uint32) map[string]string {
@@ -156,3 +208,69 @@ func getPodsForRCs(c client.Interface, replicationControllers []*api.Replication
 	}
 	return allPods, nil
 }
+
+// Returns a new deployment condition with its update and transition times set
+// to now.
+func NewDeploymentCondition(condType extensions.DeploymentConditionType, status api.ConditionStatus, reason, message string) *extensions.DeploymentCondition {
+	now := unversioned.Now()
+	return &extensions.DeploymentCondition{
+		Type:               condType,
+		Status:             status,
+		LastUpdateTime:     now,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// Returns the condition of the given type from the deployment status, or nil
+// if it is not present.
+func GetDeploymentCondition(status extensions.DeploymentStatus, condType extensions.DeploymentConditionType) *extensions.DeploymentCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == condType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// Replaces the condition of the same type in the deployment status with the
+// given condition, or adds it if there is none. The last transition time of
+// the existing condition is kept if its status does not change.
+func SetDeploymentCondition(status *extensions.DeploymentStatus, condition extensions.DeploymentCondition) {
+	conditions := []extensions.DeploymentCondition{}
+	for _, c := range status.Conditions {
+		if c.Type != condition.Type {
+			conditions = append(conditions, c)
+			continue
+		}
+		if c.Status == condition.Status {
+			condition.LastTransitionTime = c.LastTransitionTime
+		}
+	}
+	status.Conditions = append(conditions, condition)
+}
+
+// Returns true if the given deployment has not made any progress within its
+// progress deadline. Deployments without a deadline, paused deployments and
+// deployments whose rollout is complete never time out. The deadline is
+// measured from the last update of the Progressing condition, which the
+// deployment controller records on its first sync of a deployment that has
+// none, so a deployment without the condition has not started the clock yet.
+func DeploymentTimedOut(deployment *extensions.Deployment, now time.Time) bool {
+	if deployment.Spec.ProgressDeadlineSeconds == nil {
+		return false
+	}
+	condition := GetDeploymentCondition(deployment.Status, extensions.DeploymentProgressing)
+	if condition == nil {
+		return false
+	}
+	switch condition.Reason {
+	case NewRCAvailableReason, PausedDeployReason:
+		return false
+	case TimedOutReason:
+		return true
+	}
+	deadline := condition.LastUpdateTime.Add(time.Duration(*deployment.Spec.ProgressDeadlineSeconds) * time.Second)
+	return deadline.Before(now)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+func TestSetDeploymentCondition(t *testing.T) {
+	past := unversioned.NewTime(time.Now().Add(-time.Hour))
+	status := extensions.DeploymentStatus{
+		Conditions: []extensions.DeploymentCondition{
+			{
+				Type:               extensions.DeploymentProgressing,
+				Status:             api.ConditionTrue,
+				LastUpdateTime:     past,
+				LastTransitionTime: past,
+				Reason:             RCUpdatedReason,
+			},
+		},
+	}
+
+	// The transition time is kept while the status does not change.
+	SetDeploymentCondition(&status, *NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionTrue, NewRCAvailableReason, ""))
+	if len(status.Conditions) != 1 {
+		t.Fatalf("expected 1 condition, got %v", status.Conditions)
+	}
+	condition := GetDeploymentCondition(status, extensions.DeploymentProgressing)
+	if condition.Reason != NewRCAvailableReason {
+		t.Errorf("expected reason %s, got %s", NewRCAvailableReason, condition.Reason)
+	}
+	if !condition.LastTransitionTime.Equal(past) {
+		t.Errorf("expected transition time %v, got %v", past, condition.LastTransitionTime)
+	}
+	if condition.LastUpdateTime.Equal(past) {
+		t.Errorf("expected update time to change")
+	}
+
+	SetDeploymentCondition(&status, *NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionFalse, TimedOutReason, ""))
+	condition = GetDeploymentCondition(status, extensions.DeploymentProgressing)
+	if condition.LastTransitionTime.Equal(past) {
+		t.Errorf("expected transition time to change")
+	}
+}
+
+func TestDeploymentTimedOut(t *testing.T) {
+	now := time.Now()
+	deadline := 10
+	tests := []struct {
+		progressDeadlineSeconds *int
+		reason                  string
+		lastUpdate              time.Duration
+		expected                bool
+	}{
+		{
+			progressDeadlineSeconds: nil,
+			reason:                  RCUpdatedReason,
+			lastUpdate:              time.Minute,
+			expected:                false,
+		},
+		{
+			progressDeadlineSeconds: &deadline,
+			reason:                  RCUpdatedReason,
+			lastUpdate:              5 * time.Second,
+			expected:                false,
+		},
+		{
+			progressDeadlineSeconds: &deadline,
+			reason:                  RCUpdatedReason,
+			lastUpdate:              time.Minute,
+			expected:                true,
+		},
+		{
+			progressDeadlineSeconds: &deadline,
+			reason:                  ResumedDeployReason,
+			lastUpdate:              time.Minute,
+			expected:                true,
+		},
+		{
+			progressDeadlineSeconds: &deadline,
+			reason:                  NewRCAvailableReason,
+			lastUpdate:              time.Minute,
+			expected:                false,
+		},
+		{
+			progressDeadlineSeconds: &deadline,
+			reason:                  PausedDeployReason,
+			lastUpdate:              time.Minute,
+			expected:                false,
+		},
+		{
+			progressDeadlineSeconds: &deadline,
+			reason:                  TimedOutReason,
+			lastUpdate:              5 * time.Second,
+			expected:                true,
+		},
+	}
+
+	for i, test := range tests {
+		deployment := &extensions.Deployment{
+			Spec: extensions.DeploymentSpec{
+				ProgressDeadlineSeconds: test.progressDeadlineSeconds,
+			},
+			Status: extensions.DeploymentStatus{
+				Conditions: []extensions.DeploymentCondition{
+					{
+						Type:           extensions.DeploymentProgressing,
+						Status:         api.ConditionTrue,
+						LastUpdateTime: unversioned.NewTime(now.Add(-test.lastUpdate)),
+						Reason:         test.reason,
+					},
+				},
+			},
+		}
+		if e, a := test.expected, DeploymentTimedOut(deployment, now); e != a {
+			t.Errorf("[%d] expected timed out %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestGetOldRCsEmptySelector(t *testing.T) {
+	for _, selector := range []*extensions.PodSelector{nil, {}} {
+		deployment := extensions.Deployment{
+			ObjectMeta: api.ObjectMeta{Name: "foo"},
+			Spec:       extensions.DeploymentSpec{Selector: selector},
+		}
+		// The client must not be used for a deployment that would select every pod.
+		if _, err := GetOldRCs(deployment, nil); err == nil {
+			t.Errorf("expected an error for selector %+v", selector)
+		}
+	}
+}
+
+func TestGetSelectorAsMap(t *testing.T) {
+	labels := map[string]string{"a": "b", "c": "d"}
+	tests := []struct {
+		selector *extensions.PodSelector
+		expected map[string]string
+	}{
+		{
+			selector: &extensions.PodSelector{MatchLabels: map[string]string{"a": "b"}},
+			expected: map[string]string{"a": "b"},
+		},
+		{
+			selector: &extensions.PodSelector{
+				MatchExpressions: []extensions.PodSelectorRequirement{
+					{
+						Key:      "a",
+						Operator: extensions.PodSelectorOpNotIn,
+						Values:   []string{"e"},
+					},
+				},
+			},
+			expected: labels,
+		},
+	}
+	for i, test := range tests {
+		deployment := extensions.Deployment{
+			Spec: extensions.DeploymentSpec{
+				Selector: test.selector,
+				Template: api.PodTemplateSpec{
+					ObjectMeta: api.ObjectMeta{Labels: labels},
+				},
+			},
+		}
+		if e, a := test.expected, GetSelectorAsMap(deployment); !reflect.DeepEqual(e, a) {
+			t.Errorf("[%d] expected %v, got %v", i, e, a)
+		}
+	}
+}
@@ -53,7 +53,7 @@ func testNewDeployment(f *Framework) {
 		},
 		Spec: extensions.DeploymentSpec{
 			Replicas:       1,
-			Selector:       podLabels,
+			Selector:       &extensions.PodSelector{MatchLabels: podLabels},
 			UniqueLabelKey: "deployment.kubernetes.io/podTemplateHash",
 			Template: api.PodTemplateSpec{
 				ObjectMeta: api.ObjectMeta{
@@ -146,7 +146,7 @@ func testRollingUpdateDeployment(f *Framework) {
 		},
 		Spec: extensions.DeploymentSpec{
 			Replicas:       3,
-			Selector:       deploymentPodLabels,
+			Selector:       &extensions.PodSelector{MatchLabels: deploymentPodLabels},
 			UniqueLabelKey: "deployment.kubernetes.io/podTemplateHash",
 			Template: api.PodTemplateSpec{
 				ObjectMeta: api.ObjectMeta{
@@ -227,7 +227,7 @@ func testRollingUpdateDeploymentEvents(f *Framework) {
 		},
 		Spec: extensions.DeploymentSpec{
 			Replicas:       1,
-			Selector:       deploymentPodLabels,
+			Selector:       &extensions.PodSelector{MatchLabels: deploymentPodLabels},
 			UniqueLabelKey: "deployment.kubernetes.io/podTemplateHash",
 			Template: api.PodTemplateSpec{
 				ObjectMeta: api.ObjectMeta{
@@ -1274,8 +1274,10 @@ func (config *DeploymentConfig) create() error {
 		},
 		Spec: extensions.DeploymentSpec{
 			Replicas: config.Replicas,
-			Selector: map[string]string{
-				"name": config.Name,
+			Selector: &extensions.PodSelector{
+				MatchLabels: map[string]string{
+					"name": config.Name,
+				},
 			},
 			UniqueLabelKey: "deployment.kubernetes.io/podTemplateHash",
 			Template: api.PodTemplateSpec{